@@ -41,7 +41,7 @@ func main() {
 	fmt.Printf("  Rate Limit: %d req/s\n", config.RateLimit)
 	fmt.Printf("  Ratio: %.2f\n", config.Ratio)
 	fmt.Printf("  Enabled: %v\n", config.Enabled)
-	fmt.Printf("  API Key: %s\n", string(config.ApiKey))
+	fmt.Printf("  API Key: %s (%d bytes)\n", fs.Lookup("api-key").Value, len(config.ApiKey))
 	fmt.Println()
 
 	fmt.Println("Special Types:")
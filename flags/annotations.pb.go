@@ -1,6758 +0,0 @@
-// Copyright 2021 Aapeli <aapeli.nian@gmail.com>  All rights reserved.
-//
-// Licensed under the Apache License, Version 2.0 (the "License");
-// you may not use this file except in compliance with the License.
-// You may obtain a copy of the License at
-//
-// http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
-
-// Package flags provides protobuf extensions for generating command-line flag bindings.
-//
-// This package defines custom protobuf extensions that can be used to configure
-// how AddFlags methods are generated for protobuf messages and fields. The generated code
-// integrates with the spf13/pflag library to provide POSIX/GNU-style command-line
-// flag parsing.
-//
-// # Usage Example
-//
-// To use these extensions in your protobuf files, import this annotations file and
-// apply the options to your messages and fields:
-//
-//   syntax = "proto3";
-//   import "flags/annotations.proto";
-//
-//   message MyConfig {
-//     string name = 1 [(flags.value).string = { name: "name" short: "n" usage: "Name value" }];
-//     int32 port = 2 [(flags.value).int32 = { default: 8080 }];
-//   }
-//
-// # Message Level Options
-//
-// Message-level options control the overall flag generation behavior for a message:
-//
-//   option (flags.disabled) = true;     // Skip flag generation for this message
-//   option (flags.unexported) = true;   // Generate unexported flag methods
-//   option (flags.allow_empty) = true;  // Generate flags even without field configurations
-//
-// # Field Level Options
-//
-// Field-level options configure individual flag behavior:
-//
-//   string name = 1 [(flags.value).string = {
-//     name: "custom-name"
-//     short: "n"
-//     usage: "Custom usage description"
-//     default: "default-value"
-//   }];
-//
-// # Supported Types
-//
-// All protobuf scalar types are supported, including:
-//   - Numeric types: int32, int64, uint32, uint64, float, double, etc.
-//   - String and bytes
-//   - Boolean
-//   - Enum types
-//   - Repeated fields
-//   - Well-known types: duration, timestamp
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.33.0
-// 	protoc        (unknown)
-// source: flags/annotations.proto
-
-// buf:lint:ignore PACKAGE_VERSION_SUFFIX
-// buf:lint:ignore PACKAGE_SAME_DIRECTORY
-
-package flags
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
-	reflect "reflect"
-	sync "sync"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// BytesEncodingType specifies the encoding format for bytes fields in command-line flags.
-type BytesEncodingType int32
-
-const (
-	// BYTES_ENCODING_TYPE_UNSPECIFIED uses the default base64 encoding.
-	BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED BytesEncodingType = 0
-	// BYTES_ENCODING_TYPE_BASE64 uses standard base64 encoding for bytes fields.
-	BytesEncodingType_BYTES_ENCODING_TYPE_BASE64 BytesEncodingType = 1
-	// BYTES_ENCODING_TYPE_HEX uses hexadecimal encoding for bytes fields.
-	BytesEncodingType_BYTES_ENCODING_TYPE_HEX BytesEncodingType = 2
-)
-
-// Enum value maps for BytesEncodingType.
-var (
-	BytesEncodingType_name = map[int32]string{
-		0: "BYTES_ENCODING_TYPE_UNSPECIFIED",
-		1: "BYTES_ENCODING_TYPE_BASE64",
-		2: "BYTES_ENCODING_TYPE_HEX",
-	}
-	BytesEncodingType_value = map[string]int32{
-		"BYTES_ENCODING_TYPE_UNSPECIFIED": 0,
-		"BYTES_ENCODING_TYPE_BASE64":      1,
-		"BYTES_ENCODING_TYPE_HEX":         2,
-	}
-)
-
-func (x BytesEncodingType) Enum() *BytesEncodingType {
-	p := new(BytesEncodingType)
-	*p = x
-	return p
-}
-
-func (x BytesEncodingType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (BytesEncodingType) Descriptor() protoreflect.EnumDescriptor {
-	return file_flags_annotations_proto_enumTypes[0].Descriptor()
-}
-
-func (BytesEncodingType) Type() protoreflect.EnumType {
-	return &file_flags_annotations_proto_enumTypes[0]
-}
-
-func (x BytesEncodingType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use BytesEncodingType.Descriptor instead.
-func (BytesEncodingType) EnumDescriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{0}
-}
-
-// MapFormatType specifies the format for map fields in command-line flags.
-type MapFormatType int32
-
-const (
-	// MAP_FORMAT_TYPE_UNSPECIFIED uses the default format (JSON).
-	MapFormatType_MAP_FORMAT_TYPE_UNSPECIFIED MapFormatType = 0
-	// MAP_FORMAT_TYPE_JSON uses JSON format for the entire map.
-	MapFormatType_MAP_FORMAT_TYPE_JSON MapFormatType = 1
-	// MAP_FORMAT_TYPE_STRING_TO_STRING uses string keys and string values.
-	MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING MapFormatType = 2
-	// MAP_FORMAT_TYPE_STRING_TO_INT uses string keys and int values.
-	MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT MapFormatType = 3
-)
-
-// Enum value maps for MapFormatType.
-var (
-	MapFormatType_name = map[int32]string{
-		0: "MAP_FORMAT_TYPE_UNSPECIFIED",
-		1: "MAP_FORMAT_TYPE_JSON",
-		2: "MAP_FORMAT_TYPE_STRING_TO_STRING",
-		3: "MAP_FORMAT_TYPE_STRING_TO_INT",
-	}
-	MapFormatType_value = map[string]int32{
-		"MAP_FORMAT_TYPE_UNSPECIFIED":      0,
-		"MAP_FORMAT_TYPE_JSON":             1,
-		"MAP_FORMAT_TYPE_STRING_TO_STRING": 2,
-		"MAP_FORMAT_TYPE_STRING_TO_INT":    3,
-	}
-)
-
-func (x MapFormatType) Enum() *MapFormatType {
-	p := new(MapFormatType)
-	*p = x
-	return p
-}
-
-func (x MapFormatType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (MapFormatType) Descriptor() protoreflect.EnumDescriptor {
-	return file_flags_annotations_proto_enumTypes[1].Descriptor()
-}
-
-func (MapFormatType) Type() protoreflect.EnumType {
-	return &file_flags_annotations_proto_enumTypes[1]
-}
-
-func (x MapFormatType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use MapFormatType.Descriptor instead.
-func (MapFormatType) EnumDescriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{1}
-}
-
-// BytesFlag contains configuration specifically for bytes fields with encoding type selection.
-//
-// This message allows customization of how bytes fields are encoded and decoded
-// in command-line flags, supporting both base64 and hexadecimal formats.
-type BytesFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Encoding specifies the encoding format for bytes fields. When unspecified,
-	// defaults to base64 encoding.
-	Encoding BytesEncodingType `protobuf:"varint,8,opt,name=encoding,proto3,enum=flags.BytesEncodingType" json:"encoding,omitempty"`
-	// Default specifies the default value for this flag.
-	Default []byte `protobuf:"bytes,9,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *BytesFlag) Reset() {
-	*x = BytesFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *BytesFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BytesFlag) ProtoMessage() {}
-
-func (x *BytesFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BytesFlag.ProtoReflect.Descriptor instead.
-func (*BytesFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *BytesFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *BytesFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BytesFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *BytesFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *BytesFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *BytesFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *BytesFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *BytesFlag) GetEncoding() BytesEncodingType {
-	if x != nil {
-		return x.Encoding
-	}
-	return BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED
-}
-
-func (x *BytesFlag) GetDefault() []byte {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// PrimitiveFlag contains the core configuration for all primitive flag types.
-//
-// This message provides a comprehensive set of options for customizing flag
-// behavior, appearance, and integration with environment variables.
-type PrimitiveFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-}
-
-func (x *PrimitiveFlag) Reset() {
-	*x = PrimitiveFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PrimitiveFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PrimitiveFlag) ProtoMessage() {}
-
-func (x *PrimitiveFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PrimitiveFlag.ProtoReflect.Descriptor instead.
-func (*PrimitiveFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *PrimitiveFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *PrimitiveFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PrimitiveFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *PrimitiveFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *PrimitiveFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *PrimitiveFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *PrimitiveFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-// FloatFlag contains configuration for float32 fields with default value support.
-type FloatFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *float32 `protobuf:"fixed32,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *FloatFlag) Reset() {
-	*x = FloatFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *FloatFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FloatFlag) ProtoMessage() {}
-
-func (x *FloatFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FloatFlag.ProtoReflect.Descriptor instead.
-func (*FloatFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *FloatFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *FloatFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *FloatFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *FloatFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *FloatFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *FloatFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *FloatFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *FloatFlag) GetDefault() float32 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// DoubleFlag contains configuration for float64 fields with default value support.
-type DoubleFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *float64 `protobuf:"fixed64,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *DoubleFlag) Reset() {
-	*x = DoubleFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DoubleFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DoubleFlag) ProtoMessage() {}
-
-func (x *DoubleFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DoubleFlag.ProtoReflect.Descriptor instead.
-func (*DoubleFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *DoubleFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *DoubleFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *DoubleFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *DoubleFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *DoubleFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *DoubleFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *DoubleFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *DoubleFlag) GetDefault() float64 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Int32Flag contains configuration for int32 fields with default value support.
-type Int32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *int32 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Int32Flag) Reset() {
-	*x = Int32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Int32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Int32Flag) ProtoMessage() {}
-
-func (x *Int32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Int32Flag.ProtoReflect.Descriptor instead.
-func (*Int32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *Int32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Int32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Int32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Int32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Int32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Int32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Int32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Int32Flag) GetDefault() int32 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Int64Flag contains configuration for int64 fields with default value support.
-type Int64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *int64 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Int64Flag) Reset() {
-	*x = Int64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Int64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Int64Flag) ProtoMessage() {}
-
-func (x *Int64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Int64Flag.ProtoReflect.Descriptor instead.
-func (*Int64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *Int64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Int64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Int64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Int64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Int64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Int64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Int64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Int64Flag) GetDefault() int64 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Uint32Flag contains configuration for uint32 fields with default value support.
-type Uint32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *uint32 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Uint32Flag) Reset() {
-	*x = Uint32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Uint32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Uint32Flag) ProtoMessage() {}
-
-func (x *Uint32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Uint32Flag.ProtoReflect.Descriptor instead.
-func (*Uint32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *Uint32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Uint32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Uint32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Uint32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Uint32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Uint32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Uint32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Uint32Flag) GetDefault() uint32 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Uint64Flag contains configuration for uint64 fields with default value support.
-type Uint64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *uint64 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Uint64Flag) Reset() {
-	*x = Uint64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Uint64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Uint64Flag) ProtoMessage() {}
-
-func (x *Uint64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Uint64Flag.ProtoReflect.Descriptor instead.
-func (*Uint64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *Uint64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Uint64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Uint64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Uint64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Uint64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Uint64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Uint64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Uint64Flag) GetDefault() uint64 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Sint32Flag contains configuration for sint32 fields with default value support.
-type Sint32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *int32 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Sint32Flag) Reset() {
-	*x = Sint32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Sint32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Sint32Flag) ProtoMessage() {}
-
-func (x *Sint32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Sint32Flag.ProtoReflect.Descriptor instead.
-func (*Sint32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *Sint32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Sint32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Sint32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Sint32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Sint32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Sint32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Sint32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Sint32Flag) GetDefault() int32 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Sint64Flag contains configuration for sint64 fields with default value support.
-type Sint64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *int64 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Sint64Flag) Reset() {
-	*x = Sint64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Sint64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Sint64Flag) ProtoMessage() {}
-
-func (x *Sint64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Sint64Flag.ProtoReflect.Descriptor instead.
-func (*Sint64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Sint64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Sint64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Sint64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Sint64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Sint64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Sint64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Sint64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Sint64Flag) GetDefault() int64 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Fixed32Flag contains configuration for fixed32 fields with default value support.
-type Fixed32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *uint32 `protobuf:"fixed32,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Fixed32Flag) Reset() {
-	*x = Fixed32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Fixed32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Fixed32Flag) ProtoMessage() {}
-
-func (x *Fixed32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Fixed32Flag.ProtoReflect.Descriptor instead.
-func (*Fixed32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *Fixed32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Fixed32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Fixed32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Fixed32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Fixed32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Fixed32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Fixed32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Fixed32Flag) GetDefault() uint32 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Fixed64Flag contains configuration for fixed64 fields with default value support.
-type Fixed64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *uint64 `protobuf:"fixed64,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Fixed64Flag) Reset() {
-	*x = Fixed64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Fixed64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Fixed64Flag) ProtoMessage() {}
-
-func (x *Fixed64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Fixed64Flag.ProtoReflect.Descriptor instead.
-func (*Fixed64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *Fixed64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Fixed64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Fixed64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Fixed64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Fixed64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Fixed64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Fixed64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Fixed64Flag) GetDefault() uint64 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Sfixed32Flag contains configuration for sfixed32 fields with default value support.
-type Sfixed32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *int32 `protobuf:"fixed32,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Sfixed32Flag) Reset() {
-	*x = Sfixed32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Sfixed32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Sfixed32Flag) ProtoMessage() {}
-
-func (x *Sfixed32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Sfixed32Flag.ProtoReflect.Descriptor instead.
-func (*Sfixed32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *Sfixed32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Sfixed32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Sfixed32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Sfixed32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Sfixed32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Sfixed32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Sfixed32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Sfixed32Flag) GetDefault() int32 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// Sfixed64Flag contains configuration for sfixed64 fields with default value support.
-type Sfixed64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *int64 `protobuf:"fixed64,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *Sfixed64Flag) Reset() {
-	*x = Sfixed64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Sfixed64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Sfixed64Flag) ProtoMessage() {}
-
-func (x *Sfixed64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Sfixed64Flag.ProtoReflect.Descriptor instead.
-func (*Sfixed64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *Sfixed64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *Sfixed64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Sfixed64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *Sfixed64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *Sfixed64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *Sfixed64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *Sfixed64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *Sfixed64Flag) GetDefault() int64 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// BoolFlag contains configuration for bool fields with default value support.
-type BoolFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *bool `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *BoolFlag) Reset() {
-	*x = BoolFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *BoolFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BoolFlag) ProtoMessage() {}
-
-func (x *BoolFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BoolFlag.ProtoReflect.Descriptor instead.
-func (*BoolFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *BoolFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *BoolFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BoolFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *BoolFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *BoolFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *BoolFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *BoolFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *BoolFlag) GetDefault() bool {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return false
-}
-
-// StringFlag contains configuration for string fields with default value support.
-type StringFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag as a JSON string.
-	Default *string `protobuf:"bytes,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *StringFlag) Reset() {
-	*x = StringFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *StringFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StringFlag) ProtoMessage() {}
-
-func (x *StringFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StringFlag.ProtoReflect.Descriptor instead.
-func (*StringFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *StringFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *StringFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *StringFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *StringFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *StringFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *StringFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *StringFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *StringFlag) GetDefault() string {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return ""
-}
-
-// RepeatedFloatFlag contains configuration for repeated float32 fields with default value support.
-type RepeatedFloatFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []float32 `protobuf:"fixed32,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedFloatFlag) Reset() {
-	*x = RepeatedFloatFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedFloatFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedFloatFlag) ProtoMessage() {}
-
-func (x *RepeatedFloatFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedFloatFlag.ProtoReflect.Descriptor instead.
-func (*RepeatedFloatFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *RepeatedFloatFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedFloatFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedFloatFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedFloatFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedFloatFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedFloatFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedFloatFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedFloatFlag) GetDefault() []float32 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedDoubleFlag contains configuration for repeated float64 fields with default value support.
-type RepeatedDoubleFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []float64 `protobuf:"fixed64,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedDoubleFlag) Reset() {
-	*x = RepeatedDoubleFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedDoubleFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedDoubleFlag) ProtoMessage() {}
-
-func (x *RepeatedDoubleFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedDoubleFlag.ProtoReflect.Descriptor instead.
-func (*RepeatedDoubleFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *RepeatedDoubleFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedDoubleFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedDoubleFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedDoubleFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedDoubleFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedDoubleFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedDoubleFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedDoubleFlag) GetDefault() []float64 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedInt32Flag contains configuration for repeated int32 fields with default value support.
-type RepeatedInt32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []int32 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedInt32Flag) Reset() {
-	*x = RepeatedInt32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedInt32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedInt32Flag) ProtoMessage() {}
-
-func (x *RepeatedInt32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedInt32Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedInt32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *RepeatedInt32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedInt32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedInt32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedInt32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedInt32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedInt32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedInt32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedInt32Flag) GetDefault() []int32 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedInt64Flag contains configuration for repeated int64 fields with default value support.
-type RepeatedInt64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []int64 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedInt64Flag) Reset() {
-	*x = RepeatedInt64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedInt64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedInt64Flag) ProtoMessage() {}
-
-func (x *RepeatedInt64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedInt64Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedInt64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *RepeatedInt64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedInt64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedInt64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedInt64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedInt64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedInt64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedInt64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedInt64Flag) GetDefault() []int64 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedUint32Flag contains configuration for repeated uint32 fields with default value support.
-type RepeatedUint32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []uint32 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedUint32Flag) Reset() {
-	*x = RepeatedUint32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedUint32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedUint32Flag) ProtoMessage() {}
-
-func (x *RepeatedUint32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedUint32Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedUint32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *RepeatedUint32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedUint32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedUint32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedUint32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedUint32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedUint32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedUint32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedUint32Flag) GetDefault() []uint32 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedUint64Flag contains configuration for repeated uint64 fields with default value support.
-type RepeatedUint64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []uint64 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedUint64Flag) Reset() {
-	*x = RepeatedUint64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedUint64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedUint64Flag) ProtoMessage() {}
-
-func (x *RepeatedUint64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedUint64Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedUint64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *RepeatedUint64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedUint64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedUint64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedUint64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedUint64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedUint64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedUint64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedUint64Flag) GetDefault() []uint64 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedSint32Flag contains configuration for repeated sint32 fields with default value support.
-type RepeatedSint32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []int32 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedSint32Flag) Reset() {
-	*x = RepeatedSint32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedSint32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedSint32Flag) ProtoMessage() {}
-
-func (x *RepeatedSint32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedSint32Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedSint32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *RepeatedSint32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedSint32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedSint32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedSint32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedSint32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedSint32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedSint32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedSint32Flag) GetDefault() []int32 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedSint64Flag contains configuration for repeated sint64 fields with default value support.
-type RepeatedSint64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []int64 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedSint64Flag) Reset() {
-	*x = RepeatedSint64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedSint64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedSint64Flag) ProtoMessage() {}
-
-func (x *RepeatedSint64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedSint64Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedSint64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *RepeatedSint64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedSint64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedSint64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedSint64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedSint64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedSint64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedSint64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedSint64Flag) GetDefault() []int64 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedFixed32Flag contains configuration for repeated fixed32 fields with default value support.
-type RepeatedFixed32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []uint32 `protobuf:"fixed32,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedFixed32Flag) Reset() {
-	*x = RepeatedFixed32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedFixed32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedFixed32Flag) ProtoMessage() {}
-
-func (x *RepeatedFixed32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedFixed32Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedFixed32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *RepeatedFixed32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedFixed32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedFixed32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedFixed32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedFixed32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedFixed32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedFixed32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedFixed32Flag) GetDefault() []uint32 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedFixed64Flag contains configuration for repeated fixed64 fields with default value support.
-type RepeatedFixed64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []uint64 `protobuf:"fixed64,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedFixed64Flag) Reset() {
-	*x = RepeatedFixed64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedFixed64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedFixed64Flag) ProtoMessage() {}
-
-func (x *RepeatedFixed64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedFixed64Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedFixed64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *RepeatedFixed64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedFixed64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedFixed64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedFixed64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedFixed64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedFixed64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedFixed64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedFixed64Flag) GetDefault() []uint64 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedSfixed32Flag contains configuration for repeated sfixed32 fields with default value support.
-type RepeatedSfixed32Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []int32 `protobuf:"fixed32,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedSfixed32Flag) Reset() {
-	*x = RepeatedSfixed32Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedSfixed32Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedSfixed32Flag) ProtoMessage() {}
-
-func (x *RepeatedSfixed32Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedSfixed32Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedSfixed32Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *RepeatedSfixed32Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedSfixed32Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedSfixed32Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedSfixed32Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedSfixed32Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedSfixed32Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedSfixed32Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedSfixed32Flag) GetDefault() []int32 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedSfixed64Flag contains configuration for repeated sfixed64 fields with default value support.
-type RepeatedSfixed64Flag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []int64 `protobuf:"fixed64,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedSfixed64Flag) Reset() {
-	*x = RepeatedSfixed64Flag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedSfixed64Flag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedSfixed64Flag) ProtoMessage() {}
-
-func (x *RepeatedSfixed64Flag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedSfixed64Flag.ProtoReflect.Descriptor instead.
-func (*RepeatedSfixed64Flag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *RepeatedSfixed64Flag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedSfixed64Flag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedSfixed64Flag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedSfixed64Flag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedSfixed64Flag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedSfixed64Flag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedSfixed64Flag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedSfixed64Flag) GetDefault() []int64 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedBoolFlag contains configuration for repeated bool fields with default value support.
-type RepeatedBoolFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []bool `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedBoolFlag) Reset() {
-	*x = RepeatedBoolFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedBoolFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedBoolFlag) ProtoMessage() {}
-
-func (x *RepeatedBoolFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedBoolFlag.ProtoReflect.Descriptor instead.
-func (*RepeatedBoolFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *RepeatedBoolFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedBoolFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedBoolFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedBoolFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedBoolFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedBoolFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedBoolFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedBoolFlag) GetDefault() []bool {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedStringFlag contains configuration for repeated string fields with default value support.
-type RepeatedStringFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []string `protobuf:"bytes,8,rep,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedStringFlag) Reset() {
-	*x = RepeatedStringFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedStringFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedStringFlag) ProtoMessage() {}
-
-func (x *RepeatedStringFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedStringFlag.ProtoReflect.Descriptor instead.
-func (*RepeatedStringFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *RepeatedStringFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedStringFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedStringFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedStringFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedStringFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedStringFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedStringFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedStringFlag) GetDefault() []string {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedBytesFlag contains configuration for repeated bytes fields with default value support.
-type RepeatedBytesFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Encoding specifies the encoding format for bytes fields. When unspecified,
-	// defaults to base64 encoding.
-	Encoding BytesEncodingType `protobuf:"varint,8,opt,name=encoding,proto3,enum=flags.BytesEncodingType" json:"encoding,omitempty"`
-	// Default specifies the default values for this flag.
-	Default [][]byte `protobuf:"bytes,9,rep,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedBytesFlag) Reset() {
-	*x = RepeatedBytesFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedBytesFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedBytesFlag) ProtoMessage() {}
-
-func (x *RepeatedBytesFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedBytesFlag.ProtoReflect.Descriptor instead.
-func (*RepeatedBytesFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *RepeatedBytesFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedBytesFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedBytesFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedBytesFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedBytesFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedBytesFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedBytesFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedBytesFlag) GetEncoding() BytesEncodingType {
-	if x != nil {
-		return x.Encoding
-	}
-	return BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED
-}
-
-func (x *RepeatedBytesFlag) GetDefault() [][]byte {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedEnumFlag contains configuration for repeated enum fields with default value support.
-type RepeatedEnumFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []int32 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedEnumFlag) Reset() {
-	*x = RepeatedEnumFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedEnumFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedEnumFlag) ProtoMessage() {}
-
-func (x *RepeatedEnumFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedEnumFlag.ProtoReflect.Descriptor instead.
-func (*RepeatedEnumFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *RepeatedEnumFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedEnumFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedEnumFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedEnumFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedEnumFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedEnumFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedEnumFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedEnumFlag) GetDefault() []int32 {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedDurationFlag contains configuration for repeated duration fields with default value support.
-type RepeatedDurationFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []string `protobuf:"bytes,8,rep,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedDurationFlag) Reset() {
-	*x = RepeatedDurationFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedDurationFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedDurationFlag) ProtoMessage() {}
-
-func (x *RepeatedDurationFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedDurationFlag.ProtoReflect.Descriptor instead.
-func (*RepeatedDurationFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *RepeatedDurationFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedDurationFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedDurationFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedDurationFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedDurationFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedDurationFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedDurationFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedDurationFlag) GetDefault() []string {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// RepeatedTimestampFlag contains configuration for repeated timestamp fields with default value support.
-type RepeatedTimestampFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Formats specifies the acceptable timestamp format strings for parsing.
-	// Common formats include: "RFC3339", "ISO8601", "RFC822", "Stamp", etc.
-	// When empty, defaults to RFC3339 format.
-	Formats []string `protobuf:"bytes,8,rep,name=formats,proto3" json:"formats,omitempty"`
-	// Default specifies the default values for this flag.
-	Default []string `protobuf:"bytes,9,rep,name=default,proto3" json:"default,omitempty"`
-}
-
-func (x *RepeatedTimestampFlag) Reset() {
-	*x = RepeatedTimestampFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedTimestampFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedTimestampFlag) ProtoMessage() {}
-
-func (x *RepeatedTimestampFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedTimestampFlag.ProtoReflect.Descriptor instead.
-func (*RepeatedTimestampFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *RepeatedTimestampFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *RepeatedTimestampFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepeatedTimestampFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *RepeatedTimestampFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *RepeatedTimestampFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *RepeatedTimestampFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *RepeatedTimestampFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *RepeatedTimestampFlag) GetFormats() []string {
-	if x != nil {
-		return x.Formats
-	}
-	return nil
-}
-
-func (x *RepeatedTimestampFlag) GetDefault() []string {
-	if x != nil {
-		return x.Default
-	}
-	return nil
-}
-
-// EnumFlag contains configuration for enum fields with default value support.
-type EnumFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag as an int32.
-	Default *int32 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *EnumFlag) Reset() {
-	*x = EnumFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *EnumFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EnumFlag) ProtoMessage() {}
-
-func (x *EnumFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use EnumFlag.ProtoReflect.Descriptor instead.
-func (*EnumFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *EnumFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *EnumFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *EnumFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *EnumFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *EnumFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *EnumFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *EnumFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *EnumFlag) GetDefault() int32 {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return 0
-}
-
-// MapFlag contains configuration for map fields with default value support.
-type MapFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *string `protobuf:"bytes,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-	// Format specifies the format for map fields. When unspecified,
-	// defaults to JSON format.
-	Format MapFormatType `protobuf:"varint,9,opt,name=format,proto3,enum=flags.MapFormatType" json:"format,omitempty"`
-}
-
-func (x *MapFlag) Reset() {
-	*x = MapFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *MapFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MapFlag) ProtoMessage() {}
-
-func (x *MapFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[35]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MapFlag.ProtoReflect.Descriptor instead.
-func (*MapFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *MapFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *MapFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *MapFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *MapFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *MapFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *MapFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *MapFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *MapFlag) GetDefault() string {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return ""
-}
-
-func (x *MapFlag) GetFormat() MapFormatType {
-	if x != nil {
-		return x.Format
-	}
-	return MapFormatType_MAP_FORMAT_TYPE_UNSPECIFIED
-}
-
-// DurationFlag contains configuration for duration fields with default value support.
-type DurationFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *string `protobuf:"bytes,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *DurationFlag) Reset() {
-	*x = DurationFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DurationFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DurationFlag) ProtoMessage() {}
-
-func (x *DurationFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[36]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DurationFlag.ProtoReflect.Descriptor instead.
-func (*DurationFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *DurationFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *DurationFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *DurationFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *DurationFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *DurationFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *DurationFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *DurationFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *DurationFlag) GetDefault() string {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return ""
-}
-
-// TimestampFlag contains the core configuration for all Timestamp flag types.
-//
-// This message provides a comprehensive set of options for customizing flag
-// behavior, appearance, and integration with environment variables.
-type TimestampFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Disabled skips generation of flags for this field when set to true.
-	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Name specifies a custom flag name. When empty, defaults to field name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Short specifies a short flag alias (single character).
-	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
-	// Usage provides the description text shown in help output for this flag.
-	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
-	// Hidden hides this flag from help output when set to true.
-	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	// Deprecated marks this flag as deprecated when set to true.
-	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
-	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
-	// Formats specifies the acceptable timestamp format strings for parsing.
-	// Common formats include: "RFC3339", "ISO8601", "RFC822", "Stamp", etc.
-	// When empty, defaults to RFC3339 format.
-	Formats []string `protobuf:"bytes,8,rep,name=formats,proto3" json:"formats,omitempty"`
-	// Default specifies the default value for this flag.
-	Default *string `protobuf:"bytes,9,opt,name=default,proto3,oneof" json:"default,omitempty"`
-}
-
-func (x *TimestampFlag) Reset() {
-	*x = TimestampFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *TimestampFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TimestampFlag) ProtoMessage() {}
-
-func (x *TimestampFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TimestampFlag.ProtoReflect.Descriptor instead.
-func (*TimestampFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *TimestampFlag) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
-}
-
-func (x *TimestampFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *TimestampFlag) GetShort() string {
-	if x != nil {
-		return x.Short
-	}
-	return ""
-}
-
-func (x *TimestampFlag) GetUsage() string {
-	if x != nil {
-		return x.Usage
-	}
-	return ""
-}
-
-func (x *TimestampFlag) GetHidden() bool {
-	if x != nil {
-		return x.Hidden
-	}
-	return false
-}
-
-func (x *TimestampFlag) GetDeprecated() bool {
-	if x != nil {
-		return x.Deprecated
-	}
-	return false
-}
-
-func (x *TimestampFlag) GetDeprecatedUsage() string {
-	if x != nil {
-		return x.DeprecatedUsage
-	}
-	return ""
-}
-
-func (x *TimestampFlag) GetFormats() []string {
-	if x != nil {
-		return x.Formats
-	}
-	return nil
-}
-
-func (x *TimestampFlag) GetDefault() string {
-	if x != nil && x.Default != nil {
-		return *x.Default
-	}
-	return ""
-}
-
-// MessageFlag contains configuration for message fields that contain nested flag configurations.
-//
-// This message type is used when a protobuf field is itself a message that contains
-// fields with flag configurations. It allows customization of how the nested flags
-// are organized and presented in the command-line interface.
-type MessageFlag struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Nested controls whether to generate AddFlags method calls for this message field.
-	// When set to true, the generated code will:
-	//  1. Automatically initialize the message field if it's nil (prevents null pointer exceptions)
-	//  2. Call AddFlags on the nested message fields that implement the flags.Interface
-	//
-	// When set to false, no flags will be generated for fields within this message,
-	// even if they have flag configurations.
-	Nested bool `protobuf:"varint,1,opt,name=nested,proto3" json:"nested,omitempty"`
-	// Name specifies a custom name prefix for all flags in this message field.
-	// This is useful for organizing flags into logical groups and avoiding
-	// naming conflicts when multiple messages have similarly named fields.
-	//
-	// Examples:
-	//   - name "server" would generate "--server.host", "--server.port"
-	//   - name "database" would generate "--database.url", "--database.timeout"
-	//   - empty name would generate "--host", "--port" (field names directly)
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *MessageFlag) Reset() {
-	*x = MessageFlag{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *MessageFlag) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MessageFlag) ProtoMessage() {}
-
-func (x *MessageFlag) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MessageFlag.ProtoReflect.Descriptor instead.
-func (*MessageFlag) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *MessageFlag) GetNested() bool {
-	if x != nil {
-		return x.Nested
-	}
-	return false
-}
-
-func (x *MessageFlag) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-// RepeatedFlags defines configuration for repeated fields (slices/arrays).
-//
-// This allows customization of how repeated values are handled in the CLI.
-// The type oneof field determines the element type of the repeated field.
-type RepeatedFlags struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Type:
-	//
-	//	*RepeatedFlags_Float
-	//	*RepeatedFlags_Double
-	//	*RepeatedFlags_Int32
-	//	*RepeatedFlags_Int64
-	//	*RepeatedFlags_Uint32
-	//	*RepeatedFlags_Uint64
-	//	*RepeatedFlags_Sint32
-	//	*RepeatedFlags_Sint64
-	//	*RepeatedFlags_Fixed32
-	//	*RepeatedFlags_Fixed64
-	//	*RepeatedFlags_Sfixed32
-	//	*RepeatedFlags_Sfixed64
-	//	*RepeatedFlags_Bool
-	//	*RepeatedFlags_String_
-	//	*RepeatedFlags_Bytes
-	//	*RepeatedFlags_Enum
-	//	*RepeatedFlags_Duration
-	//	*RepeatedFlags_Timestamp
-	Type isRepeatedFlags_Type `protobuf_oneof:"type"`
-}
-
-func (x *RepeatedFlags) Reset() {
-	*x = RepeatedFlags{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[39]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RepeatedFlags) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepeatedFlags) ProtoMessage() {}
-
-func (x *RepeatedFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[39]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepeatedFlags.ProtoReflect.Descriptor instead.
-func (*RepeatedFlags) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{39}
-}
-
-func (m *RepeatedFlags) GetType() isRepeatedFlags_Type {
-	if m != nil {
-		return m.Type
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetFloat() *RepeatedFloatFlag {
-	if x, ok := x.GetType().(*RepeatedFlags_Float); ok {
-		return x.Float
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetDouble() *RepeatedDoubleFlag {
-	if x, ok := x.GetType().(*RepeatedFlags_Double); ok {
-		return x.Double
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetInt32() *RepeatedInt32Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Int32); ok {
-		return x.Int32
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetInt64() *RepeatedInt64Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Int64); ok {
-		return x.Int64
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetUint32() *RepeatedUint32Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Uint32); ok {
-		return x.Uint32
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetUint64() *RepeatedUint64Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Uint64); ok {
-		return x.Uint64
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetSint32() *RepeatedSint32Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Sint32); ok {
-		return x.Sint32
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetSint64() *RepeatedSint64Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Sint64); ok {
-		return x.Sint64
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetFixed32() *RepeatedFixed32Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Fixed32); ok {
-		return x.Fixed32
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetFixed64() *RepeatedFixed64Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Fixed64); ok {
-		return x.Fixed64
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetSfixed32() *RepeatedSfixed32Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Sfixed32); ok {
-		return x.Sfixed32
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetSfixed64() *RepeatedSfixed64Flag {
-	if x, ok := x.GetType().(*RepeatedFlags_Sfixed64); ok {
-		return x.Sfixed64
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetBool() *RepeatedBoolFlag {
-	if x, ok := x.GetType().(*RepeatedFlags_Bool); ok {
-		return x.Bool
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetString_() *RepeatedStringFlag {
-	if x, ok := x.GetType().(*RepeatedFlags_String_); ok {
-		return x.String_
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetBytes() *RepeatedBytesFlag {
-	if x, ok := x.GetType().(*RepeatedFlags_Bytes); ok {
-		return x.Bytes
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetEnum() *RepeatedEnumFlag {
-	if x, ok := x.GetType().(*RepeatedFlags_Enum); ok {
-		return x.Enum
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetDuration() *RepeatedDurationFlag {
-	if x, ok := x.GetType().(*RepeatedFlags_Duration); ok {
-		return x.Duration
-	}
-	return nil
-}
-
-func (x *RepeatedFlags) GetTimestamp() *RepeatedTimestampFlag {
-	if x, ok := x.GetType().(*RepeatedFlags_Timestamp); ok {
-		return x.Timestamp
-	}
-	return nil
-}
-
-type isRepeatedFlags_Type interface {
-	isRepeatedFlags_Type()
-}
-
-type RepeatedFlags_Float struct {
-	// Supported types for repeated fields - each corresponds to its specific flag type
-	// configuration that controls how individual elements are handled.
-	Float *RepeatedFloatFlag `protobuf:"bytes,1,opt,name=float,proto3,oneof"` // Repeated 32-bit floating point
-}
-
-type RepeatedFlags_Double struct {
-	Double *RepeatedDoubleFlag `protobuf:"bytes,2,opt,name=double,proto3,oneof"` // Repeated 64-bit floating point
-}
-
-type RepeatedFlags_Int32 struct {
-	Int32 *RepeatedInt32Flag `protobuf:"bytes,3,opt,name=int32,proto3,oneof"` // Repeated 32-bit signed integer
-}
-
-type RepeatedFlags_Int64 struct {
-	Int64 *RepeatedInt64Flag `protobuf:"bytes,4,opt,name=int64,proto3,oneof"` // Repeated 64-bit signed integer
-}
-
-type RepeatedFlags_Uint32 struct {
-	Uint32 *RepeatedUint32Flag `protobuf:"bytes,5,opt,name=uint32,proto3,oneof"` // Repeated 32-bit unsigned integer
-}
-
-type RepeatedFlags_Uint64 struct {
-	Uint64 *RepeatedUint64Flag `protobuf:"bytes,6,opt,name=uint64,proto3,oneof"` // Repeated 64-bit unsigned integer
-}
-
-type RepeatedFlags_Sint32 struct {
-	Sint32 *RepeatedSint32Flag `protobuf:"bytes,7,opt,name=sint32,proto3,oneof"` // Repeated 32-bit signed integer (zigzag)
-}
-
-type RepeatedFlags_Sint64 struct {
-	Sint64 *RepeatedSint64Flag `protobuf:"bytes,8,opt,name=sint64,proto3,oneof"` // Repeated 64-bit signed integer (zigzag)
-}
-
-type RepeatedFlags_Fixed32 struct {
-	Fixed32 *RepeatedFixed32Flag `protobuf:"bytes,9,opt,name=fixed32,proto3,oneof"` // Repeated 32-bit fixed-point integer
-}
-
-type RepeatedFlags_Fixed64 struct {
-	Fixed64 *RepeatedFixed64Flag `protobuf:"bytes,10,opt,name=fixed64,proto3,oneof"` // Repeated 64-bit fixed-point integer
-}
-
-type RepeatedFlags_Sfixed32 struct {
-	Sfixed32 *RepeatedSfixed32Flag `protobuf:"bytes,11,opt,name=sfixed32,proto3,oneof"` // Repeated 32-bit signed fixed-point integer
-}
-
-type RepeatedFlags_Sfixed64 struct {
-	Sfixed64 *RepeatedSfixed64Flag `protobuf:"bytes,12,opt,name=sfixed64,proto3,oneof"` // Repeated 64-bit signed fixed-point integer
-}
-
-type RepeatedFlags_Bool struct {
-	Bool *RepeatedBoolFlag `protobuf:"bytes,13,opt,name=bool,proto3,oneof"` // Repeated boolean values
-}
-
-type RepeatedFlags_String_ struct {
-	String_ *RepeatedStringFlag `protobuf:"bytes,14,opt,name=string,proto3,oneof"` // Repeated UTF-8 strings
-}
-
-type RepeatedFlags_Bytes struct {
-	// Bytes field type with encoding type selection
-	Bytes *RepeatedBytesFlag `protobuf:"bytes,15,opt,name=bytes,proto3,oneof"` // Repeated arbitrary bytes with encoding format
-}
-
-type RepeatedFlags_Enum struct {
-	Enum *RepeatedEnumFlag `protobuf:"bytes,16,opt,name=enum,proto3,oneof"` // Repeated enum values
-}
-
-type RepeatedFlags_Duration struct {
-	Duration *RepeatedDurationFlag `protobuf:"bytes,17,opt,name=duration,proto3,oneof"` // Repeated duration values
-}
-
-type RepeatedFlags_Timestamp struct {
-	Timestamp *RepeatedTimestampFlag `protobuf:"bytes,18,opt,name=timestamp,proto3,oneof"` // Repeated timestamp values
-}
-
-func (*RepeatedFlags_Float) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Double) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Int32) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Int64) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Uint32) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Uint64) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Sint32) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Sint64) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Fixed32) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Fixed64) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Sfixed32) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Sfixed64) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Bool) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_String_) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Bytes) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Enum) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Duration) isRepeatedFlags_Type() {}
-
-func (*RepeatedFlags_Timestamp) isRepeatedFlags_Type() {}
-
-// FieldFlags encapsulates the flag configuration for each type of field.
-//
-// The oneof type field ensures type safety and allows the code generator to
-// determine which pflag method to use (StringVarP, IntVarP, etc.) for each
-// field type. Only one of the type fields should be set, corresponding to
-// the actual protobuf field type.
-type FieldFlags struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Type:
-	//
-	//	*FieldFlags_Float
-	//	*FieldFlags_Double
-	//	*FieldFlags_Int32
-	//	*FieldFlags_Int64
-	//	*FieldFlags_Uint32
-	//	*FieldFlags_Uint64
-	//	*FieldFlags_Sint32
-	//	*FieldFlags_Sint64
-	//	*FieldFlags_Fixed32
-	//	*FieldFlags_Fixed64
-	//	*FieldFlags_Sfixed32
-	//	*FieldFlags_Sfixed64
-	//	*FieldFlags_Bool
-	//	*FieldFlags_String_
-	//	*FieldFlags_Bytes
-	//	*FieldFlags_Enum
-	//	*FieldFlags_Repeated
-	//	*FieldFlags_Map
-	//	*FieldFlags_Duration
-	//	*FieldFlags_Timestamp
-	//	*FieldFlags_Message
-	Type isFieldFlags_Type `protobuf_oneof:"type"`
-}
-
-func (x *FieldFlags) Reset() {
-	*x = FieldFlags{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_flags_annotations_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *FieldFlags) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FieldFlags) ProtoMessage() {}
-
-func (x *FieldFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_flags_annotations_proto_msgTypes[40]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FieldFlags.ProtoReflect.Descriptor instead.
-func (*FieldFlags) Descriptor() ([]byte, []int) {
-	return file_flags_annotations_proto_rawDescGZIP(), []int{40}
-}
-
-func (m *FieldFlags) GetType() isFieldFlags_Type {
-	if m != nil {
-		return m.Type
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetFloat() *FloatFlag {
-	if x, ok := x.GetType().(*FieldFlags_Float); ok {
-		return x.Float
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetDouble() *DoubleFlag {
-	if x, ok := x.GetType().(*FieldFlags_Double); ok {
-		return x.Double
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetInt32() *Int32Flag {
-	if x, ok := x.GetType().(*FieldFlags_Int32); ok {
-		return x.Int32
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetInt64() *Int64Flag {
-	if x, ok := x.GetType().(*FieldFlags_Int64); ok {
-		return x.Int64
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetUint32() *Uint32Flag {
-	if x, ok := x.GetType().(*FieldFlags_Uint32); ok {
-		return x.Uint32
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetUint64() *Uint64Flag {
-	if x, ok := x.GetType().(*FieldFlags_Uint64); ok {
-		return x.Uint64
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetSint32() *Sint32Flag {
-	if x, ok := x.GetType().(*FieldFlags_Sint32); ok {
-		return x.Sint32
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetSint64() *Sint64Flag {
-	if x, ok := x.GetType().(*FieldFlags_Sint64); ok {
-		return x.Sint64
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetFixed32() *Fixed32Flag {
-	if x, ok := x.GetType().(*FieldFlags_Fixed32); ok {
-		return x.Fixed32
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetFixed64() *Fixed64Flag {
-	if x, ok := x.GetType().(*FieldFlags_Fixed64); ok {
-		return x.Fixed64
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetSfixed32() *Sfixed32Flag {
-	if x, ok := x.GetType().(*FieldFlags_Sfixed32); ok {
-		return x.Sfixed32
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetSfixed64() *Sfixed64Flag {
-	if x, ok := x.GetType().(*FieldFlags_Sfixed64); ok {
-		return x.Sfixed64
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetBool() *BoolFlag {
-	if x, ok := x.GetType().(*FieldFlags_Bool); ok {
-		return x.Bool
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetString_() *StringFlag {
-	if x, ok := x.GetType().(*FieldFlags_String_); ok {
-		return x.String_
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetBytes() *BytesFlag {
-	if x, ok := x.GetType().(*FieldFlags_Bytes); ok {
-		return x.Bytes
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetEnum() *EnumFlag {
-	if x, ok := x.GetType().(*FieldFlags_Enum); ok {
-		return x.Enum
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetRepeated() *RepeatedFlags {
-	if x, ok := x.GetType().(*FieldFlags_Repeated); ok {
-		return x.Repeated
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetMap() *MapFlag {
-	if x, ok := x.GetType().(*FieldFlags_Map); ok {
-		return x.Map
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetDuration() *DurationFlag {
-	if x, ok := x.GetType().(*FieldFlags_Duration); ok {
-		return x.Duration
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetTimestamp() *TimestampFlag {
-	if x, ok := x.GetType().(*FieldFlags_Timestamp); ok {
-		return x.Timestamp
-	}
-	return nil
-}
-
-func (x *FieldFlags) GetMessage() *MessageFlag {
-	if x, ok := x.GetType().(*FieldFlags_Message); ok {
-		return x.Message
-	}
-	return nil
-}
-
-type isFieldFlags_Type interface {
-	isFieldFlags_Type()
-}
-
-type FieldFlags_Float struct {
-	// Scalar Field Types - each type has its own flag configuration with default value support
-	Float *FloatFlag `protobuf:"bytes,1,opt,name=float,proto3,oneof"` // 32-bit floating point
-}
-
-type FieldFlags_Double struct {
-	Double *DoubleFlag `protobuf:"bytes,2,opt,name=double,proto3,oneof"` // 64-bit floating point
-}
-
-type FieldFlags_Int32 struct {
-	Int32 *Int32Flag `protobuf:"bytes,3,opt,name=int32,proto3,oneof"` // 32-bit signed integer
-}
-
-type FieldFlags_Int64 struct {
-	Int64 *Int64Flag `protobuf:"bytes,4,opt,name=int64,proto3,oneof"` // 64-bit signed integer
-}
-
-type FieldFlags_Uint32 struct {
-	Uint32 *Uint32Flag `protobuf:"bytes,5,opt,name=uint32,proto3,oneof"` // 32-bit unsigned integer
-}
-
-type FieldFlags_Uint64 struct {
-	Uint64 *Uint64Flag `protobuf:"bytes,6,opt,name=uint64,proto3,oneof"` // 64-bit unsigned integer
-}
-
-type FieldFlags_Sint32 struct {
-	Sint32 *Sint32Flag `protobuf:"bytes,7,opt,name=sint32,proto3,oneof"` // 32-bit signed integer (zigzag encoded)
-}
-
-type FieldFlags_Sint64 struct {
-	Sint64 *Sint64Flag `protobuf:"bytes,8,opt,name=sint64,proto3,oneof"` // 64-bit signed integer (zigzag encoded)
-}
-
-type FieldFlags_Fixed32 struct {
-	Fixed32 *Fixed32Flag `protobuf:"bytes,9,opt,name=fixed32,proto3,oneof"` // 32-bit fixed-point integer
-}
-
-type FieldFlags_Fixed64 struct {
-	Fixed64 *Fixed64Flag `protobuf:"bytes,10,opt,name=fixed64,proto3,oneof"` // 64-bit fixed-point integer
-}
-
-type FieldFlags_Sfixed32 struct {
-	Sfixed32 *Sfixed32Flag `protobuf:"bytes,11,opt,name=sfixed32,proto3,oneof"` // 32-bit signed fixed-point integer
-}
-
-type FieldFlags_Sfixed64 struct {
-	Sfixed64 *Sfixed64Flag `protobuf:"bytes,12,opt,name=sfixed64,proto3,oneof"` // 64-bit signed fixed-point integer
-}
-
-type FieldFlags_Bool struct {
-	Bool *BoolFlag `protobuf:"bytes,13,opt,name=bool,proto3,oneof"` // Boolean value
-}
-
-type FieldFlags_String_ struct {
-	String_ *StringFlag `protobuf:"bytes,14,opt,name=string,proto3,oneof"` // UTF-8 string
-}
-
-type FieldFlags_Bytes struct {
-	// Bytes field type with encoding type selection
-	Bytes *BytesFlag `protobuf:"bytes,15,opt,name=bytes,proto3,oneof"` // Arbitrary bytes with encoding format
-}
-
-type FieldFlags_Enum struct {
-	// Complex Field Types
-	Enum *EnumFlag `protobuf:"bytes,16,opt,name=enum,proto3,oneof"` // Enum type
-}
-
-type FieldFlags_Repeated struct {
-	Repeated *RepeatedFlags `protobuf:"bytes,17,opt,name=repeated,proto3,oneof"` // Repeated field (slice/array)
-}
-
-type FieldFlags_Map struct {
-	Map *MapFlag `protobuf:"bytes,18,opt,name=map,proto3,oneof"` // Map field
-}
-
-type FieldFlags_Duration struct {
-	// Well-Known Field Types
-	Duration *DurationFlag `protobuf:"bytes,19,opt,name=duration,proto3,oneof"` // google.protobuf.Duration
-}
-
-type FieldFlags_Timestamp struct {
-	Timestamp *TimestampFlag `protobuf:"bytes,20,opt,name=timestamp,proto3,oneof"` // google.protobuf.Timestamp
-}
-
-type FieldFlags_Message struct {
-	// Message field type - used for nested message configurations
-	Message *MessageFlag `protobuf:"bytes,21,opt,name=message,proto3,oneof"`
-}
-
-func (*FieldFlags_Float) isFieldFlags_Type() {}
-
-func (*FieldFlags_Double) isFieldFlags_Type() {}
-
-func (*FieldFlags_Int32) isFieldFlags_Type() {}
-
-func (*FieldFlags_Int64) isFieldFlags_Type() {}
-
-func (*FieldFlags_Uint32) isFieldFlags_Type() {}
-
-func (*FieldFlags_Uint64) isFieldFlags_Type() {}
-
-func (*FieldFlags_Sint32) isFieldFlags_Type() {}
-
-func (*FieldFlags_Sint64) isFieldFlags_Type() {}
-
-func (*FieldFlags_Fixed32) isFieldFlags_Type() {}
-
-func (*FieldFlags_Fixed64) isFieldFlags_Type() {}
-
-func (*FieldFlags_Sfixed32) isFieldFlags_Type() {}
-
-func (*FieldFlags_Sfixed64) isFieldFlags_Type() {}
-
-func (*FieldFlags_Bool) isFieldFlags_Type() {}
-
-func (*FieldFlags_String_) isFieldFlags_Type() {}
-
-func (*FieldFlags_Bytes) isFieldFlags_Type() {}
-
-func (*FieldFlags_Enum) isFieldFlags_Type() {}
-
-func (*FieldFlags_Repeated) isFieldFlags_Type() {}
-
-func (*FieldFlags_Map) isFieldFlags_Type() {}
-
-func (*FieldFlags_Duration) isFieldFlags_Type() {}
-
-func (*FieldFlags_Timestamp) isFieldFlags_Type() {}
-
-func (*FieldFlags_Message) isFieldFlags_Type() {}
-
-var file_flags_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
-	{
-		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
-		ExtensionType: (*bool)(nil),
-		Field:         1171,
-		Name:          "flags.disabled",
-		Tag:           "varint,1171,opt,name=disabled",
-		Filename:      "flags/annotations.proto",
-	},
-	{
-		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
-		ExtensionType: (*bool)(nil),
-		Field:         1172,
-		Name:          "flags.unexported",
-		Tag:           "varint,1172,opt,name=unexported",
-		Filename:      "flags/annotations.proto",
-	},
-	{
-		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
-		ExtensionType: (*bool)(nil),
-		Field:         1173,
-		Name:          "flags.allow_empty",
-		Tag:           "varint,1173,opt,name=allow_empty",
-		Filename:      "flags/annotations.proto",
-	},
-	{
-		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
-		ExtensionType: (*FieldFlags)(nil),
-		Field:         1171,
-		Name:          "flags.value",
-		Tag:           "bytes,1171,opt,name=value",
-		Filename:      "flags/annotations.proto",
-	},
-}
-
-// Extension fields to descriptorpb.MessageOptions.
-var (
-	// Disabled skips generation of flags for this entire message when set to true.
-	// This takes precedence over all other flag generation options.
-	//
-	// optional bool disabled = 1171;
-	E_Disabled = &file_flags_annotations_proto_extTypes[0]
-	// Unexported generates unexported flag methods for this message when set to true.
-	// This is useful when you want to provide custom exported methods that call
-	// the generated unexported methods.
-	//
-	// optional bool unexported = 1172;
-	E_Unexported = &file_flags_annotations_proto_extTypes[1]
-	// AllowEmpty allows generation of AddFlags methods even when the message has
-	// no fields configured with flag options. This is useful for messages that
-	// want to provide a consistent interface or may have flags added in the future.
-	//
-	// optional bool allow_empty = 1173;
-	E_AllowEmpty = &file_flags_annotations_proto_extTypes[2]
-)
-
-// Extension fields to descriptorpb.FieldOptions.
-var (
-	// Value specifies the flag configuration for this field. When set, the
-	// protoc-gen-flags plugin will generate command-line flag bindings based
-	// on the field type and configuration provided.
-	//
-	// optional flags.FieldFlags value = 1171;
-	E_Value = &file_flags_annotations_proto_extTypes[3]
-)
-
-var File_flags_annotations_proto protoreflect.FileDescriptor
-
-var file_flags_annotations_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xab, 0x02, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x34, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x63,
-	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x22, 0xce, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x46, 0x6c,
-	0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0xf5, 0x01, 0x0a, 0x09, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d,
-	0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x48,
-	0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x0a, 0x44, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x22, 0xf5, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xf5, 0x01, 0x0a, 0x09, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x0a, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61,
-	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0d, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x0a,
-	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29,
-	0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46,
-	0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01,
-	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xf6, 0x01,
-	0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64,
-	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xf7, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x07, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x22, 0xf7, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x06,
-	0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x0c, 0x53,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
-	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0f, 0x48, 0x00, 0x52, 0x07, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x0c, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x10, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x22, 0xf4, 0x01, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x22, 0xec, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x02, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
-	0xed, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x6f, 0x75, 0x62,
-	0x6c, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
-	0xec, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xec,
-	0x01, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xed, 0x01,
-	0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xed, 0x01,
-	0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xed, 0x01,
-	0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xed, 0x01,
-	0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xee, 0x01,
-	0x0a, 0x13, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x07, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xee,
-	0x01, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x06, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
-	0xef, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0f, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x22, 0xef, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29,
-	0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x10, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x22, 0xed, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x22, 0xa2, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69,
-	0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
-	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x6c, 0x61, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x6c, 0x61, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xa1, 0x02, 0x0a, 0x07, 0x4d,
-	0x61, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x61, 0x70,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xf8,
-	0x01, 0x0a, 0x0c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d,
-	0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x0d, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
-	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
-	0x39, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xda, 0x07, 0x0a, 0x0d, 0x52,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x30, 0x0a, 0x05,
-	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x33,
-	0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x05,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00,
-	0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
-	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c,
-	0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x33, 0x0a, 0x06,
-	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06,
-	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61,
-	0x67, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x36, 0x0a, 0x07, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67,
-	0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x39, 0x0a, 0x08, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x08, 0x73, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x12, 0x2d, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c,
-	0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00,
-	0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65,
-	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00,
-	0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x3c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x6c,
-	0x61, 0x67, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xcf, 0x07, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74,
-	0x12, 0x2b, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46,
-	0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x28, 0x0a,
-	0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00,
-	0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x12, 0x2b, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2b,
-	0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61,
-	0x67, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x06, 0x73,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00,
-	0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x2e, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x73,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x08,
-	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6c, 0x61,
-	0x67, 0x73, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48,
-	0x00, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x25, 0x0a, 0x04, 0x62,
-	0x6f, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f,
-	0x6f, 0x6c, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x28, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67,
-	0x48, 0x00, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x65, 0x6e, 0x75,
-	0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d,
-	0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x6c, 0x61,
-	0x67, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6c, 0x61,
-	0x67, 0x73, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x48,
-	0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x75, 0x0a, 0x11, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23,
-	0x0a, 0x1f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43,
-	0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x36,
-	0x34, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43,
-	0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x45, 0x58, 0x10, 0x02,
-	0x2a, 0x93, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x24, 0x0a,
-	0x20, 0x4d, 0x41, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e,
-	0x47, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x41, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f,
-	0x5f, 0x49, 0x4e, 0x54, 0x10, 0x03, 0x3a, 0x3c, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x3a, 0x40, 0x0a, 0x0a, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x94, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x65, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x3a, 0x41, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x95, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x3a, 0x47, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6b, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x3b, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_flags_annotations_proto_rawDescOnce sync.Once
-	file_flags_annotations_proto_rawDescData = file_flags_annotations_proto_rawDesc
-)
-
-func file_flags_annotations_proto_rawDescGZIP() []byte {
-	file_flags_annotations_proto_rawDescOnce.Do(func() {
-		file_flags_annotations_proto_rawDescData = protoimpl.X.CompressGZIP(file_flags_annotations_proto_rawDescData)
-	})
-	return file_flags_annotations_proto_rawDescData
-}
-
-var file_flags_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_flags_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
-var file_flags_annotations_proto_goTypes = []interface{}{
-	(BytesEncodingType)(0),              // 0: flags.BytesEncodingType
-	(MapFormatType)(0),                  // 1: flags.MapFormatType
-	(*BytesFlag)(nil),                   // 2: flags.BytesFlag
-	(*PrimitiveFlag)(nil),               // 3: flags.PrimitiveFlag
-	(*FloatFlag)(nil),                   // 4: flags.FloatFlag
-	(*DoubleFlag)(nil),                  // 5: flags.DoubleFlag
-	(*Int32Flag)(nil),                   // 6: flags.Int32Flag
-	(*Int64Flag)(nil),                   // 7: flags.Int64Flag
-	(*Uint32Flag)(nil),                  // 8: flags.Uint32Flag
-	(*Uint64Flag)(nil),                  // 9: flags.Uint64Flag
-	(*Sint32Flag)(nil),                  // 10: flags.Sint32Flag
-	(*Sint64Flag)(nil),                  // 11: flags.Sint64Flag
-	(*Fixed32Flag)(nil),                 // 12: flags.Fixed32Flag
-	(*Fixed64Flag)(nil),                 // 13: flags.Fixed64Flag
-	(*Sfixed32Flag)(nil),                // 14: flags.Sfixed32Flag
-	(*Sfixed64Flag)(nil),                // 15: flags.Sfixed64Flag
-	(*BoolFlag)(nil),                    // 16: flags.BoolFlag
-	(*StringFlag)(nil),                  // 17: flags.StringFlag
-	(*RepeatedFloatFlag)(nil),           // 18: flags.RepeatedFloatFlag
-	(*RepeatedDoubleFlag)(nil),          // 19: flags.RepeatedDoubleFlag
-	(*RepeatedInt32Flag)(nil),           // 20: flags.RepeatedInt32Flag
-	(*RepeatedInt64Flag)(nil),           // 21: flags.RepeatedInt64Flag
-	(*RepeatedUint32Flag)(nil),          // 22: flags.RepeatedUint32Flag
-	(*RepeatedUint64Flag)(nil),          // 23: flags.RepeatedUint64Flag
-	(*RepeatedSint32Flag)(nil),          // 24: flags.RepeatedSint32Flag
-	(*RepeatedSint64Flag)(nil),          // 25: flags.RepeatedSint64Flag
-	(*RepeatedFixed32Flag)(nil),         // 26: flags.RepeatedFixed32Flag
-	(*RepeatedFixed64Flag)(nil),         // 27: flags.RepeatedFixed64Flag
-	(*RepeatedSfixed32Flag)(nil),        // 28: flags.RepeatedSfixed32Flag
-	(*RepeatedSfixed64Flag)(nil),        // 29: flags.RepeatedSfixed64Flag
-	(*RepeatedBoolFlag)(nil),            // 30: flags.RepeatedBoolFlag
-	(*RepeatedStringFlag)(nil),          // 31: flags.RepeatedStringFlag
-	(*RepeatedBytesFlag)(nil),           // 32: flags.RepeatedBytesFlag
-	(*RepeatedEnumFlag)(nil),            // 33: flags.RepeatedEnumFlag
-	(*RepeatedDurationFlag)(nil),        // 34: flags.RepeatedDurationFlag
-	(*RepeatedTimestampFlag)(nil),       // 35: flags.RepeatedTimestampFlag
-	(*EnumFlag)(nil),                    // 36: flags.EnumFlag
-	(*MapFlag)(nil),                     // 37: flags.MapFlag
-	(*DurationFlag)(nil),                // 38: flags.DurationFlag
-	(*TimestampFlag)(nil),               // 39: flags.TimestampFlag
-	(*MessageFlag)(nil),                 // 40: flags.MessageFlag
-	(*RepeatedFlags)(nil),               // 41: flags.RepeatedFlags
-	(*FieldFlags)(nil),                  // 42: flags.FieldFlags
-	(*descriptorpb.MessageOptions)(nil), // 43: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),   // 44: google.protobuf.FieldOptions
-}
-var file_flags_annotations_proto_depIdxs = []int32{
-	0,  // 0: flags.BytesFlag.encoding:type_name -> flags.BytesEncodingType
-	0,  // 1: flags.RepeatedBytesFlag.encoding:type_name -> flags.BytesEncodingType
-	1,  // 2: flags.MapFlag.format:type_name -> flags.MapFormatType
-	18, // 3: flags.RepeatedFlags.float:type_name -> flags.RepeatedFloatFlag
-	19, // 4: flags.RepeatedFlags.double:type_name -> flags.RepeatedDoubleFlag
-	20, // 5: flags.RepeatedFlags.int32:type_name -> flags.RepeatedInt32Flag
-	21, // 6: flags.RepeatedFlags.int64:type_name -> flags.RepeatedInt64Flag
-	22, // 7: flags.RepeatedFlags.uint32:type_name -> flags.RepeatedUint32Flag
-	23, // 8: flags.RepeatedFlags.uint64:type_name -> flags.RepeatedUint64Flag
-	24, // 9: flags.RepeatedFlags.sint32:type_name -> flags.RepeatedSint32Flag
-	25, // 10: flags.RepeatedFlags.sint64:type_name -> flags.RepeatedSint64Flag
-	26, // 11: flags.RepeatedFlags.fixed32:type_name -> flags.RepeatedFixed32Flag
-	27, // 12: flags.RepeatedFlags.fixed64:type_name -> flags.RepeatedFixed64Flag
-	28, // 13: flags.RepeatedFlags.sfixed32:type_name -> flags.RepeatedSfixed32Flag
-	29, // 14: flags.RepeatedFlags.sfixed64:type_name -> flags.RepeatedSfixed64Flag
-	30, // 15: flags.RepeatedFlags.bool:type_name -> flags.RepeatedBoolFlag
-	31, // 16: flags.RepeatedFlags.string:type_name -> flags.RepeatedStringFlag
-	32, // 17: flags.RepeatedFlags.bytes:type_name -> flags.RepeatedBytesFlag
-	33, // 18: flags.RepeatedFlags.enum:type_name -> flags.RepeatedEnumFlag
-	34, // 19: flags.RepeatedFlags.duration:type_name -> flags.RepeatedDurationFlag
-	35, // 20: flags.RepeatedFlags.timestamp:type_name -> flags.RepeatedTimestampFlag
-	4,  // 21: flags.FieldFlags.float:type_name -> flags.FloatFlag
-	5,  // 22: flags.FieldFlags.double:type_name -> flags.DoubleFlag
-	6,  // 23: flags.FieldFlags.int32:type_name -> flags.Int32Flag
-	7,  // 24: flags.FieldFlags.int64:type_name -> flags.Int64Flag
-	8,  // 25: flags.FieldFlags.uint32:type_name -> flags.Uint32Flag
-	9,  // 26: flags.FieldFlags.uint64:type_name -> flags.Uint64Flag
-	10, // 27: flags.FieldFlags.sint32:type_name -> flags.Sint32Flag
-	11, // 28: flags.FieldFlags.sint64:type_name -> flags.Sint64Flag
-	12, // 29: flags.FieldFlags.fixed32:type_name -> flags.Fixed32Flag
-	13, // 30: flags.FieldFlags.fixed64:type_name -> flags.Fixed64Flag
-	14, // 31: flags.FieldFlags.sfixed32:type_name -> flags.Sfixed32Flag
-	15, // 32: flags.FieldFlags.sfixed64:type_name -> flags.Sfixed64Flag
-	16, // 33: flags.FieldFlags.bool:type_name -> flags.BoolFlag
-	17, // 34: flags.FieldFlags.string:type_name -> flags.StringFlag
-	2,  // 35: flags.FieldFlags.bytes:type_name -> flags.BytesFlag
-	36, // 36: flags.FieldFlags.enum:type_name -> flags.EnumFlag
-	41, // 37: flags.FieldFlags.repeated:type_name -> flags.RepeatedFlags
-	37, // 38: flags.FieldFlags.map:type_name -> flags.MapFlag
-	38, // 39: flags.FieldFlags.duration:type_name -> flags.DurationFlag
-	39, // 40: flags.FieldFlags.timestamp:type_name -> flags.TimestampFlag
-	40, // 41: flags.FieldFlags.message:type_name -> flags.MessageFlag
-	43, // 42: flags.disabled:extendee -> google.protobuf.MessageOptions
-	43, // 43: flags.unexported:extendee -> google.protobuf.MessageOptions
-	43, // 44: flags.allow_empty:extendee -> google.protobuf.MessageOptions
-	44, // 45: flags.value:extendee -> google.protobuf.FieldOptions
-	42, // 46: flags.value:type_name -> flags.FieldFlags
-	47, // [47:47] is the sub-list for method output_type
-	47, // [47:47] is the sub-list for method input_type
-	46, // [46:47] is the sub-list for extension type_name
-	42, // [42:46] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
-}
-
-func init() { file_flags_annotations_proto_init() }
-func file_flags_annotations_proto_init() {
-	if File_flags_annotations_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_flags_annotations_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BytesFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrimitiveFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FloatFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DoubleFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Int32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Int64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Uint32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Uint64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sint32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sint64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fixed32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fixed64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sfixed32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sfixed64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoolFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedFloatFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedDoubleFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedInt32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedInt64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedUint32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedUint64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedSint32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedSint64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedFixed32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedFixed64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedSfixed32Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedSfixed64Flag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedBoolFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedStringFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedBytesFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedEnumFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedDurationFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedTimestampFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurationFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimestampFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageFlag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedFlags); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_flags_annotations_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldFlags); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	file_flags_annotations_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[3].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[7].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[8].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[11].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[13].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[14].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[15].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[34].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[35].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[36].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[37].OneofWrappers = []interface{}{}
-	file_flags_annotations_proto_msgTypes[39].OneofWrappers = []interface{}{
-		(*RepeatedFlags_Float)(nil),
-		(*RepeatedFlags_Double)(nil),
-		(*RepeatedFlags_Int32)(nil),
-		(*RepeatedFlags_Int64)(nil),
-		(*RepeatedFlags_Uint32)(nil),
-		(*RepeatedFlags_Uint64)(nil),
-		(*RepeatedFlags_Sint32)(nil),
-		(*RepeatedFlags_Sint64)(nil),
-		(*RepeatedFlags_Fixed32)(nil),
-		(*RepeatedFlags_Fixed64)(nil),
-		(*RepeatedFlags_Sfixed32)(nil),
-		(*RepeatedFlags_Sfixed64)(nil),
-		(*RepeatedFlags_Bool)(nil),
-		(*RepeatedFlags_String_)(nil),
-		(*RepeatedFlags_Bytes)(nil),
-		(*RepeatedFlags_Enum)(nil),
-		(*RepeatedFlags_Duration)(nil),
-		(*RepeatedFlags_Timestamp)(nil),
-	}
-	file_flags_annotations_proto_msgTypes[40].OneofWrappers = []interface{}{
-		(*FieldFlags_Float)(nil),
-		(*FieldFlags_Double)(nil),
-		(*FieldFlags_Int32)(nil),
-		(*FieldFlags_Int64)(nil),
-		(*FieldFlags_Uint32)(nil),
-		(*FieldFlags_Uint64)(nil),
-		(*FieldFlags_Sint32)(nil),
-		(*FieldFlags_Sint64)(nil),
-		(*FieldFlags_Fixed32)(nil),
-		(*FieldFlags_Fixed64)(nil),
-		(*FieldFlags_Sfixed32)(nil),
-		(*FieldFlags_Sfixed64)(nil),
-		(*FieldFlags_Bool)(nil),
-		(*FieldFlags_String_)(nil),
-		(*FieldFlags_Bytes)(nil),
-		(*FieldFlags_Enum)(nil),
-		(*FieldFlags_Repeated)(nil),
-		(*FieldFlags_Map)(nil),
-		(*FieldFlags_Duration)(nil),
-		(*FieldFlags_Timestamp)(nil),
-		(*FieldFlags_Message)(nil),
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_flags_annotations_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   41,
-			NumExtensions: 4,
-			NumServices:   0,
-		},
-		GoTypes:           file_flags_annotations_proto_goTypes,
-		DependencyIndexes: file_flags_annotations_proto_depIdxs,
-		EnumInfos:         file_flags_annotations_proto_enumTypes,
-		MessageInfos:      file_flags_annotations_proto_msgTypes,
-		ExtensionInfos:    file_flags_annotations_proto_extTypes,
-	}.Build()
-	File_flags_annotations_proto = out.File
-	file_flags_annotations_proto_rawDesc = nil
-	file_flags_annotations_proto_goTypes = nil
-	file_flags_annotations_proto_depIdxs = nil
-}
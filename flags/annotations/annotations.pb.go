@@ -0,0 +1,10570 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com>  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flags provides protobuf extensions for generating command-line flag bindings.
+//
+// This package defines custom protobuf extensions that can be used to configure
+// how AddFlags methods are generated for protobuf messages and fields. The generated code
+// integrates with the spf13/pflag library to provide POSIX/GNU-style command-line
+// flag parsing.
+//
+// # Usage Example
+//
+// To use these extensions in your protobuf files, import this annotations file and
+// apply the options to your messages and fields:
+//
+//   syntax = "proto3";
+//   import "flags/annotations.proto";
+//
+//   message MyConfig {
+//     string name = 1 [(flags.value).string = { name: "name" short: "n" usage: "Name value" }];
+//     int32 port = 2 [(flags.value).int32 = { default: 8080 }];
+//   }
+//
+// # Message Level Options
+//
+// Message-level options control the overall flag generation behavior for a message:
+//
+//   option (flags.disabled) = true;     // Skip flag generation for this message
+//   option (flags.unexported) = true;   // Generate unexported flag methods
+//   option (flags.allow_empty) = true;  // Generate flags even without field configurations
+//
+// # Field Level Options
+//
+// Field-level options configure individual flag behavior:
+//
+//   string name = 1 [(flags.value).string = {
+//     name: "custom-name"
+//     short: "n"
+//     usage: "Custom usage description"
+//     default: "default-value"
+//   }];
+//
+// # Supported Types
+//
+// All protobuf scalar types are supported, including:
+//   - Numeric types: int32, int64, uint32, uint64, float, double, etc.
+//   - String and bytes
+//   - Boolean
+//   - Enum types
+//   - Repeated fields
+//   - Well-known types: duration, timestamp
+//
+// # Go Package
+//
+// The Go code of these extensions is generated into the
+// github.com/kunstack/protoc-gen-flags/flags/annotations package, which depends only on
+// protobuf, so that the .pb.go files of the annotated messages do not import pflag. The flag
+// bindings generated into the .pb.flags.go files use the github.com/kunstack/protoc-gen-flags/flags
+// package.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: flags/annotations.proto
+
+// buf:lint:ignore PACKAGE_VERSION_SUFFIX
+// buf:lint:ignore PACKAGE_SAME_DIRECTORY
+
+package annotations
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BytesEncodingType specifies the encoding format for bytes fields in command-line flags.
+type BytesEncodingType int32
+
+const (
+	// BYTES_ENCODING_TYPE_UNSPECIFIED uses the default base64 encoding.
+	BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED BytesEncodingType = 0
+	// BYTES_ENCODING_TYPE_BASE64 uses standard base64 encoding for bytes fields.
+	BytesEncodingType_BYTES_ENCODING_TYPE_BASE64 BytesEncodingType = 1
+	// BYTES_ENCODING_TYPE_HEX uses hexadecimal encoding for bytes fields.
+	BytesEncodingType_BYTES_ENCODING_TYPE_HEX BytesEncodingType = 2
+	// BYTES_ENCODING_TYPE_BASE64_URL uses padded base64 encoding with the URL and file name safe alphabet.
+	BytesEncodingType_BYTES_ENCODING_TYPE_BASE64_URL BytesEncodingType = 3
+	// BYTES_ENCODING_TYPE_BASE64_RAW uses standard base64 encoding without padding.
+	BytesEncodingType_BYTES_ENCODING_TYPE_BASE64_RAW BytesEncodingType = 4
+	// BYTES_ENCODING_TYPE_BASE64_RAW_URL uses base64 encoding with the URL alphabet and without
+	// padding, as found in JWTs.
+	BytesEncodingType_BYTES_ENCODING_TYPE_BASE64_RAW_URL BytesEncodingType = 5
+	// BYTES_ENCODING_TYPE_UTF8 takes the argument as plain UTF-8 text.
+	BytesEncodingType_BYTES_ENCODING_TYPE_UTF8 BytesEncodingType = 6
+	// BYTES_ENCODING_TYPE_FILE takes the argument as a path and reads the raw contents of the file,
+	// e.g. --cert=/etc/tls/cert.pem. Defaults are not supported.
+	BytesEncodingType_BYTES_ENCODING_TYPE_FILE BytesEncodingType = 7
+)
+
+// Enum value maps for BytesEncodingType.
+var (
+	BytesEncodingType_name = map[int32]string{
+		0: "BYTES_ENCODING_TYPE_UNSPECIFIED",
+		1: "BYTES_ENCODING_TYPE_BASE64",
+		2: "BYTES_ENCODING_TYPE_HEX",
+		3: "BYTES_ENCODING_TYPE_BASE64_URL",
+		4: "BYTES_ENCODING_TYPE_BASE64_RAW",
+		5: "BYTES_ENCODING_TYPE_BASE64_RAW_URL",
+		6: "BYTES_ENCODING_TYPE_UTF8",
+		7: "BYTES_ENCODING_TYPE_FILE",
+	}
+	BytesEncodingType_value = map[string]int32{
+		"BYTES_ENCODING_TYPE_UNSPECIFIED":    0,
+		"BYTES_ENCODING_TYPE_BASE64":         1,
+		"BYTES_ENCODING_TYPE_HEX":            2,
+		"BYTES_ENCODING_TYPE_BASE64_URL":     3,
+		"BYTES_ENCODING_TYPE_BASE64_RAW":     4,
+		"BYTES_ENCODING_TYPE_BASE64_RAW_URL": 5,
+		"BYTES_ENCODING_TYPE_UTF8":           6,
+		"BYTES_ENCODING_TYPE_FILE":           7,
+	}
+)
+
+func (x BytesEncodingType) Enum() *BytesEncodingType {
+	p := new(BytesEncodingType)
+	*p = x
+	return p
+}
+
+func (x BytesEncodingType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BytesEncodingType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flags_annotations_proto_enumTypes[0].Descriptor()
+}
+
+func (BytesEncodingType) Type() protoreflect.EnumType {
+	return &file_flags_annotations_proto_enumTypes[0]
+}
+
+func (x BytesEncodingType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BytesEncodingType.Descriptor instead.
+func (BytesEncodingType) EnumDescriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+// SplitType specifies how a single command-line argument of a repeated flag is
+// broken into individual items.
+type SplitType int32
+
+const (
+	// SPLIT_TYPE_UNSPECIFIED keeps the historical behavior of each element type:
+	// comma separated for numbers, durations and native string slices, and one
+	// item per argument for wrapper strings, bytes, enums and timestamps.
+	SplitType_SPLIT_TYPE_UNSPECIFIED SplitType = 0
+	// SPLIT_TYPE_CSV parses each argument as a CSV record (like pflag StringSlice),
+	// so items may be quoted to contain commas: --tags='a,"b,c"'.
+	SplitType_SPLIT_TYPE_CSV SplitType = 1
+	// SPLIT_TYPE_ARRAY never splits; each argument is exactly one item
+	// (like pflag StringArray): --tags=a --tags=b,c.
+	SplitType_SPLIT_TYPE_ARRAY SplitType = 2
+	// SPLIT_TYPE_SEPARATOR splits each argument on the configured separator.
+	SplitType_SPLIT_TYPE_SEPARATOR SplitType = 3
+	// SPLIT_TYPE_JSON parses each argument as a JSON array: --tags='["a","b"]'.
+	SplitType_SPLIT_TYPE_JSON SplitType = 4
+)
+
+// Enum value maps for SplitType.
+var (
+	SplitType_name = map[int32]string{
+		0: "SPLIT_TYPE_UNSPECIFIED",
+		1: "SPLIT_TYPE_CSV",
+		2: "SPLIT_TYPE_ARRAY",
+		3: "SPLIT_TYPE_SEPARATOR",
+		4: "SPLIT_TYPE_JSON",
+	}
+	SplitType_value = map[string]int32{
+		"SPLIT_TYPE_UNSPECIFIED": 0,
+		"SPLIT_TYPE_CSV":         1,
+		"SPLIT_TYPE_ARRAY":       2,
+		"SPLIT_TYPE_SEPARATOR":   3,
+		"SPLIT_TYPE_JSON":        4,
+	}
+)
+
+func (x SplitType) Enum() *SplitType {
+	p := new(SplitType)
+	*p = x
+	return p
+}
+
+func (x SplitType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flags_annotations_proto_enumTypes[1].Descriptor()
+}
+
+func (SplitType) Type() protoreflect.EnumType {
+	return &file_flags_annotations_proto_enumTypes[1]
+}
+
+func (x SplitType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitType.Descriptor instead.
+func (SplitType) EnumDescriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{1}
+}
+
+// MergeType defines how the items given on the command line for a repeated flag
+// are combined with its default values. Passing an empty argument (e.g. --tags=)
+// always clears the list.
+type MergeType int32
+
+const (
+	// MERGE_TYPE_UNSPECIFIED keeps the historical behavior, same as MERGE_TYPE_REPLACE.
+	MergeType_MERGE_TYPE_UNSPECIFIED MergeType = 0
+	// MERGE_TYPE_REPLACE discards the defaults on the first occurrence of the flag;
+	// later occurrences append.
+	MergeType_MERGE_TYPE_REPLACE MergeType = 1
+	// MERGE_TYPE_APPEND keeps the defaults and appends the command-line items after them.
+	MergeType_MERGE_TYPE_APPEND MergeType = 2
+	// MERGE_TYPE_PREPEND keeps the defaults and inserts the command-line items before them.
+	MergeType_MERGE_TYPE_PREPEND MergeType = 3
+)
+
+// Enum value maps for MergeType.
+var (
+	MergeType_name = map[int32]string{
+		0: "MERGE_TYPE_UNSPECIFIED",
+		1: "MERGE_TYPE_REPLACE",
+		2: "MERGE_TYPE_APPEND",
+		3: "MERGE_TYPE_PREPEND",
+	}
+	MergeType_value = map[string]int32{
+		"MERGE_TYPE_UNSPECIFIED": 0,
+		"MERGE_TYPE_REPLACE":     1,
+		"MERGE_TYPE_APPEND":      2,
+		"MERGE_TYPE_PREPEND":     3,
+	}
+)
+
+func (x MergeType) Enum() *MergeType {
+	p := new(MergeType)
+	*p = x
+	return p
+}
+
+func (x MergeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flags_annotations_proto_enumTypes[2].Descriptor()
+}
+
+func (MergeType) Type() protoreflect.EnumType {
+	return &file_flags_annotations_proto_enumTypes[2]
+}
+
+func (x MergeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeType.Descriptor instead.
+func (MergeType) EnumDescriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{2}
+}
+
+// UnitType specifies the unit suffixes accepted by a numeric flag.
+type UnitType int32
+
+const (
+	// UNIT_TYPE_UNSPECIFIED accepts plain numbers only.
+	UnitType_UNIT_TYPE_UNSPECIFIED UnitType = 0
+	// UNIT_TYPE_BYTES accepts byte sizes with binary (KiB, MiB, GiB, ... or Ki, Mi, Gi, ...)
+	// and decimal (KB, MB, GB, ...) suffixes, case-insensitively: --max-memory=512MiB.
+	// Negative values are rejected.
+	UnitType_UNIT_TYPE_BYTES UnitType = 1
+	// UNIT_TYPE_SI accepts SI multipliers k/K, M, G, T, P, E and, for fractional
+	// values, m, u and n: --rate=10k.
+	UnitType_UNIT_TYPE_SI UnitType = 2
+	// UNIT_TYPE_PERCENT accepts percentages on float and double fields, "80%" is 0.8.
+	// Plain numbers are taken as ratios.
+	UnitType_UNIT_TYPE_PERCENT UnitType = 3
+)
+
+// Enum value maps for UnitType.
+var (
+	UnitType_name = map[int32]string{
+		0: "UNIT_TYPE_UNSPECIFIED",
+		1: "UNIT_TYPE_BYTES",
+		2: "UNIT_TYPE_SI",
+		3: "UNIT_TYPE_PERCENT",
+	}
+	UnitType_value = map[string]int32{
+		"UNIT_TYPE_UNSPECIFIED": 0,
+		"UNIT_TYPE_BYTES":       1,
+		"UNIT_TYPE_SI":          2,
+		"UNIT_TYPE_PERCENT":     3,
+	}
+)
+
+func (x UnitType) Enum() *UnitType {
+	p := new(UnitType)
+	*p = x
+	return p
+}
+
+func (x UnitType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnitType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flags_annotations_proto_enumTypes[3].Descriptor()
+}
+
+func (UnitType) Type() protoreflect.EnumType {
+	return &file_flags_annotations_proto_enumTypes[3]
+}
+
+func (x UnitType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnitType.Descriptor instead.
+func (UnitType) EnumDescriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{3}
+}
+
+// DurationUnitType specifies the unit an integer field stores a duration in.
+type DurationUnitType int32
+
+const (
+	// DURATION_UNIT_TYPE_UNSPECIFIED is only valid on google.protobuf.Duration fields.
+	DurationUnitType_DURATION_UNIT_TYPE_UNSPECIFIED DurationUnitType = 0
+	// DURATION_UNIT_TYPE_NANOSECONDS stores the duration in nanoseconds.
+	DurationUnitType_DURATION_UNIT_TYPE_NANOSECONDS DurationUnitType = 1
+	// DURATION_UNIT_TYPE_MICROSECONDS stores the duration in microseconds.
+	DurationUnitType_DURATION_UNIT_TYPE_MICROSECONDS DurationUnitType = 2
+	// DURATION_UNIT_TYPE_MILLISECONDS stores the duration in milliseconds.
+	DurationUnitType_DURATION_UNIT_TYPE_MILLISECONDS DurationUnitType = 3
+	// DURATION_UNIT_TYPE_SECONDS stores the duration in seconds.
+	DurationUnitType_DURATION_UNIT_TYPE_SECONDS DurationUnitType = 4
+	// DURATION_UNIT_TYPE_MINUTES stores the duration in minutes.
+	DurationUnitType_DURATION_UNIT_TYPE_MINUTES DurationUnitType = 5
+	// DURATION_UNIT_TYPE_HOURS stores the duration in hours.
+	DurationUnitType_DURATION_UNIT_TYPE_HOURS DurationUnitType = 6
+)
+
+// Enum value maps for DurationUnitType.
+var (
+	DurationUnitType_name = map[int32]string{
+		0: "DURATION_UNIT_TYPE_UNSPECIFIED",
+		1: "DURATION_UNIT_TYPE_NANOSECONDS",
+		2: "DURATION_UNIT_TYPE_MICROSECONDS",
+		3: "DURATION_UNIT_TYPE_MILLISECONDS",
+		4: "DURATION_UNIT_TYPE_SECONDS",
+		5: "DURATION_UNIT_TYPE_MINUTES",
+		6: "DURATION_UNIT_TYPE_HOURS",
+	}
+	DurationUnitType_value = map[string]int32{
+		"DURATION_UNIT_TYPE_UNSPECIFIED":  0,
+		"DURATION_UNIT_TYPE_NANOSECONDS":  1,
+		"DURATION_UNIT_TYPE_MICROSECONDS": 2,
+		"DURATION_UNIT_TYPE_MILLISECONDS": 3,
+		"DURATION_UNIT_TYPE_SECONDS":      4,
+		"DURATION_UNIT_TYPE_MINUTES":      5,
+		"DURATION_UNIT_TYPE_HOURS":        6,
+	}
+)
+
+func (x DurationUnitType) Enum() *DurationUnitType {
+	p := new(DurationUnitType)
+	*p = x
+	return p
+}
+
+func (x DurationUnitType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DurationUnitType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flags_annotations_proto_enumTypes[4].Descriptor()
+}
+
+func (DurationUnitType) Type() protoreflect.EnumType {
+	return &file_flags_annotations_proto_enumTypes[4]
+}
+
+func (x DurationUnitType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DurationUnitType.Descriptor instead.
+func (DurationUnitType) EnumDescriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{4}
+}
+
+// StringFormatType specifies the format a string flag value is validated against.
+// Values are stored in their canonical form.
+type StringFormatType int32
+
+const (
+	// STRING_FORMAT_TYPE_UNSPECIFIED accepts any string.
+	StringFormatType_STRING_FORMAT_TYPE_UNSPECIFIED StringFormatType = 0
+	// STRING_FORMAT_TYPE_IP accepts an IPv4 or IPv6 address: "10.0.0.1", "2001:db8::1".
+	StringFormatType_STRING_FORMAT_TYPE_IP StringFormatType = 1
+	// STRING_FORMAT_TYPE_IPV4 accepts an IPv4 address.
+	StringFormatType_STRING_FORMAT_TYPE_IPV4 StringFormatType = 2
+	// STRING_FORMAT_TYPE_IPV6 accepts an IPv6 address.
+	StringFormatType_STRING_FORMAT_TYPE_IPV6 StringFormatType = 3
+	// STRING_FORMAT_TYPE_CIDR accepts a CIDR prefix, stored with the host bits cleared:
+	// "10.1.2.3/8" is stored as "10.0.0.0/8".
+	StringFormatType_STRING_FORMAT_TYPE_CIDR StringFormatType = 4
+	// STRING_FORMAT_TYPE_HOST_PORT accepts "host:port" with a numeric port; the host may be
+	// a hostname, an IP address (IPv6 in brackets) or empty, e.g. ":8080".
+	StringFormatType_STRING_FORMAT_TYPE_HOST_PORT StringFormatType = 5
+	// STRING_FORMAT_TYPE_URL accepts an absolute URL with a scheme and a host.
+	StringFormatType_STRING_FORMAT_TYPE_URL StringFormatType = 6
+	// STRING_FORMAT_TYPE_HOSTNAME accepts an RFC 1123 hostname, stored lower-cased.
+	StringFormatType_STRING_FORMAT_TYPE_HOSTNAME StringFormatType = 7
+	// STRING_FORMAT_TYPE_EMAIL accepts a bare email address such as "ops@example.com".
+	StringFormatType_STRING_FORMAT_TYPE_EMAIL StringFormatType = 8
+)
+
+// Enum value maps for StringFormatType.
+var (
+	StringFormatType_name = map[int32]string{
+		0: "STRING_FORMAT_TYPE_UNSPECIFIED",
+		1: "STRING_FORMAT_TYPE_IP",
+		2: "STRING_FORMAT_TYPE_IPV4",
+		3: "STRING_FORMAT_TYPE_IPV6",
+		4: "STRING_FORMAT_TYPE_CIDR",
+		5: "STRING_FORMAT_TYPE_HOST_PORT",
+		6: "STRING_FORMAT_TYPE_URL",
+		7: "STRING_FORMAT_TYPE_HOSTNAME",
+		8: "STRING_FORMAT_TYPE_EMAIL",
+	}
+	StringFormatType_value = map[string]int32{
+		"STRING_FORMAT_TYPE_UNSPECIFIED": 0,
+		"STRING_FORMAT_TYPE_IP":          1,
+		"STRING_FORMAT_TYPE_IPV4":        2,
+		"STRING_FORMAT_TYPE_IPV6":        3,
+		"STRING_FORMAT_TYPE_CIDR":        4,
+		"STRING_FORMAT_TYPE_HOST_PORT":   5,
+		"STRING_FORMAT_TYPE_URL":         6,
+		"STRING_FORMAT_TYPE_HOSTNAME":    7,
+		"STRING_FORMAT_TYPE_EMAIL":       8,
+	}
+)
+
+func (x StringFormatType) Enum() *StringFormatType {
+	p := new(StringFormatType)
+	*p = x
+	return p
+}
+
+func (x StringFormatType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StringFormatType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flags_annotations_proto_enumTypes[5].Descriptor()
+}
+
+func (StringFormatType) Type() protoreflect.EnumType {
+	return &file_flags_annotations_proto_enumTypes[5]
+}
+
+func (x StringFormatType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StringFormatType.Descriptor instead.
+func (StringFormatType) EnumDescriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{5}
+}
+
+// PathCheckType specifies the file system check applied to the value of a path flag.
+type PathCheckType int32
+
+const (
+	// PATH_CHECK_TYPE_UNSPECIFIED applies no check.
+	PathCheckType_PATH_CHECK_TYPE_UNSPECIFIED PathCheckType = 0
+	// PATH_CHECK_TYPE_MUST_EXIST requires the path to exist.
+	PathCheckType_PATH_CHECK_TYPE_MUST_EXIST PathCheckType = 1
+	// PATH_CHECK_TYPE_MUST_BE_FILE requires the path to exist and not be a directory.
+	PathCheckType_PATH_CHECK_TYPE_MUST_BE_FILE PathCheckType = 2
+	// PATH_CHECK_TYPE_MUST_BE_DIR requires the path to be an existing directory.
+	PathCheckType_PATH_CHECK_TYPE_MUST_BE_DIR PathCheckType = 3
+	// PATH_CHECK_TYPE_MUST_NOT_EXIST requires the path not to exist, e.g. for output files.
+	PathCheckType_PATH_CHECK_TYPE_MUST_NOT_EXIST PathCheckType = 4
+)
+
+// Enum value maps for PathCheckType.
+var (
+	PathCheckType_name = map[int32]string{
+		0: "PATH_CHECK_TYPE_UNSPECIFIED",
+		1: "PATH_CHECK_TYPE_MUST_EXIST",
+		2: "PATH_CHECK_TYPE_MUST_BE_FILE",
+		3: "PATH_CHECK_TYPE_MUST_BE_DIR",
+		4: "PATH_CHECK_TYPE_MUST_NOT_EXIST",
+	}
+	PathCheckType_value = map[string]int32{
+		"PATH_CHECK_TYPE_UNSPECIFIED":    0,
+		"PATH_CHECK_TYPE_MUST_EXIST":     1,
+		"PATH_CHECK_TYPE_MUST_BE_FILE":   2,
+		"PATH_CHECK_TYPE_MUST_BE_DIR":    3,
+		"PATH_CHECK_TYPE_MUST_NOT_EXIST": 4,
+	}
+)
+
+func (x PathCheckType) Enum() *PathCheckType {
+	p := new(PathCheckType)
+	*p = x
+	return p
+}
+
+func (x PathCheckType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PathCheckType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flags_annotations_proto_enumTypes[6].Descriptor()
+}
+
+func (PathCheckType) Type() protoreflect.EnumType {
+	return &file_flags_annotations_proto_enumTypes[6]
+}
+
+func (x PathCheckType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PathCheckType.Descriptor instead.
+func (PathCheckType) EnumDescriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{6}
+}
+
+// MapFormatType specifies the format for map fields in command-line flags.
+type MapFormatType int32
+
+const (
+	// MAP_FORMAT_TYPE_UNSPECIFIED uses the default format (JSON).
+	MapFormatType_MAP_FORMAT_TYPE_UNSPECIFIED MapFormatType = 0
+	// MAP_FORMAT_TYPE_JSON uses JSON format for the entire map.
+	MapFormatType_MAP_FORMAT_TYPE_JSON MapFormatType = 1
+	// MAP_FORMAT_TYPE_STRING_TO_STRING uses string keys and string values.
+	MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING MapFormatType = 2
+	// MAP_FORMAT_TYPE_STRING_TO_INT uses string keys and int values.
+	MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT MapFormatType = 3
+)
+
+// Enum value maps for MapFormatType.
+var (
+	MapFormatType_name = map[int32]string{
+		0: "MAP_FORMAT_TYPE_UNSPECIFIED",
+		1: "MAP_FORMAT_TYPE_JSON",
+		2: "MAP_FORMAT_TYPE_STRING_TO_STRING",
+		3: "MAP_FORMAT_TYPE_STRING_TO_INT",
+	}
+	MapFormatType_value = map[string]int32{
+		"MAP_FORMAT_TYPE_UNSPECIFIED":      0,
+		"MAP_FORMAT_TYPE_JSON":             1,
+		"MAP_FORMAT_TYPE_STRING_TO_STRING": 2,
+		"MAP_FORMAT_TYPE_STRING_TO_INT":    3,
+	}
+)
+
+func (x MapFormatType) Enum() *MapFormatType {
+	p := new(MapFormatType)
+	*p = x
+	return p
+}
+
+func (x MapFormatType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MapFormatType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flags_annotations_proto_enumTypes[7].Descriptor()
+}
+
+func (MapFormatType) Type() protoreflect.EnumType {
+	return &file_flags_annotations_proto_enumTypes[7]
+}
+
+func (x MapFormatType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MapFormatType.Descriptor instead.
+func (MapFormatType) EnumDescriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{7}
+}
+
+// PathOptions marks a string flag as a file system path. A leading "~" is expanded to the home
+// directory and $VAR or ${VAR} references to environment variables.
+type PathOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Absolute makes relative paths absolute against the working directory, or base when set.
+	Absolute bool `protobuf:"varint,1,opt,name=absolute,proto3" json:"absolute,omitempty"`
+	// Base is the directory relative paths are resolved against. It is expanded like the value
+	// and implies absolute.
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// Check is the file system check applied to values given on the command line.
+	// Defaults are expanded but not checked.
+	Check PathCheckType `protobuf:"varint,3,opt,name=check,proto3,enum=flags.PathCheckType" json:"check,omitempty"`
+	// Extensions restricts shell completion to files with these extensions, e.g. ["yaml", "yml"].
+	// Ignored for PATH_CHECK_TYPE_MUST_BE_DIR, which completes directories only.
+	Extensions []string `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty"`
+}
+
+func (x *PathOptions) Reset() {
+	*x = PathOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathOptions) ProtoMessage() {}
+
+func (x *PathOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathOptions.ProtoReflect.Descriptor instead.
+func (*PathOptions) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PathOptions) GetAbsolute() bool {
+	if x != nil {
+		return x.Absolute
+	}
+	return false
+}
+
+func (x *PathOptions) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *PathOptions) GetCheck() PathCheckType {
+	if x != nil {
+		return x.Check
+	}
+	return PathCheckType_PATH_CHECK_TYPE_UNSPECIFIED
+}
+
+func (x *PathOptions) GetExtensions() []string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// BytesFlag contains configuration specifically for bytes fields with encoding type selection.
+//
+// This message allows customization of how bytes fields are encoded and decoded
+// in command-line flags, supporting both base64 and hexadecimal formats.
+type BytesFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Encoding specifies the encoding format for bytes fields. When unspecified,
+	// defaults to base64 encoding.
+	Encoding BytesEncodingType `protobuf:"varint,8,opt,name=encoding,proto3,enum=flags.BytesEncodingType" json:"encoding,omitempty"`
+	// Default specifies the default value for this flag.
+	Default []byte `protobuf:"bytes,9,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// AllowFileRef reads an argument of the form "@path" from the file at path and "-" from
+	// standard input, trimming a trailing newline, and decodes it with the encoding,
+	// e.g. --key=@/run/secrets/key. Use "@@" for a literal leading "@".
+	AllowFileRef bool `protobuf:"varint,10,opt,name=allow_file_ref,json=allowFileRef,proto3" json:"allow_file_ref,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *BytesFlag) Reset() {
+	*x = BytesFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesFlag) ProtoMessage() {}
+
+func (x *BytesFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesFlag.ProtoReflect.Descriptor instead.
+func (*BytesFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BytesFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *BytesFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BytesFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *BytesFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *BytesFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *BytesFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *BytesFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *BytesFlag) GetEncoding() BytesEncodingType {
+	if x != nil {
+		return x.Encoding
+	}
+	return BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED
+}
+
+func (x *BytesFlag) GetDefault() []byte {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *BytesFlag) GetAllowFileRef() bool {
+	if x != nil {
+		return x.AllowFileRef
+	}
+	return false
+}
+
+func (x *BytesFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// PrimitiveFlag contains the core configuration for all primitive flag types.
+//
+// This message provides a comprehensive set of options for customizing flag
+// behavior, appearance, and integration with environment variables.
+type PrimitiveFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,8,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *PrimitiveFlag) Reset() {
+	*x = PrimitiveFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrimitiveFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimitiveFlag) ProtoMessage() {}
+
+func (x *PrimitiveFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimitiveFlag.ProtoReflect.Descriptor instead.
+func (*PrimitiveFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PrimitiveFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *PrimitiveFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PrimitiveFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *PrimitiveFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *PrimitiveFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *PrimitiveFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *PrimitiveFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *PrimitiveFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// FloatFlag contains configuration for float32 fields with default value support.
+type FloatFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *float32 `protobuf:"fixed32,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *FloatFlag) Reset() {
+	*x = FloatFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloatFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatFlag) ProtoMessage() {}
+
+func (x *FloatFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatFlag.ProtoReflect.Descriptor instead.
+func (*FloatFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FloatFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *FloatFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FloatFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *FloatFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *FloatFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *FloatFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *FloatFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *FloatFlag) GetDefault() float32 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *FloatFlag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *FloatFlag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *FloatFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// DoubleFlag contains configuration for float64 fields with default value support.
+type DoubleFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *float64 `protobuf:"fixed64,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *DoubleFlag) Reset() {
+	*x = DoubleFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleFlag) ProtoMessage() {}
+
+func (x *DoubleFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleFlag.ProtoReflect.Descriptor instead.
+func (*DoubleFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DoubleFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *DoubleFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DoubleFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *DoubleFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *DoubleFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *DoubleFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *DoubleFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *DoubleFlag) GetDefault() float64 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *DoubleFlag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *DoubleFlag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *DoubleFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Int32Flag contains configuration for int32 fields with default value support.
+type Int32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *int32 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Int32Flag) Reset() {
+	*x = Int32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Int32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int32Flag) ProtoMessage() {}
+
+func (x *Int32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int32Flag.ProtoReflect.Descriptor instead.
+func (*Int32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Int32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Int32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Int32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Int32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Int32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Int32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Int32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Int32Flag) GetDefault() int32 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Int32Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Int32Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Int32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Int64Flag contains configuration for int64 fields with default value support.
+type Int64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *int64 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Int64Flag) Reset() {
+	*x = Int64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Int64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Flag) ProtoMessage() {}
+
+func (x *Int64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64Flag.ProtoReflect.Descriptor instead.
+func (*Int64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Int64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Int64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Int64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Int64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Int64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Int64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Int64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Int64Flag) GetDefault() int64 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Int64Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Int64Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Int64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Uint32Flag contains configuration for uint32 fields with default value support.
+type Uint32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *uint32 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Uint32Flag) Reset() {
+	*x = Uint32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Uint32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Uint32Flag) ProtoMessage() {}
+
+func (x *Uint32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Uint32Flag.ProtoReflect.Descriptor instead.
+func (*Uint32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Uint32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Uint32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Uint32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Uint32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Uint32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Uint32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Uint32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Uint32Flag) GetDefault() uint32 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Uint32Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Uint32Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Uint32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Uint64Flag contains configuration for uint64 fields with default value support.
+type Uint64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *uint64 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Uint64Flag) Reset() {
+	*x = Uint64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Uint64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Uint64Flag) ProtoMessage() {}
+
+func (x *Uint64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Uint64Flag.ProtoReflect.Descriptor instead.
+func (*Uint64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Uint64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Uint64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Uint64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Uint64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Uint64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Uint64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Uint64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Uint64Flag) GetDefault() uint64 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Uint64Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Uint64Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Uint64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Sint32Flag contains configuration for sint32 fields with default value support.
+type Sint32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *int32 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Sint32Flag) Reset() {
+	*x = Sint32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sint32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sint32Flag) ProtoMessage() {}
+
+func (x *Sint32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sint32Flag.ProtoReflect.Descriptor instead.
+func (*Sint32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Sint32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Sint32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sint32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Sint32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Sint32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Sint32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Sint32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Sint32Flag) GetDefault() int32 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Sint32Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Sint32Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Sint32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Sint64Flag contains configuration for sint64 fields with default value support.
+type Sint64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *int64 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Sint64Flag) Reset() {
+	*x = Sint64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sint64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sint64Flag) ProtoMessage() {}
+
+func (x *Sint64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sint64Flag.ProtoReflect.Descriptor instead.
+func (*Sint64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Sint64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Sint64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sint64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Sint64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Sint64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Sint64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Sint64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Sint64Flag) GetDefault() int64 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Sint64Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Sint64Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Sint64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Fixed32Flag contains configuration for fixed32 fields with default value support.
+type Fixed32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *uint32 `protobuf:"fixed32,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Fixed32Flag) Reset() {
+	*x = Fixed32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fixed32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fixed32Flag) ProtoMessage() {}
+
+func (x *Fixed32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fixed32Flag.ProtoReflect.Descriptor instead.
+func (*Fixed32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Fixed32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Fixed32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Fixed32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Fixed32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Fixed32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Fixed32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Fixed32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Fixed32Flag) GetDefault() uint32 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Fixed32Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Fixed32Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Fixed32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Fixed64Flag contains configuration for fixed64 fields with default value support.
+type Fixed64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *uint64 `protobuf:"fixed64,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Fixed64Flag) Reset() {
+	*x = Fixed64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fixed64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fixed64Flag) ProtoMessage() {}
+
+func (x *Fixed64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fixed64Flag.ProtoReflect.Descriptor instead.
+func (*Fixed64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Fixed64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Fixed64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Fixed64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Fixed64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Fixed64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Fixed64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Fixed64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Fixed64Flag) GetDefault() uint64 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Fixed64Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Fixed64Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Fixed64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Sfixed32Flag contains configuration for sfixed32 fields with default value support.
+type Sfixed32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *int32 `protobuf:"fixed32,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Sfixed32Flag) Reset() {
+	*x = Sfixed32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sfixed32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sfixed32Flag) ProtoMessage() {}
+
+func (x *Sfixed32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sfixed32Flag.ProtoReflect.Descriptor instead.
+func (*Sfixed32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Sfixed32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Sfixed32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sfixed32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Sfixed32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Sfixed32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Sfixed32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Sfixed32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Sfixed32Flag) GetDefault() int32 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Sfixed32Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Sfixed32Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Sfixed32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// Sfixed64Flag contains configuration for sfixed64 fields with default value support.
+type Sfixed64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *int64 `protobuf:"fixed64,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit selects the unit suffixes accepted on the command line and used when
+	// printing the value.
+	Unit UnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.UnitType" json:"unit,omitempty"`
+	// UnitDefault specifies the default value in unit syntax, e.g. "1GiB", "10k" or "80%".
+	// It is validated at generation time and may not be combined with default.
+	UnitDefault string `protobuf:"bytes,10,opt,name=unit_default,json=unitDefault,proto3" json:"unit_default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *Sfixed64Flag) Reset() {
+	*x = Sfixed64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sfixed64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sfixed64Flag) ProtoMessage() {}
+
+func (x *Sfixed64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sfixed64Flag.ProtoReflect.Descriptor instead.
+func (*Sfixed64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Sfixed64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Sfixed64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sfixed64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *Sfixed64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *Sfixed64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Sfixed64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Sfixed64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *Sfixed64Flag) GetDefault() int64 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *Sfixed64Flag) GetUnit() UnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return UnitType_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *Sfixed64Flag) GetUnitDefault() string {
+	if x != nil {
+		return x.UnitDefault
+	}
+	return ""
+}
+
+func (x *Sfixed64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// BoolFlag contains configuration for bool fields with default value support.
+type BoolFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *bool `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,9,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *BoolFlag) Reset() {
+	*x = BoolFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoolFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolFlag) ProtoMessage() {}
+
+func (x *BoolFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolFlag.ProtoReflect.Descriptor instead.
+func (*BoolFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BoolFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *BoolFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoolFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *BoolFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *BoolFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *BoolFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *BoolFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *BoolFlag) GetDefault() bool {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return false
+}
+
+func (x *BoolFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// StringFlag contains configuration for string fields with default value support.
+type StringFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag as a JSON string.
+	Default *string `protobuf:"bytes,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Format validates every value against a network or address format and stores it in
+	// canonical form. Declared defaults are validated at generation time.
+	Format StringFormatType `protobuf:"varint,9,opt,name=format,proto3,enum=flags.StringFormatType" json:"format,omitempty"`
+	// Path treats the value as a file system path, see PathOptions.
+	// Mutually exclusive with format.
+	Path *PathOptions `protobuf:"bytes,10,opt,name=path,proto3" json:"path,omitempty"`
+	// Choices restricts the value to a fixed set, e.g. ["gzip", "zstd", "none"]. The choices are
+	// listed in the help text and attached to the flag as completion candidates.
+	Choices []string `protobuf:"bytes,11,rep,name=choices,proto3" json:"choices,omitempty"`
+	// CaseInsensitive matches choices ignoring case; the value is stored as declared in choices.
+	CaseInsensitive bool `protobuf:"varint,12,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// AllowFileRef reads an argument of the form "@path" from the file at path and "-" from
+	// standard input, trimming a trailing newline, e.g. --password=@/run/secrets/db.
+	// Use "@@" for a literal leading "@".
+	AllowFileRef bool `protobuf:"varint,13,opt,name=allow_file_ref,json=allowFileRef,proto3" json:"allow_file_ref,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,14,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *StringFlag) Reset() {
+	*x = StringFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringFlag) ProtoMessage() {}
+
+func (x *StringFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringFlag.ProtoReflect.Descriptor instead.
+func (*StringFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StringFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *StringFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StringFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *StringFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *StringFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *StringFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *StringFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *StringFlag) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+func (x *StringFlag) GetFormat() StringFormatType {
+	if x != nil {
+		return x.Format
+	}
+	return StringFormatType_STRING_FORMAT_TYPE_UNSPECIFIED
+}
+
+func (x *StringFlag) GetPath() *PathOptions {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *StringFlag) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *StringFlag) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *StringFlag) GetAllowFileRef() bool {
+	if x != nil {
+		return x.AllowFileRef
+	}
+	return false
+}
+
+func (x *StringFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedFloatFlag contains configuration for repeated float32 fields with default value support.
+type RepeatedFloatFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []float32 `protobuf:"fixed32,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,14,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedFloatFlag) Reset() {
+	*x = RepeatedFloatFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedFloatFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedFloatFlag) ProtoMessage() {}
+
+func (x *RepeatedFloatFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedFloatFlag.ProtoReflect.Descriptor instead.
+func (*RepeatedFloatFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RepeatedFloatFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedFloatFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedFloatFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedFloatFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedFloatFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedFloatFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedFloatFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedFloatFlag) GetDefault() []float32 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedFloatFlag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedFloatFlag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedFloatFlag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedFloatFlag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedFloatFlag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedFloatFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedDoubleFlag contains configuration for repeated float64 fields with default value support.
+type RepeatedDoubleFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []float64 `protobuf:"fixed64,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,14,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedDoubleFlag) Reset() {
+	*x = RepeatedDoubleFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedDoubleFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedDoubleFlag) ProtoMessage() {}
+
+func (x *RepeatedDoubleFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedDoubleFlag.ProtoReflect.Descriptor instead.
+func (*RepeatedDoubleFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RepeatedDoubleFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedDoubleFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedDoubleFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedDoubleFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedDoubleFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedDoubleFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedDoubleFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedDoubleFlag) GetDefault() []float64 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedDoubleFlag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedDoubleFlag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedDoubleFlag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedDoubleFlag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedDoubleFlag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedDoubleFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedInt32Flag contains configuration for repeated int32 fields with default value support.
+type RepeatedInt32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []int32 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedInt32Flag) Reset() {
+	*x = RepeatedInt32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedInt32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedInt32Flag) ProtoMessage() {}
+
+func (x *RepeatedInt32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedInt32Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedInt32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RepeatedInt32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedInt32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedInt32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedInt32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedInt32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedInt32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedInt32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedInt32Flag) GetDefault() []int32 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedInt32Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedInt32Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedInt32Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedInt32Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedInt32Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedInt32Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedInt32Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedInt32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedInt64Flag contains configuration for repeated int64 fields with default value support.
+type RepeatedInt64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []int64 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedInt64Flag) Reset() {
+	*x = RepeatedInt64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedInt64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedInt64Flag) ProtoMessage() {}
+
+func (x *RepeatedInt64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedInt64Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedInt64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RepeatedInt64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedInt64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedInt64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedInt64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedInt64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedInt64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedInt64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedInt64Flag) GetDefault() []int64 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedInt64Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedInt64Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedInt64Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedInt64Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedInt64Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedInt64Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedInt64Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedInt64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedUint32Flag contains configuration for repeated uint32 fields with default value support.
+type RepeatedUint32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []uint32 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedUint32Flag) Reset() {
+	*x = RepeatedUint32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedUint32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedUint32Flag) ProtoMessage() {}
+
+func (x *RepeatedUint32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedUint32Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedUint32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RepeatedUint32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedUint32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedUint32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedUint32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedUint32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedUint32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedUint32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedUint32Flag) GetDefault() []uint32 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedUint32Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedUint32Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedUint32Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedUint32Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedUint32Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedUint32Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedUint32Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedUint32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedUint64Flag contains configuration for repeated uint64 fields with default value support.
+type RepeatedUint64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []uint64 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedUint64Flag) Reset() {
+	*x = RepeatedUint64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedUint64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedUint64Flag) ProtoMessage() {}
+
+func (x *RepeatedUint64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedUint64Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedUint64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RepeatedUint64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedUint64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedUint64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedUint64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedUint64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedUint64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedUint64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedUint64Flag) GetDefault() []uint64 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedUint64Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedUint64Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedUint64Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedUint64Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedUint64Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedUint64Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedUint64Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedUint64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedSint32Flag contains configuration for repeated sint32 fields with default value support.
+type RepeatedSint32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []int32 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedSint32Flag) Reset() {
+	*x = RepeatedSint32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedSint32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedSint32Flag) ProtoMessage() {}
+
+func (x *RepeatedSint32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedSint32Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedSint32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RepeatedSint32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedSint32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedSint32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedSint32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedSint32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedSint32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedSint32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedSint32Flag) GetDefault() []int32 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedSint32Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedSint32Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedSint32Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedSint32Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedSint32Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedSint32Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedSint32Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedSint32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedSint64Flag contains configuration for repeated sint64 fields with default value support.
+type RepeatedSint64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []int64 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedSint64Flag) Reset() {
+	*x = RepeatedSint64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedSint64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedSint64Flag) ProtoMessage() {}
+
+func (x *RepeatedSint64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedSint64Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedSint64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RepeatedSint64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedSint64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedSint64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedSint64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedSint64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedSint64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedSint64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedSint64Flag) GetDefault() []int64 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedSint64Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedSint64Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedSint64Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedSint64Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedSint64Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedSint64Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedSint64Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedSint64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedFixed32Flag contains configuration for repeated fixed32 fields with default value support.
+type RepeatedFixed32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []uint32 `protobuf:"fixed32,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedFixed32Flag) Reset() {
+	*x = RepeatedFixed32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedFixed32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedFixed32Flag) ProtoMessage() {}
+
+func (x *RepeatedFixed32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedFixed32Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedFixed32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RepeatedFixed32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedFixed32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedFixed32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedFixed32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedFixed32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedFixed32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedFixed32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedFixed32Flag) GetDefault() []uint32 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedFixed32Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedFixed32Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedFixed32Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedFixed32Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedFixed32Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedFixed32Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedFixed32Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedFixed32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedFixed64Flag contains configuration for repeated fixed64 fields with default value support.
+type RepeatedFixed64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []uint64 `protobuf:"fixed64,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedFixed64Flag) Reset() {
+	*x = RepeatedFixed64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedFixed64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedFixed64Flag) ProtoMessage() {}
+
+func (x *RepeatedFixed64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedFixed64Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedFixed64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RepeatedFixed64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedFixed64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedFixed64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedFixed64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedFixed64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedFixed64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedFixed64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedFixed64Flag) GetDefault() []uint64 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedFixed64Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedFixed64Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedFixed64Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedFixed64Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedFixed64Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedFixed64Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedFixed64Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedFixed64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedSfixed32Flag contains configuration for repeated sfixed32 fields with default value support.
+type RepeatedSfixed32Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []int32 `protobuf:"fixed32,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedSfixed32Flag) Reset() {
+	*x = RepeatedSfixed32Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedSfixed32Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedSfixed32Flag) ProtoMessage() {}
+
+func (x *RepeatedSfixed32Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedSfixed32Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedSfixed32Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RepeatedSfixed32Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedSfixed32Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed32Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed32Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed32Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedSfixed32Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedSfixed32Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed32Flag) GetDefault() []int32 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedSfixed32Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedSfixed32Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed32Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedSfixed32Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedSfixed32Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedSfixed32Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedSfixed32Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedSfixed32Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedSfixed64Flag contains configuration for repeated sfixed64 fields with default value support.
+type RepeatedSfixed64Flag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []int64 `protobuf:"fixed64,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Ranges enables range expansion of items: "1-5,8" expands to 1,2,3,4,5,8 and
+	// "0-100:10" steps by 10. The flag value is printed with contiguous runs compressed.
+	Ranges bool `protobuf:"varint,14,opt,name=ranges,proto3" json:"ranges,omitempty"`
+	// MaxRangeSize caps the number of items a single argument may expand to when
+	// ranges is enabled. When zero, a cap of 10000 items is used.
+	MaxRangeSize uint32 `protobuf:"varint,15,opt,name=max_range_size,json=maxRangeSize,proto3" json:"max_range_size,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedSfixed64Flag) Reset() {
+	*x = RepeatedSfixed64Flag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedSfixed64Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedSfixed64Flag) ProtoMessage() {}
+
+func (x *RepeatedSfixed64Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedSfixed64Flag.ProtoReflect.Descriptor instead.
+func (*RepeatedSfixed64Flag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RepeatedSfixed64Flag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedSfixed64Flag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed64Flag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed64Flag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed64Flag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedSfixed64Flag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedSfixed64Flag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed64Flag) GetDefault() []int64 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedSfixed64Flag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedSfixed64Flag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedSfixed64Flag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedSfixed64Flag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedSfixed64Flag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedSfixed64Flag) GetRanges() bool {
+	if x != nil {
+		return x.Ranges
+	}
+	return false
+}
+
+func (x *RepeatedSfixed64Flag) GetMaxRangeSize() uint32 {
+	if x != nil {
+		return x.MaxRangeSize
+	}
+	return 0
+}
+
+func (x *RepeatedSfixed64Flag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedBoolFlag contains configuration for repeated bool fields with default value support.
+type RepeatedBoolFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []bool `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,14,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedBoolFlag) Reset() {
+	*x = RepeatedBoolFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedBoolFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedBoolFlag) ProtoMessage() {}
+
+func (x *RepeatedBoolFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedBoolFlag.ProtoReflect.Descriptor instead.
+func (*RepeatedBoolFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RepeatedBoolFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedBoolFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedBoolFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedBoolFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedBoolFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedBoolFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedBoolFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedBoolFlag) GetDefault() []bool {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedBoolFlag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedBoolFlag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedBoolFlag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedBoolFlag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedBoolFlag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedBoolFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedStringFlag contains configuration for repeated string fields with default value support.
+type RepeatedStringFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []string `protobuf:"bytes,8,rep,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Format validates every value against a network or address format and stores it in
+	// canonical form. Declared defaults are validated at generation time.
+	Format StringFormatType `protobuf:"varint,14,opt,name=format,proto3,enum=flags.StringFormatType" json:"format,omitempty"`
+	// Path treats every value as a file system path, see PathOptions.
+	// Mutually exclusive with format.
+	Path *PathOptions `protobuf:"bytes,15,opt,name=path,proto3" json:"path,omitempty"`
+	// Choices restricts every value to a fixed set, e.g. ["gzip", "zstd", "none"]. The choices are
+	// listed in the help text and attached to the flag as completion candidates.
+	Choices []string `protobuf:"bytes,16,rep,name=choices,proto3" json:"choices,omitempty"`
+	// CaseInsensitive matches choices ignoring case; values are stored as declared in choices.
+	CaseInsensitive bool `protobuf:"varint,17,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// AllowFileRef reads an argument of the form "@path" from the file at path and "-" from
+	// standard input, one item per line; empty lines are skipped. Use "@@" for a literal leading "@".
+	AllowFileRef bool `protobuf:"varint,18,opt,name=allow_file_ref,json=allowFileRef,proto3" json:"allow_file_ref,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,19,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedStringFlag) Reset() {
+	*x = RepeatedStringFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedStringFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedStringFlag) ProtoMessage() {}
+
+func (x *RepeatedStringFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedStringFlag.ProtoReflect.Descriptor instead.
+func (*RepeatedStringFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RepeatedStringFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedStringFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedStringFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedStringFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedStringFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedStringFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedStringFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedStringFlag) GetDefault() []string {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedStringFlag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedStringFlag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedStringFlag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedStringFlag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedStringFlag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedStringFlag) GetFormat() StringFormatType {
+	if x != nil {
+		return x.Format
+	}
+	return StringFormatType_STRING_FORMAT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedStringFlag) GetPath() *PathOptions {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *RepeatedStringFlag) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *RepeatedStringFlag) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *RepeatedStringFlag) GetAllowFileRef() bool {
+	if x != nil {
+		return x.AllowFileRef
+	}
+	return false
+}
+
+func (x *RepeatedStringFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedBytesFlag contains configuration for repeated bytes fields with default value support.
+type RepeatedBytesFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Encoding specifies the encoding format for bytes fields. When unspecified,
+	// defaults to base64 encoding.
+	Encoding BytesEncodingType `protobuf:"varint,8,opt,name=encoding,proto3,enum=flags.BytesEncodingType" json:"encoding,omitempty"`
+	// Default specifies the default values for this flag.
+	Default [][]byte `protobuf:"bytes,9,rep,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,10,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,11,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,12,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,13,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,14,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// AllowFileRef reads an argument of the form "@path" from the file at path and "-" from
+	// standard input, one item per line; empty lines are skipped. Use "@@" for a literal leading "@".
+	AllowFileRef bool `protobuf:"varint,15,opt,name=allow_file_ref,json=allowFileRef,proto3" json:"allow_file_ref,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedBytesFlag) Reset() {
+	*x = RepeatedBytesFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedBytesFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedBytesFlag) ProtoMessage() {}
+
+func (x *RepeatedBytesFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedBytesFlag.ProtoReflect.Descriptor instead.
+func (*RepeatedBytesFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RepeatedBytesFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedBytesFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedBytesFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedBytesFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedBytesFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedBytesFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedBytesFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedBytesFlag) GetEncoding() BytesEncodingType {
+	if x != nil {
+		return x.Encoding
+	}
+	return BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedBytesFlag) GetDefault() [][]byte {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedBytesFlag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedBytesFlag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedBytesFlag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedBytesFlag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedBytesFlag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedBytesFlag) GetAllowFileRef() bool {
+	if x != nil {
+		return x.AllowFileRef
+	}
+	return false
+}
+
+func (x *RepeatedBytesFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedEnumFlag contains configuration for repeated enum fields with default value support.
+type RepeatedEnumFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []int32 `protobuf:"varint,8,rep,packed,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,14,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedEnumFlag) Reset() {
+	*x = RepeatedEnumFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedEnumFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedEnumFlag) ProtoMessage() {}
+
+func (x *RepeatedEnumFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedEnumFlag.ProtoReflect.Descriptor instead.
+func (*RepeatedEnumFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RepeatedEnumFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedEnumFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedEnumFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedEnumFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedEnumFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedEnumFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedEnumFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedEnumFlag) GetDefault() []int32 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedEnumFlag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedEnumFlag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedEnumFlag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedEnumFlag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedEnumFlag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedEnumFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedDurationFlag contains configuration for repeated duration fields with default value support.
+type RepeatedDurationFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []string `protobuf:"bytes,8,rep,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,9,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,10,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,11,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,12,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,13,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,14,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedDurationFlag) Reset() {
+	*x = RepeatedDurationFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedDurationFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedDurationFlag) ProtoMessage() {}
+
+func (x *RepeatedDurationFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedDurationFlag.ProtoReflect.Descriptor instead.
+func (*RepeatedDurationFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RepeatedDurationFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedDurationFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedDurationFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedDurationFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedDurationFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedDurationFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedDurationFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedDurationFlag) GetDefault() []string {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedDurationFlag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedDurationFlag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedDurationFlag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedDurationFlag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedDurationFlag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedDurationFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedTimestampFlag contains configuration for repeated timestamp fields with default value support.
+type RepeatedTimestampFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Formats specifies the acceptable timestamp format strings for parsing.
+	// Common formats include: "RFC3339", "ISO8601", "RFC822", "Stamp", etc.
+	// When empty, defaults to RFC3339 format. The value is printed with the first format.
+	// Relative expressions ("now-15m", "today", "yesterday+6h") and Unix epoch seconds
+	// or milliseconds are accepted in addition to the formats.
+	Formats []string `protobuf:"bytes,8,rep,name=formats,proto3" json:"formats,omitempty"`
+	// Default specifies the default values for this flag.
+	Default []string `protobuf:"bytes,9,rep,name=default,proto3" json:"default,omitempty"`
+	// Split selects how a single command-line argument is broken into items.
+	// When unspecified, the historical behavior of the element type is kept.
+	Split SplitType `protobuf:"varint,10,opt,name=split,proto3,enum=flags.SplitType" json:"split,omitempty"`
+	// Separator is the item separator used when split is SPLIT_TYPE_SEPARATOR,
+	// e.g. ";" or ":" for PATH-like values.
+	Separator string `protobuf:"bytes,11,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Merge selects how the command-line items are combined with the defaults.
+	// When unspecified, the first occurrence replaces the defaults.
+	Merge MergeType `protobuf:"varint,12,opt,name=merge,proto3,enum=flags.MergeType" json:"merge,omitempty"`
+	// Unique drops repeated items after every occurrence, keeping the first one.
+	Unique bool `protobuf:"varint,13,opt,name=unique,proto3" json:"unique,omitempty"`
+	// Sorted sorts the items in ascending order after every occurrence.
+	Sorted bool `protobuf:"varint,14,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	// Timezone is the IANA time zone name, e.g. "Europe/Berlin", in which values without
+	// an offset are interpreted and the value is printed. When empty, UTC is used.
+	Timezone string `protobuf:"bytes,15,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,16,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *RepeatedTimestampFlag) Reset() {
+	*x = RepeatedTimestampFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedTimestampFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedTimestampFlag) ProtoMessage() {}
+
+func (x *RepeatedTimestampFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedTimestampFlag.ProtoReflect.Descriptor instead.
+func (*RepeatedTimestampFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RepeatedTimestampFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RepeatedTimestampFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepeatedTimestampFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *RepeatedTimestampFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *RepeatedTimestampFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *RepeatedTimestampFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RepeatedTimestampFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *RepeatedTimestampFlag) GetFormats() []string {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+func (x *RepeatedTimestampFlag) GetDefault() []string {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *RepeatedTimestampFlag) GetSplit() SplitType {
+	if x != nil {
+		return x.Split
+	}
+	return SplitType_SPLIT_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedTimestampFlag) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *RepeatedTimestampFlag) GetMerge() MergeType {
+	if x != nil {
+		return x.Merge
+	}
+	return MergeType_MERGE_TYPE_UNSPECIFIED
+}
+
+func (x *RepeatedTimestampFlag) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *RepeatedTimestampFlag) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *RepeatedTimestampFlag) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RepeatedTimestampFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// EnumFlag contains configuration for enum fields with default value support.
+type EnumFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag as an int32.
+	Default *int32 `protobuf:"varint,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,9,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *EnumFlag) Reset() {
+	*x = EnumFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumFlag) ProtoMessage() {}
+
+func (x *EnumFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumFlag.ProtoReflect.Descriptor instead.
+func (*EnumFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EnumFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *EnumFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnumFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *EnumFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *EnumFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *EnumFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *EnumFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *EnumFlag) GetDefault() int32 {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return 0
+}
+
+func (x *EnumFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// MapFlag contains configuration for map fields with default value support.
+type MapFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *string `protobuf:"bytes,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Format specifies the format for map fields. When unspecified,
+	// defaults to JSON format.
+	Format MapFormatType `protobuf:"varint,9,opt,name=format,proto3,enum=flags.MapFormatType" json:"format,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,10,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *MapFlag) Reset() {
+	*x = MapFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapFlag) ProtoMessage() {}
+
+func (x *MapFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapFlag.ProtoReflect.Descriptor instead.
+func (*MapFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MapFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *MapFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MapFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *MapFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *MapFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *MapFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *MapFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *MapFlag) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+func (x *MapFlag) GetFormat() MapFormatType {
+	if x != nil {
+		return x.Format
+	}
+	return MapFormatType_MAP_FORMAT_TYPE_UNSPECIFIED
+}
+
+func (x *MapFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// DurationFlag contains configuration for duration fields with default value support.
+type DurationFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *string `protobuf:"bytes,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Unit allows the flag on integer fields (e.g. int64 timeout_ms), storing the
+	// duration as a whole number of this unit. Values that are not a whole number
+	// of the unit or overflow the field are rejected rather than rounded.
+	// Required on integer fields and not allowed on google.protobuf.Duration fields.
+	Unit DurationUnitType `protobuf:"varint,9,opt,name=unit,proto3,enum=flags.DurationUnitType" json:"unit,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,10,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *DurationFlag) Reset() {
+	*x = DurationFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DurationFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationFlag) ProtoMessage() {}
+
+func (x *DurationFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationFlag.ProtoReflect.Descriptor instead.
+func (*DurationFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DurationFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *DurationFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DurationFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *DurationFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *DurationFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *DurationFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *DurationFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *DurationFlag) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+func (x *DurationFlag) GetUnit() DurationUnitType {
+	if x != nil {
+		return x.Unit
+	}
+	return DurationUnitType_DURATION_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *DurationFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// TimestampFlag contains the core configuration for all Timestamp flag types.
+//
+// This message provides a comprehensive set of options for customizing flag
+// behavior, appearance, and integration with environment variables.
+type TimestampFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Formats specifies the acceptable timestamp format strings for parsing.
+	// Common formats include: "RFC3339", "ISO8601", "RFC822", "Stamp", etc.
+	// When empty, defaults to RFC3339 format. The value is printed with the first format.
+	// Relative expressions ("now-15m", "today", "yesterday+6h") and Unix epoch seconds
+	// or milliseconds are accepted in addition to the formats.
+	Formats []string `protobuf:"bytes,8,rep,name=formats,proto3" json:"formats,omitempty"`
+	// Default specifies the default value for this flag.
+	Default *string `protobuf:"bytes,9,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Timezone is the IANA time zone name, e.g. "Europe/Berlin", in which values without
+	// an offset are interpreted and the value is printed. When empty, UTC is used.
+	Timezone string `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,11,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *TimestampFlag) Reset() {
+	*x = TimestampFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimestampFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampFlag) ProtoMessage() {}
+
+func (x *TimestampFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampFlag.ProtoReflect.Descriptor instead.
+func (*TimestampFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TimestampFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *TimestampFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TimestampFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *TimestampFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *TimestampFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *TimestampFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *TimestampFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *TimestampFlag) GetFormats() []string {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+func (x *TimestampFlag) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+func (x *TimestampFlag) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TimestampFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// MessageFlag contains configuration for message fields that contain nested flag configurations.
+//
+// This message type is used when a protobuf field is itself a message that contains
+// fields with flag configurations. It allows customization of how the nested flags
+// are organized and presented in the command-line interface.
+type MessageFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nested controls whether to generate AddFlags method calls for this message field.
+	// When set to true, the generated code will:
+	//   1. Automatically initialize the message field if it's nil (prevents null pointer exceptions)
+	//   2. Call AddFlags on the nested message fields that implement the flags.Interface
+	// When set to false, no flags will be generated for fields within this message,
+	// even if they have flag configurations.
+	Nested bool `protobuf:"varint,1,opt,name=nested,proto3" json:"nested,omitempty"`
+	// Name specifies a custom name prefix for all flags in this message field.
+	// This is useful for organizing flags into logical groups and avoiding
+	// naming conflicts when multiple messages have similarly named fields.
+	//
+	// Examples:
+	//   - name "server" would generate "--server.host", "--server.port"
+	//   - name "database" would generate "--database.url", "--database.timeout"
+	//   - empty name would generate "--host", "--port" (field names directly)
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Literal registers a single flag, named like the prefix above, taking the whole message as
+	// protojson or prototext, e.g. --tls='{"certFile": "a"}' or --tls='{cert_file: "a"}'.
+	// The fields given replace the current values, the other fields are kept. Combined with
+	// nested, the per-field flags take precedence over the literal whatever the order of the
+	// arguments.
+	Literal bool `protobuf:"varint,3,opt,name=literal,proto3" json:"literal,omitempty"`
+	// Usage provides the description text shown in help output for the literal or presence flag.
+	// Required when literal or presence_flag is set.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Short specifies a short alias (single character) for the literal or presence flag.
+	Short string `protobuf:"bytes,5,opt,name=short,proto3" json:"short,omitempty"`
+	// PresenceFlag registers a bool flag, named like the prefix above, controlling whether the
+	// message is set: the field stays nil unless --tls is given or, combined with nested, any of
+	// the --tls.* flags is set, and --tls=false clears it. AddFlags and SetDefaults no longer
+	// allocate the message; the one enabled by the flag is initialized with its defaults.
+	// Implied for google.protobuf.Empty fields. Cannot be combined with literal, which uses the
+	// same flag name.
+	PresenceFlag bool `protobuf:"varint,6,opt,name=presence_flag,json=presenceFlag,proto3" json:"presence_flag,omitempty"`
+	// Inline registers the nested flags at the level of the parent message, without the prefix,
+	// e.g. a shared CommonOptions message yields --verbose instead of --common.verbose. The flag
+	// names of the inlined message must not collide with those of the parent, which is checked at
+	// generation time. Requires nested.
+	Inline bool `protobuf:"varint,7,opt,name=inline,proto3" json:"inline,omitempty"`
+}
+
+func (x *MessageFlag) Reset() {
+	*x = MessageFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageFlag) ProtoMessage() {}
+
+func (x *MessageFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageFlag.ProtoReflect.Descriptor instead.
+func (*MessageFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MessageFlag) GetNested() bool {
+	if x != nil {
+		return x.Nested
+	}
+	return false
+}
+
+func (x *MessageFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MessageFlag) GetLiteral() bool {
+	if x != nil {
+		return x.Literal
+	}
+	return false
+}
+
+func (x *MessageFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *MessageFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *MessageFlag) GetPresenceFlag() bool {
+	if x != nil {
+		return x.PresenceFlag
+	}
+	return false
+}
+
+func (x *MessageFlag) GetInline() bool {
+	if x != nil {
+		return x.Inline
+	}
+	return false
+}
+
+// StructFlag contains the configuration for google.protobuf.Struct, google.protobuf.Value and
+// google.protobuf.ListValue fields.
+//
+// The flag takes JSON, parsed with protojson, e.g. --extra='{"timeout": 5, "mode": "fast"}'.
+// Struct and Value flags also accept overrides of a single, possibly nested key, given as
+// --extra.timeout=5 when the arguments are parsed with flags.Parse or expanded with
+// flags.ExpandDotted, or as --extra=timeout=5. Overrides are applied to the current value,
+// the defaults or the JSON given before, and their values are parsed as JSON, falling back to
+// a string, so --extra.mode=fast sets "mode" to "fast".
+type StructFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value as JSON. It must be an object for Struct fields and an
+	// array for ListValue fields, and is validated at generation time.
+	Default *string `protobuf:"bytes,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,9,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *StructFlag) Reset() {
+	*x = StructFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructFlag) ProtoMessage() {}
+
+func (x *StructFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructFlag.ProtoReflect.Descriptor instead.
+func (*StructFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StructFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *StructFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StructFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *StructFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *StructFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *StructFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *StructFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *StructFlag) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+func (x *StructFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// FieldMaskFlag contains the configuration for google.protobuf.FieldMask fields.
+//
+// The flag takes comma-separated paths and may be repeated to add more paths,
+// e.g. --update-mask=display_name,address.city --update-mask=tags. Every path is checked
+// against the target message when the flag is parsed, and may use the proto or the JSON names
+// of the fields.
+type FieldMaskFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Target is the full name of the message the paths refer to, e.g. "example.v1.Profile", or its
+	// name relative to the package of the file. It must be defined in the file's package or in a
+	// file it imports. Required.
+	Target string `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`
+	// Default specifies the default paths, checked against the target at generation time.
+	Default []string `protobuf:"bytes,9,rep,name=default,proto3" json:"default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,10,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *FieldMaskFlag) Reset() {
+	*x = FieldMaskFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldMaskFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldMaskFlag) ProtoMessage() {}
+
+func (x *FieldMaskFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldMaskFlag.ProtoReflect.Descriptor instead.
+func (*FieldMaskFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FieldMaskFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *FieldMaskFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldMaskFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *FieldMaskFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *FieldMaskFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *FieldMaskFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *FieldMaskFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *FieldMaskFlag) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *FieldMaskFlag) GetDefault() []string {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *FieldMaskFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// AnyFlag contains the configuration for google.protobuf.Any fields.
+//
+// The flag takes protojson with an "@type" URL, e.g.
+// --provider='{"@type": "type.googleapis.com/example.v1.S3Config", "bucket": "logs"}', or the
+// shorthand --provider='type=example.v1.S3Config;{"bucket": "logs"}'. Message types are resolved
+// through protoregistry.GlobalTypes, or the registry given to AddFlags with flags.WithTypeResolver.
+type AnyFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// AllowedTypes restricts the value to the messages with these full names,
+	// e.g. "example.v1.S3Config". Any registered message is accepted when empty.
+	AllowedTypes []string `protobuf:"bytes,8,rep,name=allowed_types,json=allowedTypes,proto3" json:"allowed_types,omitempty"`
+	// Default specifies the default value as protojson with an "@type" URL. Its message must be
+	// defined in the file's package or in a file it imports, and is resolved through
+	// protoregistry.GlobalTypes.
+	Default *string `protobuf:"bytes,9,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,10,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *AnyFlag) Reset() {
+	*x = AnyFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnyFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnyFlag) ProtoMessage() {}
+
+func (x *AnyFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnyFlag.ProtoReflect.Descriptor instead.
+func (*AnyFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AnyFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *AnyFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AnyFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *AnyFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *AnyFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *AnyFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *AnyFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *AnyFlag) GetAllowedTypes() []string {
+	if x != nil {
+		return x.AllowedTypes
+	}
+	return nil
+}
+
+func (x *AnyFlag) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+func (x *AnyFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// GoogleTypeFlag contains the configuration for the flags of the google.type common messages,
+// given in their natural string syntax:
+//
+//   - google.type.Date: 2026-10-16, 2026-10 or 2026 for a partial date, --10-16 without a year
+//   - google.type.TimeOfDay: 13:45, 13:45:30 or 13:45:30.5
+//   - google.type.Money: 12.50 USD, an amount and its ISO 4217 currency code
+//   - google.type.LatLng: 52.52,13.40, latitude then longitude in degrees
+//   - google.type.Decimal: 3.14159 or 1.5e-3
+type GoogleTypeFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disabled skips generation of flags for this field when set to true.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Name specifies a custom flag name. When empty, defaults to field name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Short specifies a short flag alias (single character).
+	Short string `protobuf:"bytes,3,opt,name=short,proto3" json:"short,omitempty"`
+	// Usage provides the description text shown in help output for this flag.
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Hidden hides this flag from help output when set to true.
+	Hidden bool `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Deprecated marks this flag as deprecated when set to true.
+	Deprecated bool `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// DeprecatedUsage provides additional context shown in help output for deprecated flags.
+	DeprecatedUsage string `protobuf:"bytes,7,opt,name=deprecated_usage,json=deprecatedUsage,proto3" json:"deprecated_usage,omitempty"`
+	// Default specifies the default value in the syntax of the message, e.g. "12.50 USD".
+	Default *string `protobuf:"bytes,8,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	// Sensitive redacts the value: String() returns flags.Redacted, the default is not shown in
+	// help output and the flag carries the flags.AnnotationSensitive annotation. The field keeps
+	// the real value.
+	Sensitive bool `protobuf:"varint,9,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+}
+
+func (x *GoogleTypeFlag) Reset() {
+	*x = GoogleTypeFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoogleTypeFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleTypeFlag) ProtoMessage() {}
+
+func (x *GoogleTypeFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleTypeFlag.ProtoReflect.Descriptor instead.
+func (*GoogleTypeFlag) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GoogleTypeFlag) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *GoogleTypeFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoogleTypeFlag) GetShort() string {
+	if x != nil {
+		return x.Short
+	}
+	return ""
+}
+
+func (x *GoogleTypeFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *GoogleTypeFlag) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *GoogleTypeFlag) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *GoogleTypeFlag) GetDeprecatedUsage() string {
+	if x != nil {
+		return x.DeprecatedUsage
+	}
+	return ""
+}
+
+func (x *GoogleTypeFlag) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+func (x *GoogleTypeFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+// RepeatedFlags defines configuration for repeated fields (slices/arrays).
+//
+// This allows customization of how repeated values are handled in the CLI.
+// The type oneof field determines the element type of the repeated field.
+type RepeatedFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*RepeatedFlags_Float
+	//	*RepeatedFlags_Double
+	//	*RepeatedFlags_Int32
+	//	*RepeatedFlags_Int64
+	//	*RepeatedFlags_Uint32
+	//	*RepeatedFlags_Uint64
+	//	*RepeatedFlags_Sint32
+	//	*RepeatedFlags_Sint64
+	//	*RepeatedFlags_Fixed32
+	//	*RepeatedFlags_Fixed64
+	//	*RepeatedFlags_Sfixed32
+	//	*RepeatedFlags_Sfixed64
+	//	*RepeatedFlags_Bool
+	//	*RepeatedFlags_String_
+	//	*RepeatedFlags_Bytes
+	//	*RepeatedFlags_Enum
+	//	*RepeatedFlags_Duration
+	//	*RepeatedFlags_Timestamp
+	Type isRepeatedFlags_Type `protobuf_oneof:"type"`
+}
+
+func (x *RepeatedFlags) Reset() {
+	*x = RepeatedFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedFlags) ProtoMessage() {}
+
+func (x *RepeatedFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedFlags.ProtoReflect.Descriptor instead.
+func (*RepeatedFlags) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{44}
+}
+
+func (m *RepeatedFlags) GetType() isRepeatedFlags_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetFloat() *RepeatedFloatFlag {
+	if x, ok := x.GetType().(*RepeatedFlags_Float); ok {
+		return x.Float
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetDouble() *RepeatedDoubleFlag {
+	if x, ok := x.GetType().(*RepeatedFlags_Double); ok {
+		return x.Double
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetInt32() *RepeatedInt32Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Int32); ok {
+		return x.Int32
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetInt64() *RepeatedInt64Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Int64); ok {
+		return x.Int64
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetUint32() *RepeatedUint32Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Uint32); ok {
+		return x.Uint32
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetUint64() *RepeatedUint64Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Uint64); ok {
+		return x.Uint64
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetSint32() *RepeatedSint32Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Sint32); ok {
+		return x.Sint32
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetSint64() *RepeatedSint64Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Sint64); ok {
+		return x.Sint64
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetFixed32() *RepeatedFixed32Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Fixed32); ok {
+		return x.Fixed32
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetFixed64() *RepeatedFixed64Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Fixed64); ok {
+		return x.Fixed64
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetSfixed32() *RepeatedSfixed32Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Sfixed32); ok {
+		return x.Sfixed32
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetSfixed64() *RepeatedSfixed64Flag {
+	if x, ok := x.GetType().(*RepeatedFlags_Sfixed64); ok {
+		return x.Sfixed64
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetBool() *RepeatedBoolFlag {
+	if x, ok := x.GetType().(*RepeatedFlags_Bool); ok {
+		return x.Bool
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetString_() *RepeatedStringFlag {
+	if x, ok := x.GetType().(*RepeatedFlags_String_); ok {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetBytes() *RepeatedBytesFlag {
+	if x, ok := x.GetType().(*RepeatedFlags_Bytes); ok {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetEnum() *RepeatedEnumFlag {
+	if x, ok := x.GetType().(*RepeatedFlags_Enum); ok {
+		return x.Enum
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetDuration() *RepeatedDurationFlag {
+	if x, ok := x.GetType().(*RepeatedFlags_Duration); ok {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *RepeatedFlags) GetTimestamp() *RepeatedTimestampFlag {
+	if x, ok := x.GetType().(*RepeatedFlags_Timestamp); ok {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type isRepeatedFlags_Type interface {
+	isRepeatedFlags_Type()
+}
+
+type RepeatedFlags_Float struct {
+	// Supported types for repeated fields - each corresponds to its specific flag type
+	// configuration that controls how individual elements are handled.
+	Float *RepeatedFloatFlag `protobuf:"bytes,1,opt,name=float,proto3,oneof"` // Repeated 32-bit floating point
+}
+
+type RepeatedFlags_Double struct {
+	Double *RepeatedDoubleFlag `protobuf:"bytes,2,opt,name=double,proto3,oneof"` // Repeated 64-bit floating point
+}
+
+type RepeatedFlags_Int32 struct {
+	Int32 *RepeatedInt32Flag `protobuf:"bytes,3,opt,name=int32,proto3,oneof"` // Repeated 32-bit signed integer
+}
+
+type RepeatedFlags_Int64 struct {
+	Int64 *RepeatedInt64Flag `protobuf:"bytes,4,opt,name=int64,proto3,oneof"` // Repeated 64-bit signed integer
+}
+
+type RepeatedFlags_Uint32 struct {
+	Uint32 *RepeatedUint32Flag `protobuf:"bytes,5,opt,name=uint32,proto3,oneof"` // Repeated 32-bit unsigned integer
+}
+
+type RepeatedFlags_Uint64 struct {
+	Uint64 *RepeatedUint64Flag `protobuf:"bytes,6,opt,name=uint64,proto3,oneof"` // Repeated 64-bit unsigned integer
+}
+
+type RepeatedFlags_Sint32 struct {
+	Sint32 *RepeatedSint32Flag `protobuf:"bytes,7,opt,name=sint32,proto3,oneof"` // Repeated 32-bit signed integer (zigzag)
+}
+
+type RepeatedFlags_Sint64 struct {
+	Sint64 *RepeatedSint64Flag `protobuf:"bytes,8,opt,name=sint64,proto3,oneof"` // Repeated 64-bit signed integer (zigzag)
+}
+
+type RepeatedFlags_Fixed32 struct {
+	Fixed32 *RepeatedFixed32Flag `protobuf:"bytes,9,opt,name=fixed32,proto3,oneof"` // Repeated 32-bit fixed-point integer
+}
+
+type RepeatedFlags_Fixed64 struct {
+	Fixed64 *RepeatedFixed64Flag `protobuf:"bytes,10,opt,name=fixed64,proto3,oneof"` // Repeated 64-bit fixed-point integer
+}
+
+type RepeatedFlags_Sfixed32 struct {
+	Sfixed32 *RepeatedSfixed32Flag `protobuf:"bytes,11,opt,name=sfixed32,proto3,oneof"` // Repeated 32-bit signed fixed-point integer
+}
+
+type RepeatedFlags_Sfixed64 struct {
+	Sfixed64 *RepeatedSfixed64Flag `protobuf:"bytes,12,opt,name=sfixed64,proto3,oneof"` // Repeated 64-bit signed fixed-point integer
+}
+
+type RepeatedFlags_Bool struct {
+	Bool *RepeatedBoolFlag `protobuf:"bytes,13,opt,name=bool,proto3,oneof"` // Repeated boolean values
+}
+
+type RepeatedFlags_String_ struct {
+	String_ *RepeatedStringFlag `protobuf:"bytes,14,opt,name=string,proto3,oneof"` // Repeated UTF-8 strings
+}
+
+type RepeatedFlags_Bytes struct {
+	// Bytes field type with encoding type selection
+	Bytes *RepeatedBytesFlag `protobuf:"bytes,15,opt,name=bytes,proto3,oneof"` // Repeated arbitrary bytes with encoding format
+}
+
+type RepeatedFlags_Enum struct {
+	Enum *RepeatedEnumFlag `protobuf:"bytes,16,opt,name=enum,proto3,oneof"` // Repeated enum values
+}
+
+type RepeatedFlags_Duration struct {
+	Duration *RepeatedDurationFlag `protobuf:"bytes,17,opt,name=duration,proto3,oneof"` // Repeated duration values
+}
+
+type RepeatedFlags_Timestamp struct {
+	Timestamp *RepeatedTimestampFlag `protobuf:"bytes,18,opt,name=timestamp,proto3,oneof"` // Repeated timestamp values
+}
+
+func (*RepeatedFlags_Float) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Double) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Int32) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Int64) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Uint32) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Uint64) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Sint32) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Sint64) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Fixed32) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Fixed64) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Sfixed32) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Sfixed64) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Bool) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_String_) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Bytes) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Enum) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Duration) isRepeatedFlags_Type() {}
+
+func (*RepeatedFlags_Timestamp) isRepeatedFlags_Type() {}
+
+// FieldFlags encapsulates the flag configuration for each type of field.
+//
+// The oneof type field ensures type safety and allows the code generator to
+// determine which pflag method to use (StringVarP, IntVarP, etc.) for each
+// field type. Only one of the type fields should be set, corresponding to
+// the actual protobuf field type.
+type FieldFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*FieldFlags_Float
+	//	*FieldFlags_Double
+	//	*FieldFlags_Int32
+	//	*FieldFlags_Int64
+	//	*FieldFlags_Uint32
+	//	*FieldFlags_Uint64
+	//	*FieldFlags_Sint32
+	//	*FieldFlags_Sint64
+	//	*FieldFlags_Fixed32
+	//	*FieldFlags_Fixed64
+	//	*FieldFlags_Sfixed32
+	//	*FieldFlags_Sfixed64
+	//	*FieldFlags_Bool
+	//	*FieldFlags_String_
+	//	*FieldFlags_Bytes
+	//	*FieldFlags_Enum
+	//	*FieldFlags_Repeated
+	//	*FieldFlags_Map
+	//	*FieldFlags_Duration
+	//	*FieldFlags_Timestamp
+	//	*FieldFlags_Message
+	//	*FieldFlags_Struct
+	//	*FieldFlags_FieldMask
+	//	*FieldFlags_Any
+	//	*FieldFlags_Date
+	//	*FieldFlags_TimeOfDay
+	//	*FieldFlags_Money
+	//	*FieldFlags_LatLng
+	//	*FieldFlags_Decimal
+	Type isFieldFlags_Type `protobuf_oneof:"type"`
+}
+
+func (x *FieldFlags) Reset() {
+	*x = FieldFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flags_annotations_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldFlags) ProtoMessage() {}
+
+func (x *FieldFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_flags_annotations_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldFlags.ProtoReflect.Descriptor instead.
+func (*FieldFlags) Descriptor() ([]byte, []int) {
+	return file_flags_annotations_proto_rawDescGZIP(), []int{45}
+}
+
+func (m *FieldFlags) GetType() isFieldFlags_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetFloat() *FloatFlag {
+	if x, ok := x.GetType().(*FieldFlags_Float); ok {
+		return x.Float
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetDouble() *DoubleFlag {
+	if x, ok := x.GetType().(*FieldFlags_Double); ok {
+		return x.Double
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetInt32() *Int32Flag {
+	if x, ok := x.GetType().(*FieldFlags_Int32); ok {
+		return x.Int32
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetInt64() *Int64Flag {
+	if x, ok := x.GetType().(*FieldFlags_Int64); ok {
+		return x.Int64
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetUint32() *Uint32Flag {
+	if x, ok := x.GetType().(*FieldFlags_Uint32); ok {
+		return x.Uint32
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetUint64() *Uint64Flag {
+	if x, ok := x.GetType().(*FieldFlags_Uint64); ok {
+		return x.Uint64
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetSint32() *Sint32Flag {
+	if x, ok := x.GetType().(*FieldFlags_Sint32); ok {
+		return x.Sint32
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetSint64() *Sint64Flag {
+	if x, ok := x.GetType().(*FieldFlags_Sint64); ok {
+		return x.Sint64
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetFixed32() *Fixed32Flag {
+	if x, ok := x.GetType().(*FieldFlags_Fixed32); ok {
+		return x.Fixed32
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetFixed64() *Fixed64Flag {
+	if x, ok := x.GetType().(*FieldFlags_Fixed64); ok {
+		return x.Fixed64
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetSfixed32() *Sfixed32Flag {
+	if x, ok := x.GetType().(*FieldFlags_Sfixed32); ok {
+		return x.Sfixed32
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetSfixed64() *Sfixed64Flag {
+	if x, ok := x.GetType().(*FieldFlags_Sfixed64); ok {
+		return x.Sfixed64
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetBool() *BoolFlag {
+	if x, ok := x.GetType().(*FieldFlags_Bool); ok {
+		return x.Bool
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetString_() *StringFlag {
+	if x, ok := x.GetType().(*FieldFlags_String_); ok {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetBytes() *BytesFlag {
+	if x, ok := x.GetType().(*FieldFlags_Bytes); ok {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetEnum() *EnumFlag {
+	if x, ok := x.GetType().(*FieldFlags_Enum); ok {
+		return x.Enum
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetRepeated() *RepeatedFlags {
+	if x, ok := x.GetType().(*FieldFlags_Repeated); ok {
+		return x.Repeated
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetMap() *MapFlag {
+	if x, ok := x.GetType().(*FieldFlags_Map); ok {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetDuration() *DurationFlag {
+	if x, ok := x.GetType().(*FieldFlags_Duration); ok {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetTimestamp() *TimestampFlag {
+	if x, ok := x.GetType().(*FieldFlags_Timestamp); ok {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetMessage() *MessageFlag {
+	if x, ok := x.GetType().(*FieldFlags_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetStruct() *StructFlag {
+	if x, ok := x.GetType().(*FieldFlags_Struct); ok {
+		return x.Struct
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetFieldMask() *FieldMaskFlag {
+	if x, ok := x.GetType().(*FieldFlags_FieldMask); ok {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetAny() *AnyFlag {
+	if x, ok := x.GetType().(*FieldFlags_Any); ok {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetDate() *GoogleTypeFlag {
+	if x, ok := x.GetType().(*FieldFlags_Date); ok {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetTimeOfDay() *GoogleTypeFlag {
+	if x, ok := x.GetType().(*FieldFlags_TimeOfDay); ok {
+		return x.TimeOfDay
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetMoney() *GoogleTypeFlag {
+	if x, ok := x.GetType().(*FieldFlags_Money); ok {
+		return x.Money
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetLatLng() *GoogleTypeFlag {
+	if x, ok := x.GetType().(*FieldFlags_LatLng); ok {
+		return x.LatLng
+	}
+	return nil
+}
+
+func (x *FieldFlags) GetDecimal() *GoogleTypeFlag {
+	if x, ok := x.GetType().(*FieldFlags_Decimal); ok {
+		return x.Decimal
+	}
+	return nil
+}
+
+type isFieldFlags_Type interface {
+	isFieldFlags_Type()
+}
+
+type FieldFlags_Float struct {
+	// Scalar Field Types - each type has its own flag configuration with default value support
+	Float *FloatFlag `protobuf:"bytes,1,opt,name=float,proto3,oneof"` // 32-bit floating point
+}
+
+type FieldFlags_Double struct {
+	Double *DoubleFlag `protobuf:"bytes,2,opt,name=double,proto3,oneof"` // 64-bit floating point
+}
+
+type FieldFlags_Int32 struct {
+	Int32 *Int32Flag `protobuf:"bytes,3,opt,name=int32,proto3,oneof"` // 32-bit signed integer
+}
+
+type FieldFlags_Int64 struct {
+	Int64 *Int64Flag `protobuf:"bytes,4,opt,name=int64,proto3,oneof"` // 64-bit signed integer
+}
+
+type FieldFlags_Uint32 struct {
+	Uint32 *Uint32Flag `protobuf:"bytes,5,opt,name=uint32,proto3,oneof"` // 32-bit unsigned integer
+}
+
+type FieldFlags_Uint64 struct {
+	Uint64 *Uint64Flag `protobuf:"bytes,6,opt,name=uint64,proto3,oneof"` // 64-bit unsigned integer
+}
+
+type FieldFlags_Sint32 struct {
+	Sint32 *Sint32Flag `protobuf:"bytes,7,opt,name=sint32,proto3,oneof"` // 32-bit signed integer (zigzag encoded)
+}
+
+type FieldFlags_Sint64 struct {
+	Sint64 *Sint64Flag `protobuf:"bytes,8,opt,name=sint64,proto3,oneof"` // 64-bit signed integer (zigzag encoded)
+}
+
+type FieldFlags_Fixed32 struct {
+	Fixed32 *Fixed32Flag `protobuf:"bytes,9,opt,name=fixed32,proto3,oneof"` // 32-bit fixed-point integer
+}
+
+type FieldFlags_Fixed64 struct {
+	Fixed64 *Fixed64Flag `protobuf:"bytes,10,opt,name=fixed64,proto3,oneof"` // 64-bit fixed-point integer
+}
+
+type FieldFlags_Sfixed32 struct {
+	Sfixed32 *Sfixed32Flag `protobuf:"bytes,11,opt,name=sfixed32,proto3,oneof"` // 32-bit signed fixed-point integer
+}
+
+type FieldFlags_Sfixed64 struct {
+	Sfixed64 *Sfixed64Flag `protobuf:"bytes,12,opt,name=sfixed64,proto3,oneof"` // 64-bit signed fixed-point integer
+}
+
+type FieldFlags_Bool struct {
+	Bool *BoolFlag `protobuf:"bytes,13,opt,name=bool,proto3,oneof"` // Boolean value
+}
+
+type FieldFlags_String_ struct {
+	String_ *StringFlag `protobuf:"bytes,14,opt,name=string,proto3,oneof"` // UTF-8 string
+}
+
+type FieldFlags_Bytes struct {
+	// Bytes field type with encoding type selection
+	Bytes *BytesFlag `protobuf:"bytes,15,opt,name=bytes,proto3,oneof"` // Arbitrary bytes with encoding format
+}
+
+type FieldFlags_Enum struct {
+	// Complex Field Types
+	Enum *EnumFlag `protobuf:"bytes,16,opt,name=enum,proto3,oneof"` // Enum type
+}
+
+type FieldFlags_Repeated struct {
+	Repeated *RepeatedFlags `protobuf:"bytes,17,opt,name=repeated,proto3,oneof"` // Repeated field (slice/array)
+}
+
+type FieldFlags_Map struct {
+	Map *MapFlag `protobuf:"bytes,18,opt,name=map,proto3,oneof"` // Map field
+}
+
+type FieldFlags_Duration struct {
+	// Well-Known Field Types
+	Duration *DurationFlag `protobuf:"bytes,19,opt,name=duration,proto3,oneof"` // google.protobuf.Duration
+}
+
+type FieldFlags_Timestamp struct {
+	Timestamp *TimestampFlag `protobuf:"bytes,20,opt,name=timestamp,proto3,oneof"` // google.protobuf.Timestamp
+}
+
+type FieldFlags_Message struct {
+	// Message field type - used for nested message configurations
+	Message *MessageFlag `protobuf:"bytes,21,opt,name=message,proto3,oneof"`
+}
+
+type FieldFlags_Struct struct {
+	// google.protobuf.Struct, Value and ListValue given as JSON
+	Struct *StructFlag `protobuf:"bytes,22,opt,name=struct,proto3,oneof"`
+}
+
+type FieldFlags_FieldMask struct {
+	// google.protobuf.FieldMask given as comma-separated paths
+	FieldMask *FieldMaskFlag `protobuf:"bytes,23,opt,name=field_mask,json=fieldMask,proto3,oneof"`
+}
+
+type FieldFlags_Any struct {
+	// google.protobuf.Any given as protojson with an @type URL
+	Any *AnyFlag `protobuf:"bytes,24,opt,name=any,proto3,oneof"`
+}
+
+type FieldFlags_Date struct {
+	// google.type common messages given in their natural string syntax
+	Date *GoogleTypeFlag `protobuf:"bytes,25,opt,name=date,proto3,oneof"` // google.type.Date, e.g. 2026-10-16
+}
+
+type FieldFlags_TimeOfDay struct {
+	TimeOfDay *GoogleTypeFlag `protobuf:"bytes,26,opt,name=time_of_day,json=timeOfDay,proto3,oneof"` // google.type.TimeOfDay, e.g. 13:45
+}
+
+type FieldFlags_Money struct {
+	Money *GoogleTypeFlag `protobuf:"bytes,27,opt,name=money,proto3,oneof"` // google.type.Money, e.g. 12.50 USD
+}
+
+type FieldFlags_LatLng struct {
+	LatLng *GoogleTypeFlag `protobuf:"bytes,28,opt,name=lat_lng,json=latLng,proto3,oneof"` // google.type.LatLng, e.g. 52.52,13.40
+}
+
+type FieldFlags_Decimal struct {
+	Decimal *GoogleTypeFlag `protobuf:"bytes,29,opt,name=decimal,proto3,oneof"` // google.type.Decimal, e.g. 3.14159
+}
+
+func (*FieldFlags_Float) isFieldFlags_Type() {}
+
+func (*FieldFlags_Double) isFieldFlags_Type() {}
+
+func (*FieldFlags_Int32) isFieldFlags_Type() {}
+
+func (*FieldFlags_Int64) isFieldFlags_Type() {}
+
+func (*FieldFlags_Uint32) isFieldFlags_Type() {}
+
+func (*FieldFlags_Uint64) isFieldFlags_Type() {}
+
+func (*FieldFlags_Sint32) isFieldFlags_Type() {}
+
+func (*FieldFlags_Sint64) isFieldFlags_Type() {}
+
+func (*FieldFlags_Fixed32) isFieldFlags_Type() {}
+
+func (*FieldFlags_Fixed64) isFieldFlags_Type() {}
+
+func (*FieldFlags_Sfixed32) isFieldFlags_Type() {}
+
+func (*FieldFlags_Sfixed64) isFieldFlags_Type() {}
+
+func (*FieldFlags_Bool) isFieldFlags_Type() {}
+
+func (*FieldFlags_String_) isFieldFlags_Type() {}
+
+func (*FieldFlags_Bytes) isFieldFlags_Type() {}
+
+func (*FieldFlags_Enum) isFieldFlags_Type() {}
+
+func (*FieldFlags_Repeated) isFieldFlags_Type() {}
+
+func (*FieldFlags_Map) isFieldFlags_Type() {}
+
+func (*FieldFlags_Duration) isFieldFlags_Type() {}
+
+func (*FieldFlags_Timestamp) isFieldFlags_Type() {}
+
+func (*FieldFlags_Message) isFieldFlags_Type() {}
+
+func (*FieldFlags_Struct) isFieldFlags_Type() {}
+
+func (*FieldFlags_FieldMask) isFieldFlags_Type() {}
+
+func (*FieldFlags_Any) isFieldFlags_Type() {}
+
+func (*FieldFlags_Date) isFieldFlags_Type() {}
+
+func (*FieldFlags_TimeOfDay) isFieldFlags_Type() {}
+
+func (*FieldFlags_Money) isFieldFlags_Type() {}
+
+func (*FieldFlags_LatLng) isFieldFlags_Type() {}
+
+func (*FieldFlags_Decimal) isFieldFlags_Type() {}
+
+var file_flags_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         1171,
+		Name:          "flags.disabled",
+		Tag:           "varint,1171,opt,name=disabled",
+		Filename:      "flags/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         1172,
+		Name:          "flags.unexported",
+		Tag:           "varint,1172,opt,name=unexported",
+		Filename:      "flags/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         1173,
+		Name:          "flags.allow_empty",
+		Tag:           "varint,1173,opt,name=allow_empty",
+		Filename:      "flags/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldFlags)(nil),
+		Field:         1171,
+		Name:          "flags.value",
+		Tag:           "bytes,1171,opt,name=value",
+		Filename:      "flags/annotations.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// Disabled skips generation of flags for this entire message when set to true.
+	// This takes precedence over all other flag generation options.
+	//
+	// optional bool disabled = 1171;
+	E_Disabled = &file_flags_annotations_proto_extTypes[0]
+	// Unexported generates unexported flag methods for this message when set to true.
+	// This is useful when you want to provide custom exported methods that call
+	// the generated unexported methods.
+	//
+	// optional bool unexported = 1172;
+	E_Unexported = &file_flags_annotations_proto_extTypes[1]
+	// AllowEmpty allows generation of AddFlags methods even when the message has
+	// no fields configured with flag options. This is useful for messages that
+	// want to provide a consistent interface or may have flags added in the future.
+	//
+	// optional bool allow_empty = 1173;
+	E_AllowEmpty = &file_flags_annotations_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// Value specifies the flag configuration for this field. When set, the
+	// protoc-gen-flags plugin will generate command-line flag bindings based
+	// on the field type and configuration provided.
+	//
+	// optional flags.FieldFlags value = 1171;
+	E_Value = &file_flags_annotations_proto_extTypes[3]
+)
+
+var File_flags_annotations_proto protoreflect.FileDescriptor
+
+var file_flags_annotations_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xef,
+	0x02, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x22, 0xec, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22,
+	0xdb, 0x02, 0x0a, 0x09, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52,
+	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xdc, 0x02,
+	0x0a, 0x0a, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x07,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xdb, 0x02, 0x0a,
+	0x09, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55,
+	0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xdb, 0x02, 0x0a, 0x09, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x69,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x0a, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x0a, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75,
+	0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x07, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75,
+	0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x06, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75,
+	0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x22, 0xde, 0x02, 0x0a, 0x0c, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0f, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xde, 0x02, 0x0a, 0x0c, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x10, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x75, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x6c, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xd8, 0x03, 0x0a, 0x0a,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x50, 0x61, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63,
+	0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xa8, 0x03, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x02, 0x52, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x22, 0xa9, 0x03, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xe6, 0x03,
+	0x0a, 0x11, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xe6, 0x03, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22,
+	0xe7, 0x03, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xe7, 0x03, 0x0a, 0x12, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x22, 0xe7, 0x03, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xe7, 0x03,
+	0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a,
+	0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xe8, 0x03, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x07, 0x52,
+	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26,
+	0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x22, 0xe8, 0x03, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x06, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xe9, 0x03,
+	0x0a, 0x14, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0f, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xe9, 0x03, 0x0a, 0x14, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x10, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xa7, 0x03, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22,
+	0xed, 0x04, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x26,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x65,
+	0x49, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x66, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22,
+	0x84, 0x04, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xa7, 0x03, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x22, 0xab, 0x03, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xe2,
+	0x03, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x08, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x6c, 0x61, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xbf, 0x02, 0x0a, 0x07, 0x4d, 0x61, 0x70,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xc3, 0x02, 0x0a, 0x0c, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x22, 0xcd, 0x02, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x22, 0xbc, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x6c, 0x61, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6c,
+	0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22,
+	0x94, 0x02, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a,
+	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0xb6, 0x02, 0x0a, 0x07, 0x41, 0x6e, 0x79, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x22, 0x98, 0x02, 0x0a, 0x0e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xda, 0x07, 0x0a, 0x0d,
+	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x30, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12,
+	0x33, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52,
+	0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48,
+	0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46,
+	0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x33, 0x0a,
+	0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52,
+	0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c,
+	0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x36, 0x0a, 0x07,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c, 0x61,
+	0x67, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x39, 0x0a, 0x08,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x12, 0x2d, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f,
+	0x6c, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x48,
+	0x00, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x48,
+	0x00, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46,
+	0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd1, 0x0a, 0x0a, 0x0a, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x12, 0x2b, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48,
+	0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x12, 0x2b, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x2b, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c,
+	0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x06,
+	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48,
+	0x00, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x2e, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06,
+	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x07, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x07, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52,
+	0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x6c, 0x61, 0x67,
+	0x48, 0x00, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x25, 0x0a, 0x04,
+	0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x04, 0x62,
+	0x6f, 0x6f, 0x6c, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x28, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x6c, 0x61,
+	0x67, 0x48, 0x00, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x65, 0x6e,
+	0x75, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x04, 0x65, 0x6e, 0x75,
+	0x6d, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x6c,
+	0x61, 0x67, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67,
+	0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12,
+	0x35, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x22, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x41, 0x6e, 0x79, 0x46,
+	0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x48,
+	0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6f, 0x66, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44, 0x61, 0x79,
+	0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x12,
+	0x30, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x5f, 0x6c, 0x6e, 0x67, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x4c, 0x6e,
+	0x67, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0xa1, 0x02, 0x0a,
+	0x11, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x59, 0x54, 0x45, 0x53,
+	0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42,
+	0x41, 0x53, 0x45, 0x36, 0x34, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x59, 0x54, 0x45, 0x53,
+	0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48,
+	0x45, 0x58, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e,
+	0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x45,
+	0x36, 0x34, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x59, 0x54, 0x45,
+	0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x42, 0x41, 0x53, 0x45, 0x36, 0x34, 0x5f, 0x52, 0x41, 0x57, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22,
+	0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x36, 0x34, 0x5f, 0x52, 0x41, 0x57, 0x5f, 0x55,
+	0x52, 0x4c, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e,
+	0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x54, 0x46, 0x38,
+	0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x45, 0x4e, 0x43, 0x4f,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x07,
+	0x2a, 0x80, 0x01, 0x0a, 0x09, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x16, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x50,
+	0x4c, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x52, 0x52,
+	0x41, 0x59, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x45, 0x50, 0x41, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x13,
+	0x0a, 0x0f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x53, 0x4f,
+	0x4e, 0x10, 0x04, 0x2a, 0x6e, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41,
+	0x43, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x4d,
+	0x45, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x45, 0x4e,
+	0x44, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x08, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x19, 0x0a, 0x15, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x10,
+	0x02, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x2a, 0x82, 0x02, 0x0a, 0x10, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a,
+	0x1e, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x4e, 0x4f, 0x53, 0x45, 0x43, 0x4f,
+	0x4e, 0x44, 0x53, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x43, 0x52,
+	0x4f, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x53, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x55,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4d, 0x49, 0x4c, 0x4c, 0x49, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x53, 0x10, 0x03, 0x12,
+	0x1e, 0x0a, 0x1a, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x53, 0x10, 0x04, 0x12,
+	0x1e, 0x0a, 0x1a, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x05, 0x12,
+	0x1c, 0x0a, 0x18, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x06, 0x2a, 0xa5, 0x02,
+	0x0a, 0x10, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x50, 0x10,
+	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x50, 0x56, 0x34, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x53,
+	0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x49, 0x44, 0x52, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x52, 0x49,
+	0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48,
+	0x4f, 0x53, 0x54, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54,
+	0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x52, 0x4c, 0x10, 0x06, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x53,
+	0x54, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x52, 0x49, 0x4e,
+	0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4d,
+	0x41, 0x49, 0x4c, 0x10, 0x08, 0x2a, 0xb7, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x54, 0x48, 0x5f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x54, 0x48,
+	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x53, 0x54,
+	0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x41, 0x54, 0x48,
+	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x53, 0x54,
+	0x5f, 0x42, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41,
+	0x54, 0x48, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55,
+	0x53, 0x54, 0x5f, 0x42, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x50,
+	0x41, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
+	0x55, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x04, 0x2a,
+	0x93, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20,
+	0x4d, 0x41, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x41, 0x50, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x5f,
+	0x49, 0x4e, 0x54, 0x10, 0x03, 0x3a, 0x3c, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x3a, 0x40, 0x0a, 0x0a, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x94, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x3a, 0x41, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x95, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x3a, 0x47, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6b, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3b, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_flags_annotations_proto_rawDescOnce sync.Once
+	file_flags_annotations_proto_rawDescData = file_flags_annotations_proto_rawDesc
+)
+
+func file_flags_annotations_proto_rawDescGZIP() []byte {
+	file_flags_annotations_proto_rawDescOnce.Do(func() {
+		file_flags_annotations_proto_rawDescData = protoimpl.X.CompressGZIP(file_flags_annotations_proto_rawDescData)
+	})
+	return file_flags_annotations_proto_rawDescData
+}
+
+var file_flags_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_flags_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_flags_annotations_proto_goTypes = []interface{}{
+	(BytesEncodingType)(0),              // 0: flags.BytesEncodingType
+	(SplitType)(0),                      // 1: flags.SplitType
+	(MergeType)(0),                      // 2: flags.MergeType
+	(UnitType)(0),                       // 3: flags.UnitType
+	(DurationUnitType)(0),               // 4: flags.DurationUnitType
+	(StringFormatType)(0),               // 5: flags.StringFormatType
+	(PathCheckType)(0),                  // 6: flags.PathCheckType
+	(MapFormatType)(0),                  // 7: flags.MapFormatType
+	(*PathOptions)(nil),                 // 8: flags.PathOptions
+	(*BytesFlag)(nil),                   // 9: flags.BytesFlag
+	(*PrimitiveFlag)(nil),               // 10: flags.PrimitiveFlag
+	(*FloatFlag)(nil),                   // 11: flags.FloatFlag
+	(*DoubleFlag)(nil),                  // 12: flags.DoubleFlag
+	(*Int32Flag)(nil),                   // 13: flags.Int32Flag
+	(*Int64Flag)(nil),                   // 14: flags.Int64Flag
+	(*Uint32Flag)(nil),                  // 15: flags.Uint32Flag
+	(*Uint64Flag)(nil),                  // 16: flags.Uint64Flag
+	(*Sint32Flag)(nil),                  // 17: flags.Sint32Flag
+	(*Sint64Flag)(nil),                  // 18: flags.Sint64Flag
+	(*Fixed32Flag)(nil),                 // 19: flags.Fixed32Flag
+	(*Fixed64Flag)(nil),                 // 20: flags.Fixed64Flag
+	(*Sfixed32Flag)(nil),                // 21: flags.Sfixed32Flag
+	(*Sfixed64Flag)(nil),                // 22: flags.Sfixed64Flag
+	(*BoolFlag)(nil),                    // 23: flags.BoolFlag
+	(*StringFlag)(nil),                  // 24: flags.StringFlag
+	(*RepeatedFloatFlag)(nil),           // 25: flags.RepeatedFloatFlag
+	(*RepeatedDoubleFlag)(nil),          // 26: flags.RepeatedDoubleFlag
+	(*RepeatedInt32Flag)(nil),           // 27: flags.RepeatedInt32Flag
+	(*RepeatedInt64Flag)(nil),           // 28: flags.RepeatedInt64Flag
+	(*RepeatedUint32Flag)(nil),          // 29: flags.RepeatedUint32Flag
+	(*RepeatedUint64Flag)(nil),          // 30: flags.RepeatedUint64Flag
+	(*RepeatedSint32Flag)(nil),          // 31: flags.RepeatedSint32Flag
+	(*RepeatedSint64Flag)(nil),          // 32: flags.RepeatedSint64Flag
+	(*RepeatedFixed32Flag)(nil),         // 33: flags.RepeatedFixed32Flag
+	(*RepeatedFixed64Flag)(nil),         // 34: flags.RepeatedFixed64Flag
+	(*RepeatedSfixed32Flag)(nil),        // 35: flags.RepeatedSfixed32Flag
+	(*RepeatedSfixed64Flag)(nil),        // 36: flags.RepeatedSfixed64Flag
+	(*RepeatedBoolFlag)(nil),            // 37: flags.RepeatedBoolFlag
+	(*RepeatedStringFlag)(nil),          // 38: flags.RepeatedStringFlag
+	(*RepeatedBytesFlag)(nil),           // 39: flags.RepeatedBytesFlag
+	(*RepeatedEnumFlag)(nil),            // 40: flags.RepeatedEnumFlag
+	(*RepeatedDurationFlag)(nil),        // 41: flags.RepeatedDurationFlag
+	(*RepeatedTimestampFlag)(nil),       // 42: flags.RepeatedTimestampFlag
+	(*EnumFlag)(nil),                    // 43: flags.EnumFlag
+	(*MapFlag)(nil),                     // 44: flags.MapFlag
+	(*DurationFlag)(nil),                // 45: flags.DurationFlag
+	(*TimestampFlag)(nil),               // 46: flags.TimestampFlag
+	(*MessageFlag)(nil),                 // 47: flags.MessageFlag
+	(*StructFlag)(nil),                  // 48: flags.StructFlag
+	(*FieldMaskFlag)(nil),               // 49: flags.FieldMaskFlag
+	(*AnyFlag)(nil),                     // 50: flags.AnyFlag
+	(*GoogleTypeFlag)(nil),              // 51: flags.GoogleTypeFlag
+	(*RepeatedFlags)(nil),               // 52: flags.RepeatedFlags
+	(*FieldFlags)(nil),                  // 53: flags.FieldFlags
+	(*descriptorpb.MessageOptions)(nil), // 54: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 55: google.protobuf.FieldOptions
+}
+var file_flags_annotations_proto_depIdxs = []int32{
+	6,   // 0: flags.PathOptions.check:type_name -> flags.PathCheckType
+	0,   // 1: flags.BytesFlag.encoding:type_name -> flags.BytesEncodingType
+	3,   // 2: flags.FloatFlag.unit:type_name -> flags.UnitType
+	3,   // 3: flags.DoubleFlag.unit:type_name -> flags.UnitType
+	3,   // 4: flags.Int32Flag.unit:type_name -> flags.UnitType
+	3,   // 5: flags.Int64Flag.unit:type_name -> flags.UnitType
+	3,   // 6: flags.Uint32Flag.unit:type_name -> flags.UnitType
+	3,   // 7: flags.Uint64Flag.unit:type_name -> flags.UnitType
+	3,   // 8: flags.Sint32Flag.unit:type_name -> flags.UnitType
+	3,   // 9: flags.Sint64Flag.unit:type_name -> flags.UnitType
+	3,   // 10: flags.Fixed32Flag.unit:type_name -> flags.UnitType
+	3,   // 11: flags.Fixed64Flag.unit:type_name -> flags.UnitType
+	3,   // 12: flags.Sfixed32Flag.unit:type_name -> flags.UnitType
+	3,   // 13: flags.Sfixed64Flag.unit:type_name -> flags.UnitType
+	5,   // 14: flags.StringFlag.format:type_name -> flags.StringFormatType
+	8,   // 15: flags.StringFlag.path:type_name -> flags.PathOptions
+	1,   // 16: flags.RepeatedFloatFlag.split:type_name -> flags.SplitType
+	2,   // 17: flags.RepeatedFloatFlag.merge:type_name -> flags.MergeType
+	1,   // 18: flags.RepeatedDoubleFlag.split:type_name -> flags.SplitType
+	2,   // 19: flags.RepeatedDoubleFlag.merge:type_name -> flags.MergeType
+	1,   // 20: flags.RepeatedInt32Flag.split:type_name -> flags.SplitType
+	2,   // 21: flags.RepeatedInt32Flag.merge:type_name -> flags.MergeType
+	1,   // 22: flags.RepeatedInt64Flag.split:type_name -> flags.SplitType
+	2,   // 23: flags.RepeatedInt64Flag.merge:type_name -> flags.MergeType
+	1,   // 24: flags.RepeatedUint32Flag.split:type_name -> flags.SplitType
+	2,   // 25: flags.RepeatedUint32Flag.merge:type_name -> flags.MergeType
+	1,   // 26: flags.RepeatedUint64Flag.split:type_name -> flags.SplitType
+	2,   // 27: flags.RepeatedUint64Flag.merge:type_name -> flags.MergeType
+	1,   // 28: flags.RepeatedSint32Flag.split:type_name -> flags.SplitType
+	2,   // 29: flags.RepeatedSint32Flag.merge:type_name -> flags.MergeType
+	1,   // 30: flags.RepeatedSint64Flag.split:type_name -> flags.SplitType
+	2,   // 31: flags.RepeatedSint64Flag.merge:type_name -> flags.MergeType
+	1,   // 32: flags.RepeatedFixed32Flag.split:type_name -> flags.SplitType
+	2,   // 33: flags.RepeatedFixed32Flag.merge:type_name -> flags.MergeType
+	1,   // 34: flags.RepeatedFixed64Flag.split:type_name -> flags.SplitType
+	2,   // 35: flags.RepeatedFixed64Flag.merge:type_name -> flags.MergeType
+	1,   // 36: flags.RepeatedSfixed32Flag.split:type_name -> flags.SplitType
+	2,   // 37: flags.RepeatedSfixed32Flag.merge:type_name -> flags.MergeType
+	1,   // 38: flags.RepeatedSfixed64Flag.split:type_name -> flags.SplitType
+	2,   // 39: flags.RepeatedSfixed64Flag.merge:type_name -> flags.MergeType
+	1,   // 40: flags.RepeatedBoolFlag.split:type_name -> flags.SplitType
+	2,   // 41: flags.RepeatedBoolFlag.merge:type_name -> flags.MergeType
+	1,   // 42: flags.RepeatedStringFlag.split:type_name -> flags.SplitType
+	2,   // 43: flags.RepeatedStringFlag.merge:type_name -> flags.MergeType
+	5,   // 44: flags.RepeatedStringFlag.format:type_name -> flags.StringFormatType
+	8,   // 45: flags.RepeatedStringFlag.path:type_name -> flags.PathOptions
+	0,   // 46: flags.RepeatedBytesFlag.encoding:type_name -> flags.BytesEncodingType
+	1,   // 47: flags.RepeatedBytesFlag.split:type_name -> flags.SplitType
+	2,   // 48: flags.RepeatedBytesFlag.merge:type_name -> flags.MergeType
+	1,   // 49: flags.RepeatedEnumFlag.split:type_name -> flags.SplitType
+	2,   // 50: flags.RepeatedEnumFlag.merge:type_name -> flags.MergeType
+	1,   // 51: flags.RepeatedDurationFlag.split:type_name -> flags.SplitType
+	2,   // 52: flags.RepeatedDurationFlag.merge:type_name -> flags.MergeType
+	1,   // 53: flags.RepeatedTimestampFlag.split:type_name -> flags.SplitType
+	2,   // 54: flags.RepeatedTimestampFlag.merge:type_name -> flags.MergeType
+	7,   // 55: flags.MapFlag.format:type_name -> flags.MapFormatType
+	4,   // 56: flags.DurationFlag.unit:type_name -> flags.DurationUnitType
+	25,  // 57: flags.RepeatedFlags.float:type_name -> flags.RepeatedFloatFlag
+	26,  // 58: flags.RepeatedFlags.double:type_name -> flags.RepeatedDoubleFlag
+	27,  // 59: flags.RepeatedFlags.int32:type_name -> flags.RepeatedInt32Flag
+	28,  // 60: flags.RepeatedFlags.int64:type_name -> flags.RepeatedInt64Flag
+	29,  // 61: flags.RepeatedFlags.uint32:type_name -> flags.RepeatedUint32Flag
+	30,  // 62: flags.RepeatedFlags.uint64:type_name -> flags.RepeatedUint64Flag
+	31,  // 63: flags.RepeatedFlags.sint32:type_name -> flags.RepeatedSint32Flag
+	32,  // 64: flags.RepeatedFlags.sint64:type_name -> flags.RepeatedSint64Flag
+	33,  // 65: flags.RepeatedFlags.fixed32:type_name -> flags.RepeatedFixed32Flag
+	34,  // 66: flags.RepeatedFlags.fixed64:type_name -> flags.RepeatedFixed64Flag
+	35,  // 67: flags.RepeatedFlags.sfixed32:type_name -> flags.RepeatedSfixed32Flag
+	36,  // 68: flags.RepeatedFlags.sfixed64:type_name -> flags.RepeatedSfixed64Flag
+	37,  // 69: flags.RepeatedFlags.bool:type_name -> flags.RepeatedBoolFlag
+	38,  // 70: flags.RepeatedFlags.string:type_name -> flags.RepeatedStringFlag
+	39,  // 71: flags.RepeatedFlags.bytes:type_name -> flags.RepeatedBytesFlag
+	40,  // 72: flags.RepeatedFlags.enum:type_name -> flags.RepeatedEnumFlag
+	41,  // 73: flags.RepeatedFlags.duration:type_name -> flags.RepeatedDurationFlag
+	42,  // 74: flags.RepeatedFlags.timestamp:type_name -> flags.RepeatedTimestampFlag
+	11,  // 75: flags.FieldFlags.float:type_name -> flags.FloatFlag
+	12,  // 76: flags.FieldFlags.double:type_name -> flags.DoubleFlag
+	13,  // 77: flags.FieldFlags.int32:type_name -> flags.Int32Flag
+	14,  // 78: flags.FieldFlags.int64:type_name -> flags.Int64Flag
+	15,  // 79: flags.FieldFlags.uint32:type_name -> flags.Uint32Flag
+	16,  // 80: flags.FieldFlags.uint64:type_name -> flags.Uint64Flag
+	17,  // 81: flags.FieldFlags.sint32:type_name -> flags.Sint32Flag
+	18,  // 82: flags.FieldFlags.sint64:type_name -> flags.Sint64Flag
+	19,  // 83: flags.FieldFlags.fixed32:type_name -> flags.Fixed32Flag
+	20,  // 84: flags.FieldFlags.fixed64:type_name -> flags.Fixed64Flag
+	21,  // 85: flags.FieldFlags.sfixed32:type_name -> flags.Sfixed32Flag
+	22,  // 86: flags.FieldFlags.sfixed64:type_name -> flags.Sfixed64Flag
+	23,  // 87: flags.FieldFlags.bool:type_name -> flags.BoolFlag
+	24,  // 88: flags.FieldFlags.string:type_name -> flags.StringFlag
+	9,   // 89: flags.FieldFlags.bytes:type_name -> flags.BytesFlag
+	43,  // 90: flags.FieldFlags.enum:type_name -> flags.EnumFlag
+	52,  // 91: flags.FieldFlags.repeated:type_name -> flags.RepeatedFlags
+	44,  // 92: flags.FieldFlags.map:type_name -> flags.MapFlag
+	45,  // 93: flags.FieldFlags.duration:type_name -> flags.DurationFlag
+	46,  // 94: flags.FieldFlags.timestamp:type_name -> flags.TimestampFlag
+	47,  // 95: flags.FieldFlags.message:type_name -> flags.MessageFlag
+	48,  // 96: flags.FieldFlags.struct:type_name -> flags.StructFlag
+	49,  // 97: flags.FieldFlags.field_mask:type_name -> flags.FieldMaskFlag
+	50,  // 98: flags.FieldFlags.any:type_name -> flags.AnyFlag
+	51,  // 99: flags.FieldFlags.date:type_name -> flags.GoogleTypeFlag
+	51,  // 100: flags.FieldFlags.time_of_day:type_name -> flags.GoogleTypeFlag
+	51,  // 101: flags.FieldFlags.money:type_name -> flags.GoogleTypeFlag
+	51,  // 102: flags.FieldFlags.lat_lng:type_name -> flags.GoogleTypeFlag
+	51,  // 103: flags.FieldFlags.decimal:type_name -> flags.GoogleTypeFlag
+	54,  // 104: flags.disabled:extendee -> google.protobuf.MessageOptions
+	54,  // 105: flags.unexported:extendee -> google.protobuf.MessageOptions
+	54,  // 106: flags.allow_empty:extendee -> google.protobuf.MessageOptions
+	55,  // 107: flags.value:extendee -> google.protobuf.FieldOptions
+	53,  // 108: flags.value:type_name -> flags.FieldFlags
+	109, // [109:109] is the sub-list for method output_type
+	109, // [109:109] is the sub-list for method input_type
+	108, // [108:109] is the sub-list for extension type_name
+	104, // [104:108] is the sub-list for extension extendee
+	0,   // [0:104] is the sub-list for field type_name
+}
+
+func init() { file_flags_annotations_proto_init() }
+func file_flags_annotations_proto_init() {
+	if File_flags_annotations_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_flags_annotations_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrimitiveFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Int32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Int64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Uint32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Uint64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sint32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sint64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fixed32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fixed64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sfixed32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sfixed64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoolFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedFloatFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedDoubleFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedInt32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedInt64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedUint32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedUint64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedSint32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedSint64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedFixed32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedFixed64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedSfixed32Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedSfixed64Flag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedBoolFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedStringFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedBytesFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedEnumFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedDurationFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedTimestampFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurationFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimestampFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldMaskFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnyFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoogleTypeFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flags_annotations_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_flags_annotations_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[37].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[43].OneofWrappers = []interface{}{}
+	file_flags_annotations_proto_msgTypes[44].OneofWrappers = []interface{}{
+		(*RepeatedFlags_Float)(nil),
+		(*RepeatedFlags_Double)(nil),
+		(*RepeatedFlags_Int32)(nil),
+		(*RepeatedFlags_Int64)(nil),
+		(*RepeatedFlags_Uint32)(nil),
+		(*RepeatedFlags_Uint64)(nil),
+		(*RepeatedFlags_Sint32)(nil),
+		(*RepeatedFlags_Sint64)(nil),
+		(*RepeatedFlags_Fixed32)(nil),
+		(*RepeatedFlags_Fixed64)(nil),
+		(*RepeatedFlags_Sfixed32)(nil),
+		(*RepeatedFlags_Sfixed64)(nil),
+		(*RepeatedFlags_Bool)(nil),
+		(*RepeatedFlags_String_)(nil),
+		(*RepeatedFlags_Bytes)(nil),
+		(*RepeatedFlags_Enum)(nil),
+		(*RepeatedFlags_Duration)(nil),
+		(*RepeatedFlags_Timestamp)(nil),
+	}
+	file_flags_annotations_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*FieldFlags_Float)(nil),
+		(*FieldFlags_Double)(nil),
+		(*FieldFlags_Int32)(nil),
+		(*FieldFlags_Int64)(nil),
+		(*FieldFlags_Uint32)(nil),
+		(*FieldFlags_Uint64)(nil),
+		(*FieldFlags_Sint32)(nil),
+		(*FieldFlags_Sint64)(nil),
+		(*FieldFlags_Fixed32)(nil),
+		(*FieldFlags_Fixed64)(nil),
+		(*FieldFlags_Sfixed32)(nil),
+		(*FieldFlags_Sfixed64)(nil),
+		(*FieldFlags_Bool)(nil),
+		(*FieldFlags_String_)(nil),
+		(*FieldFlags_Bytes)(nil),
+		(*FieldFlags_Enum)(nil),
+		(*FieldFlags_Repeated)(nil),
+		(*FieldFlags_Map)(nil),
+		(*FieldFlags_Duration)(nil),
+		(*FieldFlags_Timestamp)(nil),
+		(*FieldFlags_Message)(nil),
+		(*FieldFlags_Struct)(nil),
+		(*FieldFlags_FieldMask)(nil),
+		(*FieldFlags_Any)(nil),
+		(*FieldFlags_Date)(nil),
+		(*FieldFlags_TimeOfDay)(nil),
+		(*FieldFlags_Money)(nil),
+		(*FieldFlags_LatLng)(nil),
+		(*FieldFlags_Decimal)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_flags_annotations_proto_rawDesc,
+			NumEnums:      8,
+			NumMessages:   46,
+			NumExtensions: 4,
+			NumServices:   0,
+		},
+		GoTypes:           file_flags_annotations_proto_goTypes,
+		DependencyIndexes: file_flags_annotations_proto_depIdxs,
+		EnumInfos:         file_flags_annotations_proto_enumTypes,
+		MessageInfos:      file_flags_annotations_proto_msgTypes,
+		ExtensionInfos:    file_flags_annotations_proto_extTypes,
+	}.Build()
+	File_flags_annotations_proto = out.File
+	file_flags_annotations_proto_rawDesc = nil
+	file_flags_annotations_proto_goTypes = nil
+	file_flags_annotations_proto_depIdxs = nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// AnnotationDotted marks the flags accepting dotted overrides, such as the flags of
+// google.protobuf.Struct fields. See ExpandDotted.
+const AnnotationDotted = "protoc_gen_flags_annotation_dotted"
+
+// ExpandDotted rewrites the dotted overrides in args for the flags of fs carrying the
+// AnnotationDotted annotation: "--extra.timeout=5" and "--extra.timeout 5" become
+// "--extra=timeout=5" when "extra" is such a flag and "extra.timeout" is not a flag.
+// The next argument is only taken as the value when it does not start with "-", so
+// "--extra.debug --verbose" overrides "debug" with an empty value. The longest matching
+// flag name is used. Arguments after "--" are left unchanged.
+func ExpandDotted(fs *pflag.FlagSet, args []string) []string {
+	out := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return append(out, args[i:]...)
+		}
+		if !strings.HasPrefix(arg, "--") {
+			out = append(out, arg)
+			continue
+		}
+		name, value, hasValue := strings.Cut(arg[2:], "=")
+		flag, key := lookupDotted(fs, name)
+		if flag == "" {
+			out = append(out, arg)
+			continue
+		}
+		if !hasValue && i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+			i++
+			value = args[i]
+		}
+		out = append(out, "--"+flag+"="+key+"="+value)
+	}
+	return out
+}
+
+// Parse parses args with fs after expanding the dotted overrides, see ExpandDotted.
+func Parse(fs *pflag.FlagSet, args []string) error {
+	return fs.Parse(ExpandDotted(fs, args))
+}
+
+// EnableDotted makes fs accept the dotted overrides of its flags carrying the AnnotationDotted
+// annotation when it parses the arguments itself, as cobra does, without ExpandDotted. It wraps
+// the normalize function of fs, so a normalize function set afterwards replaces it. The first
+// time an unknown name such as "extra.timeout" is looked up, a hidden flag of that name is
+// added which sets "extra" to "timeout=<value>". The value of such a flag follows the rules
+// of pflag: "--extra.timeout 5" takes the next argument whatever it is. Call EnableDotted once
+// the flags are added, since adding a flag whose name is a dotted override of a flag with the
+// annotation panics after it was looked up.
+func EnableDotted(fs *pflag.FlagSet) {
+	normalize := fs.GetNormalizeFunc()
+	busy := false
+	fs.SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
+		n := normalize(f, name)
+		// Lookup and AddFlag normalize the name again.
+		if busy {
+			return n
+		}
+		busy = true
+		defer func() { busy = false }()
+		if flag, key := lookupDotted(f, string(n)); flag != "" {
+			f.AddFlag(&pflag.Flag{
+				Name:   string(n),
+				Usage:  "override " + key + " of --" + flag,
+				Value:  &dottedValue{fs: f, flag: flag, key: key},
+				Hidden: true,
+			})
+		}
+		return n
+	})
+}
+
+// dottedValue is the value of the hidden flags added by EnableDotted.
+type dottedValue struct {
+	fs        *pflag.FlagSet
+	flag, key string
+}
+
+func (d *dottedValue) String() string { return "" }
+
+func (d *dottedValue) Set(s string) error {
+	return d.fs.Set(d.flag, d.key+"="+s)
+}
+
+func (d *dottedValue) Type() string { return "string" }
+
+// lookupDotted splits name into the longest prefix naming a flag of fs with the AnnotationDotted
+// annotation and the key after it. flag is empty when name is a flag or has no such prefix.
+func lookupDotted(fs *pflag.FlagSet, name string) (flag, key string) {
+	if fs.Lookup(name) != nil {
+		return "", ""
+	}
+	for i := strings.LastIndex(name, "."); i > 0; i = strings.LastIndex(name[:i], ".") {
+		f := fs.Lookup(name[:i])
+		if f == nil {
+			continue
+		}
+		if _, ok := f.Annotations[AnnotationDotted]; ok && i+1 < len(name) {
+			return name[:i], name[i+1:]
+		}
+		return "", ""
+	}
+	return "", ""
+}
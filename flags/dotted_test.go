@@ -0,0 +1,150 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func newDottedFlagSet() *pflag.FlagSet {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.StringArray("server.extra", nil, "Extra settings")
+	_ = fs.SetAnnotation("server.extra", AnnotationDotted, []string{"true"})
+	fs.String("plain", "", "Plain flag")
+	fs.String("plain.x", "", "Dotted name without annotation")
+	return fs
+}
+
+func TestExpandDotted(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "inline value",
+			args: []string{"--server.extra.timeout=5"},
+			want: []string{"--server.extra=timeout=5"},
+		},
+		{
+			name: "separate value",
+			args: []string{"--server.extra.mode", "fast", "arg"},
+			want: []string{"--server.extra=mode=fast", "arg"},
+		},
+		{
+			name: "nested key",
+			args: []string{"--server.extra.db.port=5432"},
+			want: []string{"--server.extra=db.port=5432"},
+		},
+		{
+			name: "registered flags are kept",
+			args: []string{"--server.extra={}", "--plain.x=1", "-p"},
+			want: []string{"--server.extra={}", "--plain.x=1", "-p"},
+		},
+		{
+			name: "flags are not taken as the value",
+			args: []string{"--server.extra.debug", "--plain=1", "--server.extra.mode", "-p"},
+			want: []string{"--server.extra=debug=", "--plain=1", "--server.extra=mode=", "-p"},
+		},
+		{
+			name: "missing value",
+			args: []string{"--server.extra.debug"},
+			want: []string{"--server.extra=debug="},
+		},
+		{
+			name: "flags without the annotation are kept",
+			args: []string{"--plain.y=1"},
+			want: []string{"--plain.y=1"},
+		},
+		{
+			name: "arguments after the terminator are kept",
+			args: []string{"--", "--server.extra.a=1"},
+			want: []string{"--", "--server.extra.a=1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandDotted(newDottedFlagSet(), tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExpandDotted() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	fs := newDottedFlagSet()
+	if err := Parse(fs, []string{"--server.extra.a=1", "--server.extra.b", "x"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got, _ := fs.GetStringArray("server.extra")
+	if want := []string{"a=1", "b=x"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("server.extra = %q, want %q", got, want)
+	}
+	if err := Parse(newDottedFlagSet(), []string{"--plain.y=1"}); err == nil {
+		t.Error("Parse() expected error for an unknown flag")
+	}
+}
+
+func TestEnableDotted(t *testing.T) {
+	fs := newDottedFlagSet()
+	EnableDotted(fs)
+	err := fs.Parse([]string{"--server.extra.a=1", "--server.extra.b", "x", "--server.extra={}", "--plain.x=2", "arg"})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got, _ := fs.GetStringArray("server.extra")
+	if want := []string{"a=1", "b=x", "{}"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("server.extra = %q, want %q", got, want)
+	}
+	if got, _ := fs.GetString("plain.x"); got != "2" {
+		t.Errorf("plain.x = %q, want %q", got, "2")
+	}
+	if f := fs.Lookup("server.extra.a"); f == nil || !f.Hidden {
+		t.Errorf("Lookup(server.extra.a) = %v, want a hidden flag", f)
+	}
+	if err := newDottedParse([]string{"--plain.y=1"}); err == nil {
+		t.Error("Parse() expected error for an unknown flag")
+	}
+	if err := newDottedParse([]string{"--server.extra."}); err == nil {
+		t.Error("Parse() expected error for an empty key")
+	}
+}
+
+func TestEnableDotted_NormalizeFunc(t *testing.T) {
+	fs := newDottedFlagSet()
+	fs.SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		return pflag.NormalizedName(strings.ReplaceAll(name, "_", "."))
+	})
+	EnableDotted(fs)
+	if err := fs.Parse([]string{"--server_extra.a=1"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got, _ := fs.GetStringArray("server.extra")
+	if want := []string{"a=1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("server.extra = %q, want %q", got, want)
+	}
+}
+
+func newDottedParse(args []string) error {
+	fs := newDottedFlagSet()
+	fs.SetOutput(io.Discard)
+	EnableDotted(fs)
+	return fs.Parse(args)
+}
@@ -12,12 +12,16 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Package flags is the runtime support of the code generated by protoc-gen-flags: the options,
+// interfaces and helpers used by the AddFlags and SetDefaults methods of the .pb.flags.go files.
+// The annotations of flags/annotations.proto are in the annotations subpackage.
 package flags
 
 import (
 	"strings"
 
 	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 // Delimiter constants are used for separating hierarchical flag names.
@@ -40,12 +44,35 @@ const (
 	DelimiterColon = ":"
 )
 
+// Shell completion annotations attached to generated flags. The path annotations use the keys read
+// by github.com/spf13/cobra, so commands complete file and directory names for these flags.
+const (
+	// AnnotationFilenameExt restricts completion to files with the extensions in the annotation
+	// value, or to any file when the value is empty.
+	AnnotationFilenameExt = "cobra_annotation_bash_completion_filename_extensions"
+
+	// AnnotationSubdirsInDir restricts completion to directories.
+	AnnotationSubdirsInDir = "cobra_annotation_bash_completion_subdirs_in_dir"
+
+	// AnnotationChoices holds the values accepted by a flag with choices. Cobra does not read it;
+	// register it as completion candidates with cobra.Command.RegisterFlagCompletionFunc.
+	AnnotationChoices = "protoc_gen_flags_annotation_choices"
+)
+
 // Options holds configuration for flag name generation and formatting.
 // It contains settings for prefix handling, delimiter usage, and custom name transformations.
 type Options struct {
 	Prefix    []string            // Prefix segments to prepend to flag names for hierarchical organization
 	Delimiter string              // Separator used between name components (default: ".")
 	Renamer   func(string) string // Custom function to transform flag names after prefix application
+	Resolver  TypeResolver        // Registry resolving the message types of google.protobuf.Any flags
+}
+
+// TypeResolver resolves the message types of google.protobuf.Any flag values.
+// *protoregistry.Types implements it.
+type TypeResolver interface {
+	protoregistry.MessageTypeResolver
+	protoregistry.ExtensionTypeResolver
 }
 
 // Option is a functional option pattern type that modifies Options instances.
@@ -82,6 +109,16 @@ type Flagger interface {
 	AddFlags(fs *pflag.FlagSet, opts ...Option)
 }
 
+// FlaggerFunc adapts a function registering flags to the Flagger interface. The generated code
+// uses it to pass the _AddFlags method of messages marked unexported, which do not implement
+// Flagger, e.g. to AddPresenceFlags.
+type FlaggerFunc func(fs *pflag.FlagSet, opts ...Option)
+
+// AddFlags calls f(fs, opts...).
+func (f FlaggerFunc) AddFlags(fs *pflag.FlagSet, opts ...Option) {
+	f(fs, opts...)
+}
+
 // WithDelimiter returns an Option that sets the delimiter used for separating
 // hierarchical flag name components. The default delimiter is "." (dot).
 //
@@ -108,6 +145,15 @@ func WithRenamer(renamer Renamer) Option {
 	}
 }
 
+// WithTypeResolver returns an Option that sets the registry resolving the message types given to
+// google.protobuf.Any flags, instead of protoregistry.GlobalTypes. It allows restricting or
+// extending the types accepted by pluggable configuration.
+func WithTypeResolver(resolver TypeResolver) Option {
+	return func(o *Options) {
+		o.Resolver = resolver
+	}
+}
+
 // WithPrefix returns an Option that adds prefix segments to flag names.
 // Prefixes are useful for organizing flags hierarchically, such as by service
 // or module name. Empty strings are filtered out, and trimming of delimiter
@@ -163,6 +209,14 @@ func (n NameBuilder) Build(name string) string {
 	return flagName
 }
 
+// Resolver returns the registry configured with WithTypeResolver, or protoregistry.GlobalTypes.
+func (n NameBuilder) Resolver() TypeResolver {
+	if n.options.Resolver == nil {
+		return protoregistry.GlobalTypes
+	}
+	return n.options.Resolver
+}
+
 // NewNameBuilder creates a new NameBuilder with the provided configuration options.
 // If no options are provided, it uses sensible defaults (dot delimiter, identity renamer).
 //
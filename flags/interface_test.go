@@ -18,7 +18,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 func TestDelimiterConstants(t *testing.T) {
@@ -122,6 +124,28 @@ func TestWithRenamer(t *testing.T) {
 	})
 }
 
+func TestWithTypeResolver(t *testing.T) {
+	t.Run("default resolver", func(t *testing.T) {
+		builder := NewNameBuilder()
+		assert.Equal(t, TypeResolver(protoregistry.GlobalTypes), builder.Resolver())
+	})
+
+	t.Run("custom resolver", func(t *testing.T) {
+		types := new(protoregistry.Types)
+		builder := NewNameBuilder(WithTypeResolver(types))
+		assert.Equal(t, TypeResolver(types), builder.Resolver())
+	})
+}
+
+func TestFlaggerFunc(t *testing.T) {
+	var got []string
+	var flagger Flagger = FlaggerFunc(func(fs *pflag.FlagSet, opts ...Option) {
+		got = append(got, NewNameBuilder(opts...).Build("port"))
+	})
+	flagger.AddFlags(pflag.NewFlagSet("test", pflag.ContinueOnError), WithPrefix("server"))
+	assert.Equal(t, []string{"server.port"}, got)
+}
+
 func TestWithPrefix(t *testing.T) {
 	t.Run("single prefix", func(t *testing.T) {
 		opts := &Options{}
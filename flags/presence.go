@@ -0,0 +1,74 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// presenceTrigger wraps the value of a flag of an optional message so that setting it also sets
+// the presence flag of the message to true.
+type presenceTrigger struct {
+	pflag.Value
+	fs       *pflag.FlagSet
+	presence string
+}
+
+func (p *presenceTrigger) Set(s string) error {
+	if err := p.Value.Set(s); err != nil {
+		return err
+	}
+	return p.fs.Set(p.presence, "true")
+}
+
+// Unwrap returns the wrapped value.
+func (p *presenceTrigger) Unwrap() pflag.Value {
+	return p.Value
+}
+
+// AddPresenceFlags completes the presence flag registered in fs under presence, a bool flag
+// controlling whether an optional message is set: it may be given without a value, and msg, the
+// message it sets, registers its flags with opts when it implements Flagger. Setting any of these
+// flags also sets the presence flag to true.
+func AddPresenceFlags(fs *pflag.FlagSet, presence string, msg interface{}, opts ...Option) error {
+	f := fs.Lookup(presence)
+	if f == nil {
+		return fmt.Errorf("flag %q does not exist", presence)
+	}
+	f.NoOptDefVal = "true"
+
+	flagger, ok := msg.(Flagger)
+	if !ok {
+		return nil
+	}
+	existing := make(map[string]struct{})
+	fs.VisitAll(func(f *pflag.Flag) {
+		existing[f.Name] = struct{}{}
+	})
+	flagger.AddFlags(fs, opts...)
+	fs.VisitAll(func(f *pflag.Flag) {
+		if _, ok := existing[f.Name]; ok {
+			return
+		}
+		f.Value = &presenceTrigger{Value: f.Value, fs: fs, presence: presence}
+		// pflag recognizes the empty default of its slices by their type, lost by the wrapper.
+		if f.DefValue == "[]" {
+			f.DefValue = ""
+		}
+	})
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+type presenceConfig struct {
+	host string
+	port int
+	tags []string
+}
+
+func (c *presenceConfig) AddFlags(fs *pflag.FlagSet, opts ...Option) {
+	builder := NewNameBuilder(opts...)
+	fs.StringVar(&c.host, builder.Build("host"), c.host, "Host")
+	fs.IntVar(&c.port, builder.Build("port"), c.port, "Port")
+	fs.StringSliceVar(&c.tags, builder.Build("tags"), c.tags, "Tags")
+}
+
+func TestAddPresenceFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantPresent bool
+		wantHost    string
+	}{
+		{name: "absent", args: nil},
+		{name: "presence flag without value", args: []string{"--tls"}, wantPresent: true, wantHost: "localhost"},
+		{name: "sub-flag enables", args: []string{"--tls.host=example.com"}, wantPresent: true, wantHost: "example.com"},
+		{name: "explicit false after sub-flag", args: []string{"--tls.host=example.com", "--tls=false"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			msg := &presenceConfig{host: "localhost"}
+			var present bool
+			fs.BoolVar(&present, "tls", false, "Enable TLS")
+			if err := AddPresenceFlags(fs, "tls", msg, WithPrefix("tls")); err != nil {
+				t.Fatalf("AddPresenceFlags() error = %v", err)
+			}
+			if got := fs.Lookup("tls.tags").DefValue; got != "" {
+				t.Errorf("empty slice default = %q, want it hidden", got)
+			}
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if present != tt.wantPresent {
+				t.Errorf("present = %v, want %v", present, tt.wantPresent)
+			}
+			if present && msg.host != tt.wantHost {
+				t.Errorf("host = %q, want %q", msg.host, tt.wantHost)
+			}
+		})
+	}
+}
+
+func TestAddPresenceFlags_Errors(t *testing.T) {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	if err := AddPresenceFlags(fs, "missing", nil); err == nil {
+		t.Error("AddPresenceFlags() expected error for an unknown flag")
+	}
+
+	var present bool
+	fs.BoolVar(&present, "empty", false, "Marker")
+	if err := AddPresenceFlags(fs, "empty", struct{}{}); err != nil {
+		t.Fatalf("AddPresenceFlags() error = %v", err)
+	}
+	if err := fs.Parse([]string{"--port=1", "--empty"}); err == nil {
+		t.Error("Parse() expected error: a message without flags registers none")
+	}
+}
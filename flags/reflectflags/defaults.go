@@ -0,0 +1,412 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reflectflags
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/utils"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// flagDefault returns the default option of flag, read through reflection since each flag type
+// declares its own. ok is false when no default is set.
+func flagDefault(flag proto.Message) (v protoreflect.Value, ok bool) {
+	m := flag.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("default")
+	if fd == nil || !m.Has(fd) {
+		return protoreflect.Value{}, false
+	}
+	return m.Get(fd), true
+}
+
+// flagDefaults returns the default values of a repeated flag.
+func flagDefaults(flag proto.Message) []interface{} {
+	v, ok := flagDefault(flag)
+	if !ok || !v.List().IsValid() {
+		return nil
+	}
+	values := make([]interface{}, v.List().Len())
+	for i := range values {
+		values[i] = v.List().Get(i).Interface()
+	}
+	return values
+}
+
+// scalarType returns the Go type of the scalar value of a native, optional or wrapper field.
+func (f goField) scalarType() reflect.Type {
+	t := f.value.Type()
+	if t.Kind() != reflect.Pointer {
+		return t
+	}
+	if f.isWrapper() {
+		field, _ := t.Elem().FieldByName("Value")
+		return field.Type
+	}
+	return t.Elem()
+}
+
+// setScalar sets a native field to v when it is zero, and an optional or wrapper field when it is
+// nil.
+func (f goField) setScalar(v interface{}) {
+	value := reflect.ValueOf(v).Convert(f.scalarType())
+	switch {
+	case f.value.Kind() != reflect.Pointer:
+		if f.value.IsZero() {
+			f.value.Set(value)
+		}
+	case f.value.IsNil():
+		p := reflect.New(f.value.Type().Elem())
+		if f.isWrapper() {
+			p.Elem().FieldByName("Value").Set(value)
+		} else {
+			p.Elem().Set(value)
+		}
+		f.value.Set(p)
+	}
+}
+
+// setMessage sets a nil message field to the message returned by newValue.
+func (f goField) setMessage(newValue func(msg proto.Message) error) error {
+	if !f.value.IsNil() {
+		return nil
+	}
+	msg := reflect.New(f.value.Type().Elem())
+	if err := newValue(msg.Interface().(proto.Message)); err != nil {
+		return err
+	}
+	f.value.Set(msg)
+	return nil
+}
+
+// setSlice sets an empty repeated field to the values converted by elem.
+func (f goField) setSlice(values []interface{}, elem func(v interface{}, t reflect.Type) (reflect.Value, error)) error {
+	if len(values) == 0 || f.value.Len() > 0 {
+		return nil
+	}
+	slice := reflect.MakeSlice(f.value.Type(), 0, len(values))
+	for _, v := range values {
+		value, err := elem(v, f.value.Type().Elem())
+		if err != nil {
+			return err
+		}
+		slice = reflect.Append(slice, value)
+	}
+	f.value.Set(slice)
+	return nil
+}
+
+// scalarElem converts a default to the element type t, a scalar or a wrapper message.
+func scalarElem(v interface{}, t reflect.Type) (reflect.Value, error) {
+	if t.Kind() != reflect.Pointer {
+		return reflect.ValueOf(v).Convert(t), nil
+	}
+	p := reflect.New(t.Elem())
+	value := p.Elem().FieldByName("Value")
+	value.Set(reflect.ValueOf(v).Convert(value.Type()))
+	return p, nil
+}
+
+// setDefaults sets the field to the default of flag, like the generated SetDefaults method.
+func (f goField) setDefaults(flag *annotations.FieldFlags) error {
+	if scalar, _, ok := scalarFlag(flag); ok {
+		return f.scalarDefaults(scalar)
+	}
+	switch r := flag.GetType().(type) {
+	case *annotations.FieldFlags_Bytes:
+		return f.bytesDefaults(r.Bytes)
+	case *annotations.FieldFlags_Enum:
+		if r.Enum.Default != nil {
+			f.setScalar(r.Enum.GetDefault())
+		}
+	case *annotations.FieldFlags_Duration:
+		return f.durationDefaults(r.Duration)
+	case *annotations.FieldFlags_Timestamp:
+		if r.Timestamp.GetDisabled() || r.Timestamp.GetDefault() == "" {
+			return nil
+		}
+		return f.setMessage(func(msg proto.Message) error {
+			return parseTimestamp(msg, r.Timestamp.GetDefault(), r.Timestamp.GetFormats(), r.Timestamp.GetTimezone())
+		})
+	case *annotations.FieldFlags_Message:
+		return f.messageDefaults(r.Message)
+	case *annotations.FieldFlags_Struct:
+		return f.jsonDefaults(r.Struct)
+	case *annotations.FieldFlags_Any:
+		return f.jsonDefaults(r.Any)
+	case *annotations.FieldFlags_FieldMask:
+		return f.fieldMaskDefaults(r.FieldMask)
+	case *annotations.FieldFlags_Date:
+		return f.googleTypeDefaults(r.Date)
+	case *annotations.FieldFlags_TimeOfDay:
+		return f.googleTypeDefaults(r.TimeOfDay)
+	case *annotations.FieldFlags_Money:
+		return f.googleTypeDefaults(r.Money)
+	case *annotations.FieldFlags_LatLng:
+		return f.googleTypeDefaults(r.LatLng)
+	case *annotations.FieldFlags_Decimal:
+		return f.googleTypeDefaults(r.Decimal)
+	case *annotations.FieldFlags_Repeated:
+		return f.repeatedDefaults(r.Repeated)
+	}
+	return nil
+}
+
+// scalarDefaults sets the default of a numeric, bool or string field, parsing unit_default and
+// normalizing string defaults.
+func (f goField) scalarDefaults(flag commonFlag) error {
+	if u, ok := flag.(unitFlag); ok && u.GetUnitDefault() != "" {
+		v, err := parseUnitDefault(u, f.scalarType())
+		if err != nil {
+			return fmt.Errorf("invalid unit_default: %w", err)
+		}
+		f.setScalar(v)
+		return nil
+	}
+	def, ok := flagDefault(flag)
+	if !ok {
+		return nil
+	}
+	v := def.Interface()
+	if sf, ok := flag.(stringFlag); ok {
+		s, err := stringDefault(sf, def.String())
+		if err != nil {
+			return fmt.Errorf("invalid default value: %w", err)
+		}
+		v = s
+	}
+	f.setScalar(v)
+	return nil
+}
+
+// parseUnitDefault parses the unit_default of a flag as a value of the numeric type t.
+func parseUnitDefault(flag unitFlag, t reflect.Type) (interface{}, error) {
+	unit := unitOf(flag.GetUnit())
+	switch t.Kind() {
+	case reflect.Int32, reflect.Int64:
+		return utils.ParseUnitInt(flag.GetUnitDefault(), unit, t.Bits())
+	case reflect.Uint32, reflect.Uint64:
+		return utils.ParseUnitUint(flag.GetUnitDefault(), unit, t.Bits())
+	case reflect.Float32, reflect.Float64:
+		return utils.ParseUnitFloat(flag.GetUnitDefault(), unit, t.Bits())
+	}
+	return nil, fmt.Errorf("unsupported numeric type %s", t)
+}
+
+// stringDefault returns the canonical value of a string default, with paths resolved.
+func stringDefault(flag stringFlag, s string) (string, error) {
+	if format, ok := stringFormats[flag.GetFormat()]; ok {
+		var err error
+		if s, err = format.check(s); err != nil {
+			return "", err
+		}
+	}
+	if len(flag.GetChoices()) > 0 {
+		return choicesOf(flag).Normalize(s)
+	}
+	if path := flag.GetPath(); path != nil {
+		return pathOf(path).Default(s), nil
+	}
+	return s, nil
+}
+
+// decodeBytes decodes a bytes default with the encoding of its flag, base64 by default.
+func decodeBytes(data []byte, encoding annotations.BytesEncodingType) ([]byte, error) {
+	enc, ok := bytesEncodings[encoding]
+	switch {
+	case encoding == annotations.BytesEncodingType_BYTES_ENCODING_TYPE_HEX:
+		enc = utils.EncodingHex
+	case !ok:
+		enc = utils.EncodingBase64
+	}
+	return utils.DecodeBytes(string(data), enc)
+}
+
+// bytesDefaults sets the default of a bytes field, when empty, or of its wrapper, when nil.
+func (f goField) bytesDefaults(flag *annotations.BytesFlag) error {
+	if len(flag.GetDefault()) == 0 {
+		return nil
+	}
+	v, err := decodeBytes(flag.GetDefault(), flag.GetEncoding())
+	if err != nil {
+		return fmt.Errorf("invalid default value: %w", err)
+	}
+	if !f.isWrapper() && f.value.Len() > 0 {
+		return nil
+	}
+	if !f.isWrapper() {
+		f.value.SetBytes(v)
+		return nil
+	}
+	f.setScalar(v)
+	return nil
+}
+
+// durationDefaults sets the default of a google.protobuf.Duration field or, converted to its
+// storage unit, of an integer field.
+func (f goField) durationDefaults(flag *annotations.DurationFlag) error {
+	if flag.GetDisabled() || flag.GetDefault() == "" {
+		return nil
+	}
+	d, err := utils.ParseDuration(flag.GetDefault())
+	if err != nil {
+		return fmt.Errorf("duration default value '%s' is invalid: %w", flag.GetDefault(), err)
+	}
+	if unit, ok := durationUnits[flag.GetUnit()]; ok {
+		t := f.scalarType()
+		unsigned := t.Kind() == reflect.Uint32 || t.Kind() == reflect.Uint64
+		n, err := utils.DurationToInt(d, unit, t.Bits(), unsigned)
+		if err != nil {
+			return fmt.Errorf("duration default value '%s' is invalid: %w", flag.GetDefault(), err)
+		}
+		f.setScalar(n)
+		return nil
+	}
+	return f.setMessage(func(msg proto.Message) error {
+		proto.Merge(msg, durationpb.New(d))
+		return nil
+	})
+}
+
+// parseTimestamp parses a timestamp default into msg, evaluating relative times now.
+func parseTimestamp(msg proto.Message, s string, formats []string, timezone string) error {
+	loc, err := utils.LoadLocation(timezone)
+	if err != nil {
+		return err
+	}
+	t, err := utils.ParseTime(s, formats, loc)
+	if err != nil {
+		return fmt.Errorf("timestamp default value '%s' is invalid: %w", s, err)
+	}
+	proto.Merge(msg, timestamppb.New(t))
+	return nil
+}
+
+// messageDefaults sets the defaults of a nested message, allocating it unless it is only set by
+// its presence flag.
+func (f goField) messageDefaults(flag *annotations.MessageFlag) error {
+	presence := hasPresenceFlag(f.fd, flag)
+	if !flag.GetNested() && !flag.GetLiteral() && !presence {
+		return nil
+	}
+	if presence && f.value.IsNil() {
+		return nil
+	}
+	return applyNestedDefaults(f.message())
+}
+
+// jsonFlag is implemented by the flags of the message fields with a JSON default.
+type jsonFlag interface {
+	proto.Message
+	GetDisabled() bool
+}
+
+// jsonDefaults sets the JSON default of a google.protobuf.Struct, Value, ListValue or Any field.
+func (f goField) jsonDefaults(flag jsonFlag) error {
+	def, ok := flagDefault(flag)
+	if flag.GetDisabled() || !ok {
+		return nil
+	}
+	return f.setMessage(func(msg proto.Message) error {
+		return protojson.Unmarshal([]byte(def.String()), msg)
+	})
+}
+
+// fieldMaskDefaults sets the default paths of a google.protobuf.FieldMask field, resolved
+// against its target.
+func (f goField) fieldMaskDefaults(flag *annotations.FieldMaskFlag) error {
+	if flag.GetDisabled() || len(flag.GetDefault()) == 0 {
+		return nil
+	}
+	target, err := findMessage(f.fd, flag.GetTarget())
+	if err != nil {
+		return err
+	}
+	paths := make([]string, 0, len(flag.GetDefault()))
+	for _, path := range flag.GetDefault() {
+		path, err := utils.ResolveFieldPath(target, path)
+		if err != nil {
+			return fmt.Errorf("field_mask default: %w", err)
+		}
+		paths = append(paths, path)
+	}
+	return f.setMessage(func(msg proto.Message) error {
+		proto.Merge(msg, &fieldmaskpb.FieldMask{Paths: paths})
+		return nil
+	})
+}
+
+// googleTypeDefaults sets the default of a google.type field.
+func (f goField) googleTypeDefaults(flag *annotations.GoogleTypeFlag) error {
+	if flag.GetDisabled() || flag.Default == nil {
+		return nil
+	}
+	return f.setMessage(func(msg proto.Message) error {
+		return utils.SetGoogleType(msg.ProtoReflect(), flag.GetDefault())
+	})
+}
+
+// repeatedDefaults sets the defaults of an empty repeated field.
+func (f goField) repeatedDefaults(flag *annotations.RepeatedFlags) error {
+	if slice, _, ok := repeatedScalarFlag(flag); ok {
+		values := flagDefaults(slice)
+		if sf, ok := slice.(stringFlag); ok {
+			for i, v := range values {
+				s, err := stringDefault(sf, v.(string))
+				if err != nil {
+					return fmt.Errorf("invalid default value: %w", err)
+				}
+				values[i] = s
+			}
+		}
+		return f.setSlice(values, scalarElem)
+	}
+	switch r := flag.GetType().(type) {
+	case *annotations.RepeatedFlags_Bytes:
+		values := make([]interface{}, 0, len(r.Bytes.GetDefault()))
+		for _, data := range r.Bytes.GetDefault() {
+			v, err := decodeBytes(data, r.Bytes.GetEncoding())
+			if err != nil {
+				return fmt.Errorf("invalid default value: %w", err)
+			}
+			values = append(values, v)
+		}
+		return f.setSlice(values, scalarElem)
+	case *annotations.RepeatedFlags_Enum:
+		return f.setSlice(flagDefaults(r.Enum), scalarElem)
+	case *annotations.RepeatedFlags_Duration:
+		return f.setSlice(flagDefaults(r.Duration), func(v interface{}, _ reflect.Type) (reflect.Value, error) {
+			d, err := utils.ParseDuration(v.(string))
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("duration default value '%s' is invalid: %w", v, err)
+			}
+			return reflect.ValueOf(durationpb.New(d)), nil
+		})
+	case *annotations.RepeatedFlags_Timestamp:
+		return f.setSlice(flagDefaults(r.Timestamp), func(v interface{}, _ reflect.Type) (reflect.Value, error) {
+			ts := new(timestamppb.Timestamp)
+			err := parseTimestamp(ts, v.(string), r.Timestamp.GetFormats(), r.Timestamp.GetTimezone())
+			return reflect.ValueOf(ts), err
+		})
+	}
+	return nil
+}
@@ -0,0 +1,854 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reflectflags
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/types"
+	"github.com/kunstack/protoc-gen-flags/utils"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// commonFlag is implemented by the flag options of the fields bound to a single flag.
+type commonFlag interface {
+	proto.Message
+	GetDisabled() bool
+	GetName() string
+	GetUsage() string
+	GetDeprecated() bool
+	GetDeprecatedUsage() string
+	GetHidden() bool
+	GetShort() string
+	GetSensitive() bool
+}
+
+// repeatedFlag is implemented by the flag options of repeated fields.
+type repeatedFlag interface {
+	commonFlag
+	GetSplit() annotations.SplitType
+	GetSeparator() string
+	GetMerge() annotations.MergeType
+	GetUnique() bool
+	GetSorted() bool
+}
+
+// stringFlag is implemented by the string flags supporting validated values.
+type stringFlag interface {
+	GetFormat() annotations.StringFormatType
+	GetPath() *annotations.PathOptions
+	GetChoices() []string
+	GetCaseInsensitive() bool
+}
+
+// unitFlag is implemented by the scalar numeric flags supporting unit suffixes.
+type unitFlag interface {
+	GetUnit() annotations.UnitType
+	GetUnitDefault() string
+}
+
+// fileRefFlag is implemented by the string and bytes flags supporting file references.
+type fileRefFlag interface {
+	GetAllowFileRef() bool
+}
+
+// rangeFlag is implemented by the repeated integer flags supporting range expansion.
+type rangeFlag interface {
+	GetRanges() bool
+	GetMaxRangeSize() uint32
+}
+
+// stringFormat is a string format: the value type shown in help output and its check.
+type stringFormat struct {
+	typ   string
+	check types.StringCheck
+}
+
+var stringFormats = map[annotations.StringFormatType]stringFormat{annotations.StringFormatType_STRING_FORMAT_TYPE_IP: {"ip", utils.NormalizeIP}, annotations.StringFormatType_STRING_FORMAT_TYPE_IPV4: {"ipv4", utils.NormalizeIPv4}, annotations.StringFormatType_STRING_FORMAT_TYPE_IPV6: {"ipv6", utils.NormalizeIPv6}, annotations.StringFormatType_STRING_FORMAT_TYPE_CIDR: {"cidr", utils.NormalizeCIDR}, annotations.StringFormatType_STRING_FORMAT_TYPE_HOST_PORT: {"hostPort", utils.NormalizeHostPort}, annotations.StringFormatType_STRING_FORMAT_TYPE_URL: {"url", utils.NormalizeURL}, annotations.StringFormatType_STRING_FORMAT_TYPE_HOSTNAME: {"hostname", utils.NormalizeHostname}, annotations.StringFormatType_STRING_FORMAT_TYPE_EMAIL: {"email", utils.NormalizeEmail}}
+
+var pathChecks = map[annotations.PathCheckType]utils.PathCheck{annotations.PathCheckType_PATH_CHECK_TYPE_MUST_EXIST: utils.PathMustExist, annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_FILE: utils.PathMustBeFile, annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_DIR: utils.PathMustBeDir, annotations.PathCheckType_PATH_CHECK_TYPE_MUST_NOT_EXIST: utils.PathMustNotExist}
+
+// bytesEncodings holds the encodings bound with types.EncodedBytes and types.EncodedBytesSlice.
+var bytesEncodings = map[annotations.BytesEncodingType]types.BytesEncoding{annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64_URL: types.EncodingBase64URL, annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64_RAW: types.EncodingBase64Raw, annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64_RAW_URL: types.EncodingBase64RawURL, annotations.BytesEncodingType_BYTES_ENCODING_TYPE_UTF8: types.EncodingUTF8, annotations.BytesEncodingType_BYTES_ENCODING_TYPE_FILE: types.EncodingFile}
+
+// durationUnits maps the storage units of integer duration fields to their duration.
+var durationUnits = map[annotations.DurationUnitType]time.Duration{annotations.DurationUnitType_DURATION_UNIT_TYPE_NANOSECONDS: types.Nanoseconds, annotations.DurationUnitType_DURATION_UNIT_TYPE_MICROSECONDS: types.Microseconds, annotations.DurationUnitType_DURATION_UNIT_TYPE_MILLISECONDS: types.Milliseconds, annotations.DurationUnitType_DURATION_UNIT_TYPE_SECONDS: types.Seconds, annotations.DurationUnitType_DURATION_UNIT_TYPE_MINUTES: types.Minutes, annotations.DurationUnitType_DURATION_UNIT_TYPE_HOURS: types.Hours}
+
+// unitOf returns the unit of the numeric flags with the unit t.
+func unitOf(t annotations.UnitType) types.Unit {
+	switch t {
+	case annotations.UnitType_UNIT_TYPE_BYTES:
+		return types.UnitBytes
+	case annotations.UnitType_UNIT_TYPE_SI:
+		return types.UnitSI
+	case annotations.UnitType_UNIT_TYPE_PERCENT:
+		return types.UnitPercent
+	}
+	return types.UnitNone
+}
+
+// pathOf returns the utils.Path configured by path.
+func pathOf(path *annotations.PathOptions) utils.Path {
+	return utils.Path{Absolute: path.GetAbsolute(), Base: path.GetBase(), Check: pathChecks[path.GetCheck()]}
+}
+
+// choicesOf returns the utils.Choices configured on a string flag.
+func choicesOf(flag stringFlag) utils.Choices {
+	return utils.Choices{Values: flag.GetChoices(), CaseInsensitive: flag.GetCaseInsensitive()}
+}
+
+// stringChecks returns the value type and the checks configured on a string flag. checks is empty
+// for plain strings.
+func stringChecks(flag stringFlag) (typ string, checks []types.StringCheck) {
+	if format, ok := stringFormats[flag.GetFormat()]; ok {
+		typ = format.typ
+		checks = append(checks, format.check)
+	}
+	if path := flag.GetPath(); path != nil {
+		switch path.GetCheck() {
+		case annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_FILE:
+			typ = "file"
+		case annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_DIR:
+			typ = "dir"
+		default:
+			typ = "path"
+		}
+		checks = append(checks, pathOf(path).Normalize)
+	}
+	if len(flag.GetChoices()) > 0 {
+		checks = append(checks, choicesOf(flag).Normalize)
+	}
+	return typ, checks
+}
+
+// flagUsage returns the usage text of a flag, listing the choices of string flags and
+// advertising the file reference syntax.
+func flagUsage(flag commonFlag) string {
+	usage := flag.GetUsage()
+	if s, ok := flag.(stringFlag); ok && len(s.GetChoices()) > 0 {
+		usage += " (one of: " + strings.Join(s.GetChoices(), ", ") + ")"
+	}
+	if r, ok := flag.(fileRefFlag); ok && r.GetAllowFileRef() {
+		if _, repeated := flag.(repeatedFlag); repeated {
+			usage += " (@file or - reads the values from a file or stdin, one per line)"
+		} else {
+			usage += " (@file or - reads the value from a file or stdin)"
+		}
+	}
+	return usage
+}
+
+// sliceOptions returns the types.SliceOption of a repeated flag.
+func sliceOptions(flag repeatedFlag) []types.SliceOption {
+	var opts []types.SliceOption
+	switch flag.GetSplit() {
+	case annotations.SplitType_SPLIT_TYPE_CSV:
+		opts = append(opts, types.WithSplitter(types.SplitCSV))
+	case annotations.SplitType_SPLIT_TYPE_ARRAY:
+		opts = append(opts, types.WithSplitter(types.SplitNone))
+	case annotations.SplitType_SPLIT_TYPE_SEPARATOR:
+		opts = append(opts, types.WithSplitter(types.SplitSeparator(flag.GetSeparator())))
+	case annotations.SplitType_SPLIT_TYPE_JSON:
+		opts = append(opts, types.WithSplitter(types.SplitJSON))
+	}
+	switch flag.GetMerge() {
+	case annotations.MergeType_MERGE_TYPE_APPEND:
+		opts = append(opts, types.WithMerge(types.MergeAppend))
+	case annotations.MergeType_MERGE_TYPE_PREPEND:
+		opts = append(opts, types.WithMerge(types.MergePrepend))
+	}
+	if flag.GetUnique() {
+		opts = append(opts, types.WithUnique())
+	}
+	if flag.GetSorted() {
+		opts = append(opts, types.WithSorted())
+	}
+	if r, ok := flag.(rangeFlag); ok && r.GetRanges() {
+		opts = append(opts, types.WithRanges(int(r.GetMaxRangeSize())))
+	}
+	if r, ok := flag.(fileRefFlag); ok && r.GetAllowFileRef() {
+		opts = append(opts, types.WithFileRef())
+	}
+	if s, ok := flag.(stringFlag); ok {
+		_, checks := stringChecks(s)
+		for _, check := range checks {
+			opts = append(opts, types.WithCheck(check))
+		}
+	}
+	return opts
+}
+
+// fieldBinder binds the annotated fields of a message to flags, as its generated AddFlags method.
+type fieldBinder struct {
+	goField
+	fs      *pflag.FlagSet
+	opts    []flags.Option
+	builder flags.NameBuilder
+}
+
+// flagName returns the full name of the flag of the field, named by flag or after the field.
+func (b *fieldBinder) flagName(flag commonFlag) string {
+	if flag.GetName() != "" {
+		return b.builder.Build(flag.GetName())
+	}
+	return b.builder.Build(strings.ToLower(b.name))
+}
+
+// mark marks the flag name as hidden, deprecated or sensitive.
+func (b *fieldBinder) mark(flag commonFlag, name string) {
+	if flag.GetHidden() {
+		_ = b.fs.MarkHidden(name)
+	}
+	if flag.GetDeprecated() {
+		_ = b.fs.MarkDeprecated(name, flag.GetDeprecatedUsage())
+	}
+	if flag.GetSensitive() {
+		_ = flags.MarkSensitive(b.fs, name)
+	}
+}
+
+// fileRef accepts file references on the scalar flag name.
+func (b *fieldBinder) fileRef(flag commonFlag, name string) {
+	if r, ok := flag.(fileRefFlag); ok && r.GetAllowFileRef() {
+		types.AllowFileRef(b.fs, name)
+	}
+}
+
+// completion sets the shell completion annotations of a path or choices flag.
+func (b *fieldBinder) completion(flag stringFlag, name string) {
+	if len(flag.GetChoices()) > 0 {
+		_ = b.fs.SetAnnotation(name, flags.AnnotationChoices, flag.GetChoices())
+		return
+	}
+	path := flag.GetPath()
+	if path == nil {
+		return
+	}
+	if path.GetCheck() == annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_DIR {
+		_ = b.fs.SetAnnotation(name, flags.AnnotationSubdirsInDir, []string{})
+		return
+	}
+	exts := make([]string, 0, len(path.GetExtensions()))
+	for _, ext := range path.GetExtensions() {
+		exts = append(exts, strings.TrimPrefix(ext, "."))
+	}
+	_ = b.fs.SetAnnotation(name, flags.AnnotationFilenameExt, exts)
+}
+
+// bind registers the flags of the field annotated with flag.
+func (b *fieldBinder) bind(flag *annotations.FieldFlags) error {
+	if scalar, _, ok := scalarFlag(flag); ok {
+		return b.scalar(scalar)
+	}
+	switch r := flag.GetType().(type) {
+	case *annotations.FieldFlags_Bytes:
+		return b.bytes(r.Bytes)
+	case *annotations.FieldFlags_Enum:
+		return b.enum(r.Enum)
+	case *annotations.FieldFlags_Duration:
+		return b.duration(r.Duration)
+	case *annotations.FieldFlags_Timestamp:
+		return b.timestamp(r.Timestamp)
+	case *annotations.FieldFlags_Message:
+		return b.messageFlag(r.Message)
+	case *annotations.FieldFlags_Map:
+		return b.mapValue(r.Map)
+	case *annotations.FieldFlags_Struct:
+		return b.structValue(r.Struct)
+	case *annotations.FieldFlags_FieldMask:
+		return b.fieldMask(r.FieldMask)
+	case *annotations.FieldFlags_Any:
+		return b.anyValue(r.Any)
+	case *annotations.FieldFlags_Date:
+		return b.googleType(r.Date, types.Date)
+	case *annotations.FieldFlags_TimeOfDay:
+		return b.googleType(r.TimeOfDay, types.TimeOfDay)
+	case *annotations.FieldFlags_Money:
+		return b.googleType(r.Money, types.Money)
+	case *annotations.FieldFlags_LatLng:
+		return b.googleType(r.LatLng, types.LatLng)
+	case *annotations.FieldFlags_Decimal:
+		return b.googleType(r.Decimal, types.Decimal)
+	case *annotations.FieldFlags_Repeated:
+		return b.repeated(r.Repeated)
+	}
+	return nil
+}
+
+// repeated registers the flag of a repeated field.
+func (b *fieldBinder) repeated(flag *annotations.RepeatedFlags) error {
+	if slice, _, ok := repeatedScalarFlag(flag); ok {
+		return b.slice(slice)
+	}
+	switch r := flag.GetType().(type) {
+	case *annotations.RepeatedFlags_Bytes:
+		return b.bytesSlice(r.Bytes)
+	case *annotations.RepeatedFlags_Enum:
+		return b.enumSlice(r.Enum)
+	case *annotations.RepeatedFlags_Duration:
+		return b.durationSlice(r.Duration)
+	case *annotations.RepeatedFlags_Timestamp:
+		return b.timestampSlice(r.Timestamp)
+	}
+	return nil
+}
+
+// scalar registers the flag of a numeric, bool or string field, or of its wrapper message.
+func (b *fieldBinder) scalar(flag commonFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	name := b.flagName(flag)
+	if u, ok := flag.(unitFlag); ok && u.GetUnit() != annotations.UnitType_UNIT_TYPE_UNSPECIFIED {
+		value, err := numberWithUnit(b.scalarTarget().Interface(), unitOf(u.GetUnit()))
+		if err != nil {
+			return err
+		}
+		b.fs.VarP(value, name, flag.GetShort(), flag.GetUsage())
+		b.mark(flag, name)
+		return nil
+	}
+	if sf, ok := flag.(stringFlag); ok {
+		if typ, checks := stringChecks(sf); len(checks) > 0 {
+			target, ok := b.scalarTarget().Interface().(*string)
+			if !ok {
+				return fmt.Errorf("unsupported string field type %s", b.value.Type())
+			}
+			b.fs.VarP(types.CheckedString(target, typ, checks...), name, flag.GetShort(), flagUsage(flag))
+			b.completion(sf, name)
+			b.fileRef(flag, name)
+			b.mark(flag, name)
+			return nil
+		}
+	}
+
+	usage := flagUsage(flag)
+	if b.isWrapper() {
+		alloc(b.value)
+		var value pflag.Value
+		switch v := b.value.Interface().(type) {
+		case *wrapperspb.FloatValue:
+			value = types.Float(v)
+		case *wrapperspb.DoubleValue:
+			value = types.Double(v)
+		case *wrapperspb.Int32Value:
+			value = types.Int32(v)
+		case *wrapperspb.Int64Value:
+			value = types.Int64(v)
+		case *wrapperspb.UInt32Value:
+			value = types.UInt32(v)
+		case *wrapperspb.UInt64Value:
+			value = types.UInt64(v)
+		case *wrapperspb.BoolValue:
+			value = types.Bool(v)
+		case *wrapperspb.StringValue:
+			value = types.String(v)
+		default:
+			return fmt.Errorf("unsupported wrapper type %T", v)
+		}
+		b.fs.VarP(value, name, flag.GetShort(), usage)
+	} else {
+		switch p := b.scalarTarget().Interface().(type) {
+		case *float32:
+			b.fs.Float32VarP(p, name, flag.GetShort(), *p, usage)
+		case *float64:
+			b.fs.Float64VarP(p, name, flag.GetShort(), *p, usage)
+		case *int32:
+			b.fs.Int32VarP(p, name, flag.GetShort(), *p, usage)
+		case *int64:
+			b.fs.Int64VarP(p, name, flag.GetShort(), *p, usage)
+		case *uint32:
+			b.fs.Uint32VarP(p, name, flag.GetShort(), *p, usage)
+		case *uint64:
+			b.fs.Uint64VarP(p, name, flag.GetShort(), *p, usage)
+		case *bool:
+			b.fs.BoolVarP(p, name, flag.GetShort(), *p, usage)
+		case *string:
+			b.fs.StringVarP(p, name, flag.GetShort(), *p, usage)
+		default:
+			return fmt.Errorf("unsupported field type %s", b.value.Type())
+		}
+	}
+	b.fileRef(flag, name)
+	b.mark(flag, name)
+	return nil
+}
+
+// numberWithUnit returns the types.NumberWithUnit value of the numeric target.
+func numberWithUnit(target interface{}, unit types.Unit) (pflag.Value, error) {
+	switch p := target.(type) {
+	case *int32:
+		return types.NumberWithUnit(p, unit), nil
+	case *int64:
+		return types.NumberWithUnit(p, unit), nil
+	case *uint32:
+		return types.NumberWithUnit(p, unit), nil
+	case *uint64:
+		return types.NumberWithUnit(p, unit), nil
+	case *float32:
+		return types.NumberWithUnit(p, unit), nil
+	case *float64:
+		return types.NumberWithUnit(p, unit), nil
+	}
+	return nil, fmt.Errorf("unsupported numeric type %T", target)
+}
+
+// slice registers the flag of a repeated numeric, bool or string field. Native fields use the
+// types slice values rather than the pflag ones, so that an empty argument clears them.
+func (b *fieldBinder) slice(flag repeatedFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	var (
+		name  = b.flagName(flag)
+		opts  = sliceOptions(flag)
+		usage = flagUsage(flag)
+		value pflag.Value
+	)
+	switch p := b.value.Addr().Interface().(type) {
+	case *[]*wrapperspb.FloatValue:
+		value = types.FloatSlice(p, opts...)
+	case *[]*wrapperspb.DoubleValue:
+		value = types.DoubleSlice(p, opts...)
+	case *[]*wrapperspb.Int32Value:
+		value = types.Int32Slice(p, opts...)
+	case *[]*wrapperspb.Int64Value:
+		value = types.Int64Slice(p, opts...)
+	case *[]*wrapperspb.UInt32Value:
+		value = types.UInt32Slice(p, opts...)
+	case *[]*wrapperspb.UInt64Value:
+		value = types.UInt64Slice(p, opts...)
+	case *[]*wrapperspb.BoolValue:
+		value = types.BoolSlice(p, opts...)
+	case *[]*wrapperspb.StringValue:
+		value = types.StringSlice(p, opts...)
+	case *[]uint32:
+		value = types.NativeUInt32Slice(p, opts...)
+	case *[]uint64:
+		value = types.NativeUInt64Slice(p, opts...)
+	case *[]float32:
+		value = types.NativeFloatSlice(p, opts...)
+	case *[]float64:
+		value = types.NativeDoubleSlice(p, opts...)
+	case *[]int32:
+		value = types.NativeInt32Slice(p, opts...)
+	case *[]int64:
+		value = types.NativeInt64Slice(p, opts...)
+	case *[]bool:
+		value = types.NativeBoolSlice(p, opts...)
+	case *[]string:
+		value = types.NativeStringSlice(p, opts...)
+	default:
+		return fmt.Errorf("unsupported repeated field type %s", b.value.Type())
+	}
+	b.fs.VarP(value, name, flag.GetShort(), usage)
+	if sf, ok := flag.(stringFlag); ok {
+		b.completion(sf, name)
+	}
+	b.mark(flag, name)
+	return nil
+}
+
+// bytes registers the flag of a bytes field, or of its google.protobuf.BytesValue wrapper.
+func (b *fieldBinder) bytes(flag *annotations.BytesFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	var (
+		name  = b.flagName(flag)
+		usage = flagUsage(flag)
+		hex   = flag.GetEncoding() == annotations.BytesEncodingType_BYTES_ENCODING_TYPE_HEX
+	)
+	if enc, ok := bytesEncodings[flag.GetEncoding()]; ok {
+		target, ok := b.scalarTarget().Interface().(*[]byte)
+		if !ok {
+			return fmt.Errorf("unsupported bytes field type %s", b.value.Type())
+		}
+		b.fs.VarP(types.EncodedBytes(target, enc), name, flag.GetShort(), usage)
+	} else if b.isWrapper() {
+		alloc(b.value)
+		v, ok := b.value.Interface().(*wrapperspb.BytesValue)
+		if !ok {
+			return fmt.Errorf("unsupported bytes field type %s", b.value.Type())
+		}
+		if hex {
+			b.fs.VarP(types.BytesHex(v), name, flag.GetShort(), usage)
+		} else {
+			b.fs.VarP(types.Bytes(v), name, flag.GetShort(), usage)
+		}
+	} else {
+		p, ok := b.value.Addr().Interface().(*[]byte)
+		if !ok {
+			return fmt.Errorf("unsupported bytes field type %s", b.value.Type())
+		}
+		if hex {
+			b.fs.BytesHexVarP(p, name, flag.GetShort(), *p, usage)
+		} else {
+			b.fs.BytesBase64VarP(p, name, flag.GetShort(), *p, usage)
+		}
+	}
+	b.fileRef(flag, name)
+	b.mark(flag, name)
+	return nil
+}
+
+// bytesSlice registers the flag of a repeated bytes or google.protobuf.BytesValue field.
+func (b *fieldBinder) bytesSlice(flag *annotations.RepeatedBytesFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	var (
+		name  = b.flagName(flag)
+		opts  = sliceOptions(flag)
+		value pflag.Value
+		hex   = flag.GetEncoding() == annotations.BytesEncodingType_BYTES_ENCODING_TYPE_HEX
+	)
+	enc, ok := bytesEncodings[flag.GetEncoding()]
+	switch p := b.value.Addr().Interface().(type) {
+	case *[][]byte:
+		switch {
+		case ok:
+			value = types.EncodedBytesSlice(p, enc, opts...)
+		case hex:
+			value = types.BytesHexSlice(p, opts...)
+		default:
+			value = types.BytesSlice(p, opts...)
+		}
+	case *[]*wrapperspb.BytesValue:
+		switch {
+		case ok:
+			value = types.EncodedBytesSlice(p, enc, opts...)
+		case hex:
+			value = types.BytesHexSlice(p, opts...)
+		default:
+			value = types.BytesSlice(p, opts...)
+		}
+	default:
+		return fmt.Errorf("unsupported repeated bytes field type %s", b.value.Type())
+	}
+	b.fs.VarP(value, name, flag.GetShort(), flagUsage(flag))
+	b.mark(flag, name)
+	return nil
+}
+
+// enum registers the flag of an enum field.
+func (b *fieldBinder) enum(flag *annotations.EnumFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	name := b.flagName(flag)
+	target, ok := b.scalarTarget().Interface().(protoreflect.Enum)
+	if !ok {
+		return fmt.Errorf("unsupported enum field type %s", b.value.Type())
+	}
+	b.fs.VarP(types.Enum(target), name, flag.GetShort(), flag.GetUsage())
+	b.mark(flag, name)
+	return nil
+}
+
+// enumSlice registers the flag of a repeated enum field.
+func (b *fieldBinder) enumSlice(flag *annotations.RepeatedEnumFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	name := b.flagName(flag)
+	b.fs.VarP(types.EnumSlice(b.value.Addr().Interface(), sliceOptions(flag)...), name, flag.GetShort(), flag.GetUsage())
+	b.mark(flag, name)
+	return nil
+}
+
+// duration registers the flag of a google.protobuf.Duration field or, with a storage unit, of an
+// integer field.
+func (b *fieldBinder) duration(flag *annotations.DurationFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	var (
+		name  = b.flagName(flag)
+		value pflag.Value
+	)
+	if unit, ok := durationUnits[flag.GetUnit()]; ok {
+		switch p := b.scalarTarget().Interface().(type) {
+		case *int32:
+			value = types.DurationAs(p, unit)
+		case *int64:
+			value = types.DurationAs(p, unit)
+		case *uint32:
+			value = types.DurationAs(p, unit)
+		case *uint64:
+			value = types.DurationAs(p, unit)
+		default:
+			return fmt.Errorf("unsupported integer duration field type %s", b.value.Type())
+		}
+	} else {
+		alloc(b.value)
+		v, ok := b.value.Interface().(*durationpb.Duration)
+		if !ok {
+			return fmt.Errorf("unsupported duration field type %s", b.value.Type())
+		}
+		value = types.Duration(v)
+	}
+	b.fs.VarP(value, name, flag.GetShort(), flag.GetUsage())
+	b.mark(flag, name)
+	return nil
+}
+
+// durationSlice registers the flag of a repeated google.protobuf.Duration field.
+func (b *fieldBinder) durationSlice(flag *annotations.RepeatedDurationFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	p, ok := b.value.Addr().Interface().(*[]*durationpb.Duration)
+	if !ok {
+		return fmt.Errorf("unsupported repeated duration field type %s", b.value.Type())
+	}
+	name := b.flagName(flag)
+	b.fs.VarP(types.DurationSlice(p, sliceOptions(flag)...), name, flag.GetShort(), flag.GetUsage())
+	b.mark(flag, name)
+	return nil
+}
+
+// timestamp registers the flag of a google.protobuf.Timestamp field.
+func (b *fieldBinder) timestamp(flag *annotations.TimestampFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	alloc(b.value)
+	v, ok := b.value.Interface().(*timestamppb.Timestamp)
+	if !ok {
+		return fmt.Errorf("unsupported timestamp field type %s", b.value.Type())
+	}
+	name := b.flagName(flag)
+	if flag.GetTimezone() != "" {
+		b.fs.VarP(types.TimestampIn(v, flag.GetFormats(), flag.GetTimezone()), name, flag.GetShort(), flag.GetUsage())
+	} else {
+		b.fs.VarP(types.Timestamp(v, flag.GetFormats()), name, flag.GetShort(), flag.GetUsage())
+	}
+	b.mark(flag, name)
+	return nil
+}
+
+// timestampSlice registers the flag of a repeated google.protobuf.Timestamp field.
+func (b *fieldBinder) timestampSlice(flag *annotations.RepeatedTimestampFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	p, ok := b.value.Addr().Interface().(*[]*timestamppb.Timestamp)
+	if !ok {
+		return fmt.Errorf("unsupported repeated timestamp field type %s", b.value.Type())
+	}
+	name := b.flagName(flag)
+	if flag.GetTimezone() != "" {
+		b.fs.VarP(types.TimestampSliceIn(p, flag.GetFormats(), flag.GetTimezone(), sliceOptions(flag)...), name, flag.GetShort(), flag.GetUsage())
+	} else {
+		b.fs.VarP(types.TimestampSlice(p, flag.GetFormats(), sliceOptions(flag)...), name, flag.GetShort(), flag.GetUsage())
+	}
+	b.mark(flag, name)
+	return nil
+}
+
+// mapValue registers the flag of a map field in the format of flag, JSON by default.
+func (b *fieldBinder) mapValue(flag *annotations.MapFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	var (
+		name  = b.flagName(flag)
+		ptr   = b.value.Addr().Interface()
+		value pflag.Value
+	)
+	switch flag.GetFormat() {
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING:
+		p, ok := ptr.(*map[string]string)
+		if !ok {
+			return fmt.Errorf("unsupported map field type %s", b.value.Type())
+		}
+		b.fs.StringToStringVarP(p, name, flag.GetShort(), *p, flag.GetUsage())
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT:
+		switch p := ptr.(type) {
+		case *map[string]int64:
+			b.fs.StringToInt64VarP(p, name, flag.GetShort(), *p, flag.GetUsage())
+		case *map[string]int32:
+			value = types.StringToInt32(p)
+		case *map[string]uint32:
+			value = types.StringToUint32(p)
+		case *map[string]uint64:
+			value = types.StringToUint64(p)
+		default:
+			return fmt.Errorf("unsupported map field type %s", b.value.Type())
+		}
+	default:
+		value = types.JSON(ptr)
+	}
+	if value != nil {
+		b.fs.VarP(value, name, flag.GetShort(), flag.GetUsage())
+	}
+	b.mark(flag, name)
+	return nil
+}
+
+// structValue registers the flag of a google.protobuf.Struct, Value or ListValue field.
+func (b *fieldBinder) structValue(flag *annotations.StructFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	var (
+		name   = b.flagName(flag)
+		value  pflag.Value
+		dotted = true
+	)
+	switch v := b.message().(type) {
+	case *structpb.Struct:
+		value = types.Struct(v)
+	case *structpb.Value:
+		value = types.Value(v)
+	case *structpb.ListValue:
+		value, dotted = types.List(v), false
+	default:
+		return fmt.Errorf("unsupported struct field type %T", v)
+	}
+	b.fs.VarP(value, name, flag.GetShort(), flag.GetUsage())
+	if dotted {
+		_ = b.fs.SetAnnotation(name, flags.AnnotationDotted, []string{"true"})
+	}
+	b.mark(flag, name)
+	return nil
+}
+
+// fieldMask registers the flag of a google.protobuf.FieldMask field.
+func (b *fieldBinder) fieldMask(flag *annotations.FieldMaskFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	target, err := findMessage(b.fd, flag.GetTarget())
+	if err != nil {
+		return err
+	}
+	v, ok := b.message().(*fieldmaskpb.FieldMask)
+	if !ok {
+		return fmt.Errorf("unsupported field mask field type %s", b.value.Type())
+	}
+	name := b.flagName(flag)
+	b.fs.VarP(types.FieldMask(v, target), name, flag.GetShort(), flag.GetUsage())
+	b.mark(flag, name)
+	return nil
+}
+
+// findMessage returns the message named name, a full name or a name relative to the package of fd.
+func findMessage(fd protoreflect.FieldDescriptor, name string) (protoreflect.MessageDescriptor, error) {
+	md, err := utils.FindMessage(name)
+	if err != nil && !strings.HasPrefix(name, ".") {
+		if relative, rerr := utils.FindMessage(string(fd.ParentFile().Package()) + "." + name); rerr == nil {
+			return relative, nil
+		}
+	}
+	return md, err
+}
+
+// anyValue registers the flag of a google.protobuf.Any field.
+func (b *fieldBinder) anyValue(flag *annotations.AnyFlag) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	v, ok := b.message().(*anypb.Any)
+	if !ok {
+		return fmt.Errorf("unsupported any field type %s", b.value.Type())
+	}
+	name := b.flagName(flag)
+	b.fs.VarP(types.Any(v, b.builder.Resolver(), flag.GetAllowedTypes()...), name, flag.GetShort(), flag.GetUsage())
+	b.mark(flag, name)
+	return nil
+}
+
+// googleType registers the flag of a google.type field with the types constructor of its message.
+func (b *fieldBinder) googleType(flag *annotations.GoogleTypeFlag, value func(proto.Message) *types.GoogleTypeValue) error {
+	if flag.GetDisabled() {
+		return nil
+	}
+	name := b.flagName(flag)
+	b.fs.VarP(value(b.message()), name, flag.GetShort(), flag.GetUsage())
+	b.mark(flag, name)
+	return nil
+}
+
+// messageFlag registers the flags of a message field: its nested flags, its literal flag or its
+// presence flag.
+func (b *fieldBinder) messageFlag(flag *annotations.MessageFlag) error {
+	if hasPresenceFlag(b.fd, flag) {
+		return b.presence(flag)
+	}
+	if !flag.GetNested() && !flag.GetLiteral() {
+		return nil
+	}
+	prefix := b.messageFlagPrefix(flag)
+	msg := b.message()
+	if !flag.GetLiteral() {
+		return addNestedFlags(b.fs, msg, nestedOptions(b.opts, flag, prefix))
+	}
+	// The nested flags are tracked by the literal, which keeps the fields they set.
+	literal := types.Message(msg)
+	var err error
+	if flag.GetNested() {
+		literal.TrackFlags(b.fs, func() {
+			err = addNestedFlags(b.fs, msg, nestedOptions(b.opts, flag, prefix))
+		})
+	}
+	if err != nil {
+		return err
+	}
+	b.fs.VarP(literal, b.builder.Build(prefix), flag.GetShort(), flag.GetUsage())
+	return nil
+}
+
+// presence registers the presence flag of an optional message field. The nested flags are bound
+// to a message initialized with its defaults, which the field is set to when the presence flag or
+// one of the nested flags is set.
+func (b *fieldBinder) presence(flag *annotations.MessageFlag) error {
+	var (
+		prefix = b.messageFlagPrefix(flag)
+		name   = b.builder.Build(prefix)
+		msg    = b.value
+	)
+	if msg.IsNil() {
+		msg = reflect.New(b.value.Type().Elem())
+		if err := applyNestedDefaults(msg.Interface().(proto.Message)); err != nil {
+			return err
+		}
+	}
+	b.fs.VarP(&presenceValue{field: b.value, msg: msg}, name, flag.GetShort(), flag.GetUsage())
+	if !flag.GetNested() {
+		_ = flags.AddPresenceFlags(b.fs, name, nil)
+		return nil
+	}
+	var err error
+	flagger := flags.FlaggerFunc(func(fs *pflag.FlagSet, opts ...flags.Option) {
+		err = addNestedFlags(fs, msg.Interface().(proto.Message), opts)
+	})
+	_ = flags.AddPresenceFlags(b.fs, name, flagger, nestedOptions(b.opts, flag, prefix)...)
+	return err
+}
@@ -0,0 +1,533 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package reflectflags registers the flags of generated messages and applies their defaults by
+// reading the annotations from their descriptors at run time, without the generated methods. It
+// is kept out of the flags package, which the generated .pb.go files import, so that these do not
+// depend on the types and utils packages.
+package reflectflags
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/utils"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AddMessageFlags registers the flags of msg in fs like its generated AddFlags method, reading the
+// annotations from the descriptor of msg at run time instead. It binds the fields of any message
+// generated by protoc-gen-go, annotated but compiled without this plugin, with the same names,
+// defaults, marks and nested flags as the generated code. Nested messages are bound the same way,
+// or through their AddFlags method when they have no annotations.
+//
+// The plugin validates the annotations at generation time; AddMessageFlags returns an error when an
+// annotation does not apply to the type of its field. Annotations given by an overlay file are not
+// part of the descriptors and are not visible at run time.
+func AddMessageFlags(fs *pflag.FlagSet, msg proto.Message, opts ...flags.Option) error {
+	b := &fieldBinder{fs: fs, opts: opts, builder: flags.NewNameBuilder(opts...)}
+	return rangeFields(msg, func(f goField, flag *annotations.FieldFlags) error {
+		b.goField = f
+		return b.bind(flag)
+	})
+}
+
+// ApplyDefaults sets the fields of msg to their annotated defaults like its generated SetDefaults
+// method, reading the annotations from the descriptor of msg at run time. Fields already set keep
+// their value. See AddMessageFlags.
+func ApplyDefaults(msg proto.Message) error {
+	return rangeFields(msg, func(f goField, flag *annotations.FieldFlags) error {
+		return f.setDefaults(flag)
+	})
+}
+
+// goField is the Go struct field of an annotated field of a generated message.
+type goField struct {
+	fd    protoreflect.FieldDescriptor
+	value reflect.Value // the addressable struct field
+	name  string        // the Go name of the field, lowercased into the default flag name
+}
+
+// structFields caches the indexes of the Go struct fields of the generated messages by proto name.
+var structFields sync.Map // map[reflect.Type]map[protoreflect.Name]int
+
+// rangeFields calls fn with the annotated fields of msg in declaration order, unless msg is
+// disabled by (flags.disabled).
+func rangeFields(msg proto.Message, fn func(f goField, flag *annotations.FieldFlags) error) error {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%T is not a message generated by protoc-gen-go", msg)
+	}
+	md := msg.ProtoReflect().Descriptor()
+	if messageOption(md, annotations.E_Disabled) {
+		return nil
+	}
+	indexes := fieldIndexes(v.Elem().Type())
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		flag := fieldFlags(fd)
+		if flag == nil {
+			continue
+		}
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			return fmt.Errorf("field %s: oneof fields are not supported", fd.FullName())
+		}
+		index, ok := indexes[fd.Name()]
+		if !ok {
+			return fmt.Errorf("field %s: no Go struct field in %T", fd.FullName(), msg)
+		}
+		f := goField{fd: fd, value: v.Elem().Field(index), name: v.Elem().Type().Field(index).Name}
+		if err := checkField(fd, flag); err != nil {
+			return fmt.Errorf("field %s: %w", fd.FullName(), err)
+		}
+		if err := fn(f, flag); err != nil {
+			return fmt.Errorf("field %s: %w", fd.FullName(), err)
+		}
+	}
+	return nil
+}
+
+// fieldIndexes returns the indexes of the fields of the generated struct t by proto name, read
+// from their protobuf struct tags.
+func fieldIndexes(t reflect.Type) map[protoreflect.Name]int {
+	if indexes, ok := structFields.Load(t); ok {
+		return indexes.(map[protoreflect.Name]int)
+	}
+	indexes := make(map[protoreflect.Name]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		for _, part := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if name := strings.TrimPrefix(part, "name="); name != part {
+				indexes[protoreflect.Name(name)] = i
+			}
+		}
+	}
+	structFields.Store(t, indexes)
+	return indexes
+}
+
+// fieldFlags returns the (flags.value) annotation of fd, or nil.
+func fieldFlags(fd protoreflect.FieldDescriptor) *annotations.FieldFlags {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, annotations.E_Value) {
+		return nil
+	}
+	flag, _ := proto.GetExtension(opts, annotations.E_Value).(*annotations.FieldFlags)
+	return flag
+}
+
+// messageOption returns the value of the bool message option ext of md.
+func messageOption(md protoreflect.MessageDescriptor, ext protoreflect.ExtensionType) bool {
+	opts := md.Options()
+	if opts == nil || !proto.HasExtension(opts, ext) {
+		return false
+	}
+	v, _ := proto.GetExtension(opts, ext).(bool)
+	return v
+}
+
+// hasFlags reports whether the plugin generates the flag methods of md: it is not disabled and has
+// annotated fields outside oneofs, or is marked with (flags.allow_empty).
+func hasFlags(md protoreflect.MessageDescriptor) bool {
+	if messageOption(md, annotations.E_Disabled) {
+		return false
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneof := fd.ContainingOneof(); fieldFlags(fd) != nil && (oneof == nil || oneof.IsSynthetic()) {
+			return true
+		}
+	}
+	return messageOption(md, annotations.E_AllowEmpty)
+}
+
+// alloc sets the nil pointer field v to a new value.
+func alloc(v reflect.Value) {
+	if v.IsNil() {
+		v.Set(reflect.New(v.Type().Elem()))
+	}
+}
+
+// isWrapper reports whether the field holds a message, e.g. a wrapper of a scalar value.
+func (f goField) isWrapper() bool {
+	return f.fd.Message() != nil
+}
+
+// scalarTarget returns a pointer to the scalar value of a native, optional or wrapper field,
+// allocating optional and wrapper fields.
+func (f goField) scalarTarget() reflect.Value {
+	if f.value.Kind() != reflect.Pointer {
+		return f.value.Addr()
+	}
+	alloc(f.value)
+	if f.isWrapper() {
+		return f.value.Elem().FieldByName("Value").Addr()
+	}
+	return f.value
+}
+
+// message returns the message held by a message field, allocating it.
+func (f goField) message() proto.Message {
+	alloc(f.value)
+	return f.value.Interface().(proto.Message)
+}
+
+// addNestedFlags registers the flags of a nested message: through reflection when it is
+// annotated, or through its AddFlags method otherwise, like the generated code.
+func addNestedFlags(fs *pflag.FlagSet, msg proto.Message, opts []flags.Option) error {
+	if hasFlags(msg.ProtoReflect().Descriptor()) {
+		return AddMessageFlags(fs, msg, opts...)
+	}
+	if v, ok := msg.(flags.Flagger); ok {
+		v.AddFlags(fs, opts...)
+	}
+	return nil
+}
+
+// applyNestedDefaults sets the defaults of a nested message, see addNestedFlags.
+func applyNestedDefaults(msg proto.Message) error {
+	if hasFlags(msg.ProtoReflect().Descriptor()) {
+		return ApplyDefaults(msg)
+	}
+	if v, ok := msg.(flags.Defaulter); ok {
+		v.SetDefaults()
+	}
+	return nil
+}
+
+// nestedOptions returns the options the nested flags of a message field are registered with: the
+// options of the parent, with the prefix unless the message is inlined.
+func nestedOptions(opts []flags.Option, flag *annotations.MessageFlag, prefix string) []flags.Option {
+	if flag.GetInline() {
+		return opts
+	}
+	return append(opts[:len(opts):len(opts)], flags.WithPrefix(prefix))
+}
+
+// isEmptyMessage reports whether fd holds a google.protobuf.Empty.
+func isEmptyMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Message() != nil && fd.Message().FullName() == "google.protobuf.Empty"
+}
+
+// hasPresenceFlag reports whether a message field registers a presence flag: when presence_flag
+// is set, and for google.protobuf.Empty fields.
+func hasPresenceFlag(fd protoreflect.FieldDescriptor, flag *annotations.MessageFlag) bool {
+	return flag.GetPresenceFlag() || isEmptyMessage(fd)
+}
+
+// messageFlagPrefix returns the prefix of the nested flags of a message field, which is also the
+// name of its literal and presence flags.
+func (f goField) messageFlagPrefix(flag *annotations.MessageFlag) string {
+	if flag.GetName() != "" {
+		return flag.GetName()
+	}
+	return strings.ToLower(string(f.fd.Name()))
+}
+
+var _ pflag.Value = (*presenceValue)(nil)
+
+// presenceValue is the presence flag of an optional message field, like types.PresenceValue for
+// a field only known through reflection: setting it to true sets the field to msg, setting it to
+// false clears the field.
+type presenceValue struct {
+	field reflect.Value
+	msg   reflect.Value
+}
+
+func (p *presenceValue) String() string {
+	return strconv.FormatBool(!p.field.IsNil())
+}
+
+func (p *presenceValue) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	if v {
+		p.field.Set(p.msg)
+	} else {
+		p.field.Set(reflect.Zero(p.field.Type()))
+	}
+	return nil
+}
+
+func (p *presenceValue) Type() string {
+	return "bool"
+}
+
+// IsBoolFlag allows the presence flag to be given without a value.
+func (p *presenceValue) IsBoolFlag() bool {
+	return true
+}
+
+// errNotMessage is returned for message flags on fields not holding a single message.
+var errNotMessage = errors.New("message flag should be used for singular message fields")
+
+// scalarKind describes the fields a scalar flag applies to: fields of kind, or fields holding the
+// wrapper message google.protobuf.<wrapper> of a value of kind.
+type scalarKind struct {
+	kind    protoreflect.Kind
+	wrapper protoreflect.Name
+}
+
+// check verifies that fd is of the kind k, and is a list when the flag is repeated.
+func (k scalarKind) check(fd protoreflect.FieldDescriptor, repeated bool) error {
+	if err := checkRepeated(fd, repeated); err != nil {
+		return err
+	}
+	if md := fd.Message(); md != nil && k.wrapper != "" && md.FullName() == "google.protobuf."+protoreflect.FullName(k.wrapper) {
+		fd = md.Fields().ByName("value")
+	}
+	if fd.Kind() != k.kind {
+		return fmt.Errorf("expected flags for %s but got %s", fd.Kind(), k.kind)
+	}
+	return nil
+}
+
+// scalarFlag returns the scalar flag held by flag and the fields it applies to.
+func scalarFlag(flag *annotations.FieldFlags) (commonFlag, scalarKind, bool) {
+	switch r := flag.GetType().(type) {
+	case *annotations.FieldFlags_Float:
+		return r.Float, scalarKind{protoreflect.FloatKind, "FloatValue"}, true
+	case *annotations.FieldFlags_Double:
+		return r.Double, scalarKind{protoreflect.DoubleKind, "DoubleValue"}, true
+	case *annotations.FieldFlags_Int32:
+		return r.Int32, scalarKind{protoreflect.Int32Kind, "Int32Value"}, true
+	case *annotations.FieldFlags_Int64:
+		return r.Int64, scalarKind{protoreflect.Int64Kind, "Int64Value"}, true
+	case *annotations.FieldFlags_Uint32:
+		return r.Uint32, scalarKind{protoreflect.Uint32Kind, "UInt32Value"}, true
+	case *annotations.FieldFlags_Uint64:
+		return r.Uint64, scalarKind{protoreflect.Uint64Kind, "UInt64Value"}, true
+	case *annotations.FieldFlags_Sint32:
+		return r.Sint32, scalarKind{protoreflect.Sint32Kind, "Int32Value"}, true
+	case *annotations.FieldFlags_Sint64:
+		return r.Sint64, scalarKind{protoreflect.Sint64Kind, "Int64Value"}, true
+	case *annotations.FieldFlags_Fixed32:
+		return r.Fixed32, scalarKind{protoreflect.Fixed32Kind, "UInt32Value"}, true
+	case *annotations.FieldFlags_Fixed64:
+		return r.Fixed64, scalarKind{protoreflect.Fixed64Kind, "UInt64Value"}, true
+	case *annotations.FieldFlags_Sfixed32:
+		return r.Sfixed32, scalarKind{protoreflect.Sfixed32Kind, "Int32Value"}, true
+	case *annotations.FieldFlags_Sfixed64:
+		return r.Sfixed64, scalarKind{protoreflect.Sfixed64Kind, "Int64Value"}, true
+	case *annotations.FieldFlags_Bool:
+		return r.Bool, scalarKind{protoreflect.BoolKind, "BoolValue"}, true
+	case *annotations.FieldFlags_String_:
+		return r.String_, scalarKind{protoreflect.StringKind, "StringValue"}, true
+	}
+	return nil, scalarKind{}, false
+}
+
+// repeatedScalarFlag returns the repeated scalar flag held by flag and the fields it applies to.
+func repeatedScalarFlag(flag *annotations.RepeatedFlags) (repeatedFlag, scalarKind, bool) {
+	switch r := flag.GetType().(type) {
+	case *annotations.RepeatedFlags_Float:
+		return r.Float, scalarKind{protoreflect.FloatKind, "FloatValue"}, true
+	case *annotations.RepeatedFlags_Double:
+		return r.Double, scalarKind{protoreflect.DoubleKind, "DoubleValue"}, true
+	case *annotations.RepeatedFlags_Int32:
+		return r.Int32, scalarKind{protoreflect.Int32Kind, "Int32Value"}, true
+	case *annotations.RepeatedFlags_Int64:
+		return r.Int64, scalarKind{protoreflect.Int64Kind, "Int64Value"}, true
+	case *annotations.RepeatedFlags_Uint32:
+		return r.Uint32, scalarKind{protoreflect.Uint32Kind, "UInt32Value"}, true
+	case *annotations.RepeatedFlags_Uint64:
+		return r.Uint64, scalarKind{protoreflect.Uint64Kind, "UInt64Value"}, true
+	case *annotations.RepeatedFlags_Sint32:
+		return r.Sint32, scalarKind{protoreflect.Sint32Kind, "Int32Value"}, true
+	case *annotations.RepeatedFlags_Sint64:
+		return r.Sint64, scalarKind{protoreflect.Sint64Kind, "Int64Value"}, true
+	case *annotations.RepeatedFlags_Fixed32:
+		return r.Fixed32, scalarKind{protoreflect.Fixed32Kind, "UInt32Value"}, true
+	case *annotations.RepeatedFlags_Fixed64:
+		return r.Fixed64, scalarKind{protoreflect.Fixed64Kind, "UInt64Value"}, true
+	case *annotations.RepeatedFlags_Sfixed32:
+		return r.Sfixed32, scalarKind{protoreflect.Sfixed32Kind, "Int32Value"}, true
+	case *annotations.RepeatedFlags_Sfixed64:
+		return r.Sfixed64, scalarKind{protoreflect.Sfixed64Kind, "Int64Value"}, true
+	case *annotations.RepeatedFlags_Bool:
+		return r.Bool, scalarKind{protoreflect.BoolKind, "BoolValue"}, true
+	case *annotations.RepeatedFlags_String_:
+		return r.String_, scalarKind{protoreflect.StringKind, "StringValue"}, true
+	}
+	return nil, scalarKind{}, false
+}
+
+// checkField verifies that the annotation flag applies to the type of fd, as the plugin does at
+// generation time.
+func checkField(fd protoreflect.FieldDescriptor, flag *annotations.FieldFlags) error {
+	if _, kind, ok := scalarFlag(flag); ok {
+		return kind.check(fd, false)
+	}
+	switch r := flag.GetType().(type) {
+	case *annotations.FieldFlags_Bytes:
+		return scalarKind{protoreflect.BytesKind, "BytesValue"}.check(fd, false)
+	case *annotations.FieldFlags_Enum:
+		return scalarKind{kind: protoreflect.EnumKind}.check(fd, false)
+	case *annotations.FieldFlags_Duration:
+		if _, ok := durationUnits[r.Duration.GetUnit()]; ok {
+			return checkInteger(fd)
+		}
+		return checkMessage(fd, false, "google.protobuf.Duration")
+	case *annotations.FieldFlags_Timestamp:
+		return checkMessage(fd, false, "google.protobuf.Timestamp")
+	case *annotations.FieldFlags_Message:
+		return checkMessageFlag(fd, r.Message)
+	case *annotations.FieldFlags_Map:
+		return checkMap(fd, r.Map)
+	case *annotations.FieldFlags_Struct:
+		return checkMessage(fd, false, "google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue")
+	case *annotations.FieldFlags_FieldMask:
+		return checkMessage(fd, false, "google.protobuf.FieldMask")
+	case *annotations.FieldFlags_Any:
+		return checkMessage(fd, false, "google.protobuf.Any")
+	case *annotations.FieldFlags_Date:
+		return checkMessage(fd, false, utils.DateName)
+	case *annotations.FieldFlags_TimeOfDay:
+		return checkMessage(fd, false, utils.TimeOfDayName)
+	case *annotations.FieldFlags_Money:
+		return checkMessage(fd, false, utils.MoneyName)
+	case *annotations.FieldFlags_LatLng:
+		return checkMessage(fd, false, utils.LatLngName)
+	case *annotations.FieldFlags_Decimal:
+		return checkMessage(fd, false, utils.DecimalName)
+	case *annotations.FieldFlags_Repeated:
+		if _, kind, ok := repeatedScalarFlag(r.Repeated); ok {
+			return kind.check(fd, true)
+		}
+		switch r.Repeated.GetType().(type) {
+		case *annotations.RepeatedFlags_Bytes:
+			return scalarKind{protoreflect.BytesKind, "BytesValue"}.check(fd, true)
+		case *annotations.RepeatedFlags_Enum:
+			return scalarKind{kind: protoreflect.EnumKind}.check(fd, true)
+		case *annotations.RepeatedFlags_Duration:
+			return checkMessage(fd, true, "google.protobuf.Duration")
+		case *annotations.RepeatedFlags_Timestamp:
+			return checkMessage(fd, true, "google.protobuf.Timestamp")
+		}
+	}
+	return nil
+}
+
+// checkRepeated verifies that fd is a list when the flag is repeated, and a singular field
+// otherwise.
+func checkRepeated(fd protoreflect.FieldDescriptor, repeated bool) error {
+	switch {
+	case fd.IsMap():
+		return errors.New("map fields should use map flag")
+	case repeated && !fd.IsList():
+		return errors.New("repeated flag should be used for repeated fields only")
+	case !repeated && fd.IsList():
+		return errors.New("repeated fields should use repeated flag")
+	}
+	return nil
+}
+
+// checkMessage verifies that fd holds one of the messages names.
+func checkMessage(fd protoreflect.FieldDescriptor, repeated bool, names ...protoreflect.FullName) error {
+	if err := checkRepeated(fd, repeated); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if fd.Message() != nil && fd.Message().FullName() == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("expected flags for %s but got %s", fieldType(fd), names[0])
+}
+
+// checkInteger verifies that fd holds an integer, directly or in an integer wrapper message.
+func checkInteger(fd protoreflect.FieldDescriptor) error {
+	if err := checkRepeated(fd, false); err != nil {
+		return err
+	}
+	if md := fd.Message(); md != nil {
+		switch md.FullName() {
+		case "google.protobuf.Int32Value", "google.protobuf.Int64Value",
+			"google.protobuf.UInt32Value", "google.protobuf.UInt64Value":
+			fd = md.Fields().ByName("value")
+		}
+	}
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return nil
+	}
+	return fmt.Errorf("unit is only allowed for duration flags on integer fields, got %s", fieldType(fd))
+}
+
+// checkMessageFlag verifies the options of a message flag against fd.
+func checkMessageFlag(fd protoreflect.FieldDescriptor, flag *annotations.MessageFlag) error {
+	if flag.GetInline() && !flag.GetNested() {
+		return errors.New("inline requires nested")
+	}
+	presence := hasPresenceFlag(fd, flag)
+	if !flag.GetNested() && !flag.GetLiteral() && !presence {
+		return nil
+	}
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return errNotMessage
+	}
+	if flag.GetLiteral() && presence {
+		return errors.New("literal and presence_flag cannot be combined, both register a flag named like the prefix")
+	}
+	return nil
+}
+
+// checkMap verifies that fd is a map whose key and value types fit the format of flag.
+func checkMap(fd protoreflect.FieldDescriptor, flag *annotations.MapFlag) error {
+	if !fd.IsMap() {
+		return errors.New("map flag should be used for map fields")
+	}
+	key, value := fd.MapKey().Kind(), fd.MapValue().Kind()
+	switch flag.GetFormat() {
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING:
+		if key != protoreflect.StringKind || value != protoreflect.StringKind {
+			return errors.New("key and value types must be string for STRING_TO_STRING format")
+		}
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT:
+		if key != protoreflect.StringKind {
+			return errors.New("key type is not string for STRING_TO_INT format")
+		}
+		switch value {
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+			protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+			protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		default:
+			return errors.New("value type is not a valid integer type for STRING_TO_INT format")
+		}
+	}
+	return nil
+}
+
+// fieldType returns the name of the type of fd used in error messages.
+func fieldType(fd protoreflect.FieldDescriptor) string {
+	if fd.Message() != nil {
+		return string(fd.Message().FullName())
+	}
+	return fd.Kind().String()
+}
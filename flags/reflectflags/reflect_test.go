@@ -0,0 +1,284 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reflectflags_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/flags/reflectflags"
+	"github.com/kunstack/protoc-gen-flags/tests"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// generatedMessages returns the messages of tests/test.proto, nested ones included, with generated
+// flag methods, exported or not.
+func generatedMessages(t *testing.T) []proto.Message {
+	t.Helper()
+	var (
+		msgs []proto.Message
+		walk func(mds protoreflect.MessageDescriptors)
+	)
+	walk = func(mds protoreflect.MessageDescriptors) {
+		for i := 0; i < mds.Len(); i++ {
+			md := mds.Get(i)
+			if md.IsMapEntry() {
+				continue
+			}
+			mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+			require.NoError(t, err)
+			msg := mt.New().Interface()
+			_, _, ok := generatedMethods(msg)
+			if proto.GetExtension(md.Options(), annotations.E_Unexported).(bool) {
+				require.True(t, ok, "%s is marked unexported without generated methods", md.FullName())
+			}
+			if ok {
+				msgs = append(msgs, msg)
+			}
+			walk(md.Messages())
+		}
+	}
+	walk(tests.File_tests_test_proto.Messages())
+	require.NotEmpty(t, msgs)
+	return msgs
+}
+
+// generatedMethods returns the generated AddFlags and SetDefaults methods of msg, which are
+// unexported when the message is marked unexported.
+func generatedMethods(msg proto.Message) (addFlags flags.FlaggerFunc, setDefaults func(), ok bool) {
+	if f, ok := msg.(flags.Flagger); ok {
+		return f.AddFlags, msg.(flags.Defaulter).SetDefaults, true
+	}
+	return tests.UnexportedMethods(msg)
+}
+
+// flagInfo is the comparable description of a registered flag.
+type flagInfo struct {
+	Shorthand, Usage, DefValue, Type, Value, NoOptDefVal, Deprecated string
+	Hidden                                                           bool
+	Annotations                                                      map[string][]string
+}
+
+// addFlags registers flags with add, returning the registered flags or the panic of pflag.
+func addFlags(add func(fs *pflag.FlagSet) error) (infos map[string]flagInfo, err error) {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	defer func() {
+		if r := recover(); r != nil {
+			infos, err = nil, fmt.Errorf("panic: %v", r)
+		}
+	}()
+	if err := add(fs); err != nil {
+		return nil, err
+	}
+	infos = make(map[string]flagInfo)
+	fs.VisitAll(func(f *pflag.Flag) {
+		infos[f.Name] = flagInfo{
+			Shorthand:   f.Shorthand,
+			Usage:       f.Usage,
+			DefValue:    f.DefValue,
+			Type:        f.Value.Type(),
+			Value:       f.Value.String(),
+			NoOptDefVal: f.NoOptDefVal,
+			Deprecated:  f.Deprecated,
+			Hidden:      f.Hidden,
+			Annotations: f.Annotations,
+		}
+	})
+	return infos, nil
+}
+
+// isTimestamp reports whether a flag holds timestamps, which may be defaulted relative to now.
+func isTimestamp(info flagInfo) bool {
+	return info.Type == "timestamp" || info.Type == "timestampSlice"
+}
+
+func TestAddMessageFlags(t *testing.T) {
+	optionSets := map[string][]flags.Option{
+		"no options": nil,
+		"prefix":     {flags.WithPrefix("app", "server")},
+		"delimiter":  {flags.WithPrefix("app"), flags.WithDelimiter(flags.DelimiterUnderscore), flags.WithRenamer(strings.ToUpper)},
+	}
+	for _, msg := range generatedMessages(t) {
+		for optsName, opts := range optionSets {
+			name := string(msg.ProtoReflect().Descriptor().Name())
+			t.Run(name+"/"+optsName, func(t *testing.T) {
+				generated := msg.ProtoReflect().New().Interface()
+				want, wantErr := addFlags(func(fs *pflag.FlagSet) error {
+					add, _, _ := generatedMethods(generated)
+					add(fs, opts...)
+					return nil
+				})
+				reflected := msg.ProtoReflect().New().Interface()
+				got, err := addFlags(func(fs *pflag.FlagSet) error {
+					return reflectflags.AddMessageFlags(fs, reflected, opts...)
+				})
+				if wantErr != nil {
+					assert.EqualError(t, err, wantErr.Error())
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, len(want), len(got), "registered flags")
+				for flagName, w := range want {
+					g, ok := got[flagName]
+					if !assert.True(t, ok, "flag %s not registered", flagName) {
+						continue
+					}
+					if isTimestamp(w) {
+						w.DefValue, w.Value, g.DefValue, g.Value = "", "", "", ""
+					}
+					assert.Equal(t, w, g, "flag %s", flagName)
+				}
+				alignTimestamps(generated.ProtoReflect(), reflected.ProtoReflect())
+				assert.True(t, proto.Equal(generated, reflected), "fields initialized by the flags: want %v\ngot  %v", generated, reflected)
+			})
+		}
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	for _, msg := range generatedMessages(t) {
+		t.Run(string(msg.ProtoReflect().Descriptor().Name()), func(t *testing.T) {
+			generated := msg.ProtoReflect().New().Interface()
+			_, setDefaults, _ := generatedMethods(generated)
+			setDefaults()
+			reflected := msg.ProtoReflect().New().Interface()
+			require.NoError(t, reflectflags.ApplyDefaults(reflected))
+			alignTimestamps(generated.ProtoReflect(), reflected.ProtoReflect())
+			assert.True(t, proto.Equal(generated, reflected), "want %v\ngot  %v", generated, reflected)
+		})
+	}
+}
+
+// alignTimestamps copies the timestamps of want that got holds within a minute, since defaults
+// relative to now are evaluated at different times by both paths.
+func alignTimestamps(want, got protoreflect.Message) {
+	want.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !got.Has(fd) || fd.Message() == nil || fd.IsMap() {
+			return true
+		}
+		if fd.IsList() {
+			wl, gl := v.List(), got.Get(fd).List()
+			for i := 0; i < wl.Len() && i < gl.Len(); i++ {
+				alignTimestamp(wl.Get(i).Message(), gl.Get(i).Message())
+			}
+			return true
+		}
+		alignTimestamp(v.Message(), got.Get(fd).Message())
+		return true
+	})
+}
+
+func alignTimestamp(want, got protoreflect.Message) {
+	w, ok := want.Interface().(*timestamppb.Timestamp)
+	if !ok {
+		alignTimestamps(want, got)
+		return
+	}
+	g := got.Interface().(*timestamppb.Timestamp)
+	if d := w.AsTime().Sub(g.AsTime()); d > -time.Minute && d < time.Minute {
+		proto.Merge(g, w)
+	}
+}
+
+func TestAddMessageFlagsParse(t *testing.T) {
+	args := []string{"--server.name=api", "--db.created-at=2024-05-06T07:08:09Z", "--client_config.name=client"}
+	generated := &tests.NestedMessageTestMessage{}
+	fs := pflag.NewFlagSet("generated", pflag.ContinueOnError)
+	generated.AddFlags(fs)
+	require.NoError(t, fs.Parse(args))
+
+	reflected := &tests.NestedMessageTestMessage{}
+	fs = pflag.NewFlagSet("reflected", pflag.ContinueOnError)
+	require.NoError(t, reflectflags.AddMessageFlags(fs, reflected))
+	require.NoError(t, fs.Parse(args))
+
+	assert.Equal(t, "api", reflected.GetServerConfig().GetName())
+	assert.Equal(t, "client", reflected.GetClientConfig().GetName())
+	assert.True(t, proto.Equal(generated, reflected), "want %v\ngot  %v", generated, reflected)
+}
+
+func TestAddMessageFlagsLiteral(t *testing.T) {
+	for _, args := range [][]string{
+		{"--tls.cert-file=server.crt", `--tls={cert_file: "lit.crt" key_file: "lit.key"}`},
+		{`--tls={cert_file: "lit.crt" key_file: "lit.key"}`, "--tls.cert-file=server.crt"},
+	} {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			generated := &tests.LiteralTestMessage{}
+			fs := pflag.NewFlagSet("generated", pflag.ContinueOnError)
+			generated.AddFlags(fs)
+			require.NoError(t, fs.Parse(args))
+
+			reflected := &tests.LiteralTestMessage{}
+			fs = pflag.NewFlagSet("reflected", pflag.ContinueOnError)
+			require.NoError(t, reflectflags.AddMessageFlags(fs, reflected))
+			require.NoError(t, fs.Parse(args))
+
+			assert.Equal(t, "server.crt", generated.GetTls().GetCertFile())
+			assert.Equal(t, "lit.key", generated.GetTls().GetKeyFile())
+			assert.True(t, proto.Equal(generated, reflected), "want %v\ngot  %v", generated, reflected)
+		})
+	}
+}
+
+func TestAddMessageFlagsClearSlice(t *testing.T) {
+	parse := func(t *testing.T, generate bool, msg proto.Message, flag string) {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		if generate {
+			msg.(flags.Flagger).AddFlags(fs)
+		} else {
+			require.NoError(t, reflectflags.AddMessageFlags(fs, msg))
+		}
+		require.NoError(t, fs.Parse([]string{"--" + flag + "=1", "--" + flag + "=", "--" + flag + "=3"}))
+	}
+	for _, generate := range []bool{true, false} {
+		t.Run(fmt.Sprintf("generated=%v", generate), func(t *testing.T) {
+			floats := &tests.FloatSliceTestMessage{}
+			parse(t, generate, floats, "measurements")
+			assert.Equal(t, []float32{3}, floats.GetMeasurements())
+
+			strs := &tests.SensitiveTestMessage{}
+			parse(t, generate, strs, "tokens")
+			assert.Equal(t, []string{"3"}, strs.GetTokens())
+		})
+	}
+}
+
+func TestAddMessageFlagsErrors(t *testing.T) {
+	t.Run("disabled message", func(t *testing.T) {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		require.NoError(t, reflectflags.AddMessageFlags(fs, &tests.DisabledMessage{}))
+		assert.False(t, fs.HasFlags())
+		require.NoError(t, reflectflags.ApplyDefaults(&tests.DisabledMessage{}))
+	})
+	t.Run("dynamic message", func(t *testing.T) {
+		msg := dynamicpb.NewMessage((&tests.SimpleMessage{}).ProtoReflect().Descriptor())
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		assert.ErrorContains(t, reflectflags.AddMessageFlags(fs, msg), "no Go struct field")
+		assert.ErrorContains(t, reflectflags.ApplyDefaults(msg), "no Go struct field")
+	})
+}
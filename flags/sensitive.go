@@ -0,0 +1,71 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// Redacted is returned by the String method of sensitive flag values instead of the value.
+const Redacted = "[REDACTED]"
+
+// AnnotationSensitive is the annotation MarkSensitive attaches to sensitive flags.
+// Code dumping or logging flag values should use IsSensitive to skip or redact them.
+const AnnotationSensitive = "protoc_gen_flags_annotation_sensitive"
+
+var _ pflag.Value = (*SensitiveValue)(nil)
+
+// SensitiveValue wraps the value of a sensitive flag so that String returns Redacted
+// when the value is set. Set and Type are passed through to the wrapped value.
+type SensitiveValue struct {
+	pflag.Value
+}
+
+// String returns Redacted, or "" when the wrapped value is empty.
+func (s *SensitiveValue) String() string {
+	if s.Value.String() == "" {
+		return ""
+	}
+	return Redacted
+}
+
+// Unwrap returns the wrapped value.
+func (s *SensitiveValue) Unwrap() pflag.Value {
+	return s.Value
+}
+
+// MarkSensitive marks the flag registered in fs under name as sensitive: its value is wrapped in a
+// SensitiveValue, its default is no longer shown in help output and it carries the
+// AnnotationSensitive annotation. The bound variable keeps the real value, but pflag getters such as
+// FlagSet.GetString, which parse Value.String, return Redacted.
+func MarkSensitive(fs *pflag.FlagSet, name string) error {
+	f := fs.Lookup(name)
+	if f == nil {
+		return fmt.Errorf("flag %q does not exist", name)
+	}
+	if _, ok := f.Value.(*SensitiveValue); !ok {
+		f.Value = &SensitiveValue{Value: f.Value}
+	}
+	f.DefValue = ""
+	return fs.SetAnnotation(name, AnnotationSensitive, []string{"true"})
+}
+
+// IsSensitive reports whether f was marked with MarkSensitive.
+func IsSensitive(f *pflag.Flag) bool {
+	_, ok := f.Annotations[AnnotationSensitive]
+	return ok
+}
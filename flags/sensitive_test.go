@@ -0,0 +1,80 @@
+// Copyright 2021 Aapeli <aapeli.nian@gmail.com> All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestMarkSensitive(t *testing.T) {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	apiKey := "default-key"
+	fs.StringVar(&apiKey, "api-key", apiKey, "API key")
+	var token string
+	fs.StringVar(&token, "token", "", "Token")
+
+	if err := MarkSensitive(fs, "api-key"); err != nil {
+		t.Fatalf("MarkSensitive() error = %v", err)
+	}
+	if err := MarkSensitive(fs, "missing"); err == nil {
+		t.Error("MarkSensitive() expected error for an unknown flag")
+	}
+
+	f := fs.Lookup("api-key")
+	if !IsSensitive(f) {
+		t.Error("IsSensitive() = false, want true")
+	}
+	if IsSensitive(fs.Lookup("token")) {
+		t.Error("IsSensitive() = true for an unmarked flag")
+	}
+	if got := f.Value.String(); got != Redacted {
+		t.Errorf("String() = %q, want %q", got, Redacted)
+	}
+	if got := f.Value.Type(); got != "string" {
+		t.Errorf("Type() = %q, want %q", got, "string")
+	}
+
+	var help bytes.Buffer
+	fs.SetOutput(&help)
+	fs.PrintDefaults()
+	if strings.Contains(help.String(), "default-key") {
+		t.Errorf("help output contains the sensitive default:\n%s", help.String())
+	}
+
+	if err := fs.Parse([]string{"--api-key=s3cret"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if apiKey != "s3cret" {
+		t.Errorf("apiKey = %q, want %q", apiKey, "s3cret")
+	}
+	if got := f.Value.String(); got != Redacted {
+		t.Errorf("String() = %q, want %q", got, Redacted)
+	}
+
+	apiKey = ""
+	if got := f.Value.String(); got != "" {
+		t.Errorf("String() = %q for an empty value, want \"\"", got)
+	}
+	if err := MarkSensitive(fs, "api-key"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := f.Value.(*SensitiveValue).Unwrap().(*SensitiveValue); ok {
+		t.Error("MarkSensitive() wrapped the value twice")
+	}
+}
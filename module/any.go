@@ -0,0 +1,123 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	pgs "github.com/lyft/protoc-gen-star/v2"
+)
+
+func (m *Module) checkAny(f pgs.Field, flag *annotations.AnyFlag) {
+	if flag == nil {
+		return
+	}
+
+	typ := f.Type()
+	if emb := typ.Embed(); emb == nil || emb.WellKnownType() != pgs.AnyWKT || typ.IsRepeated() {
+		m.Failf("any flag should be used for google.protobuf.Any fields")
+	}
+
+	if flag.GetUsage() == "" {
+		m.Failf("usage is required for any flag")
+	}
+	if flag.GetDeprecated() && flag.GetDeprecatedUsage() == "" {
+		m.Failf("deprecated any flag must provide deprecated_usage message")
+	}
+
+	seen := make(map[string]struct{}, len(flag.GetAllowedTypes()))
+	for _, t := range flag.GetAllowedTypes() {
+		if t == "" || strings.ContainsAny(t, "/ ") || strings.HasPrefix(t, ".") {
+			m.Failf("allowed type %q is not a full message name such as example.v1.Config", t)
+		}
+		if _, ok := seen[t]; ok {
+			m.Failf("duplicate allowed type %q", t)
+		}
+		seen[t] = struct{}{}
+	}
+
+	if flag.Default != nil {
+		m.checkAnyDefault(f, flag, seen)
+	}
+}
+
+// checkAnyDefault validates the JSON default of an any flag: it needs an "@type" naming a message
+// visible from the file, allowed by the flag, and its other keys must be fields of that message.
+func (m *Module) checkAnyDefault(f pgs.Field, flag *annotations.AnyFlag, allowed map[string]struct{}) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(flag.GetDefault()), &fields); err != nil {
+		m.Failf("any default value is not a JSON object: %v", err)
+	}
+	var url string
+	if err := json.Unmarshal(fields["@type"], &url); err != nil || url == "" {
+		m.Failf(`any default value requires an "@type" URL`)
+	}
+	name := url[strings.LastIndex(url, "/")+1:]
+	if _, ok := allowed[name]; len(allowed) > 0 && !ok {
+		m.Failf("any default type %q is not one of the allowed types", name)
+	}
+	msg := m.findMessage(f, "."+name)
+	if msg == nil {
+		m.Failf("any default type %q is not a message of package %s or of the files imported by %s",
+			name, f.Package().ProtoName(), f.File().Name())
+	}
+	if msg.IsWellKnown() {
+		return
+	}
+	for key := range fields {
+		if key == "@type" {
+			continue
+		}
+		if _, err := m.resolveFieldPath(msg, key); err != nil {
+			m.Failf("any default value: %s has no field %q", name, key)
+		}
+	}
+}
+
+// genAny generates the flag binding code for a google.protobuf.Any field. The message types are
+// resolved through the registry given to AddFlags with flags.WithTypeResolver.
+func (m *Module) genAny(f pgs.Field, name pgs.Name, flag *annotations.AnyFlag) string {
+	var declBuilder = &strings.Builder{}
+
+	if flag.GetDisabled() {
+		return fmt.Sprintf("// %s: flags disabled by disabled=true\n", name)
+	}
+
+	if flag.GetName() == "" {
+		flag.Name = strings.ToLower(name.String())
+	}
+
+	var allowed string
+	for _, t := range flag.GetAllowedTypes() {
+		allowed += fmt.Sprintf(", %q", t)
+	}
+
+	_, _ = fmt.Fprintf(declBuilder, `
+			if x.%s  == nil {
+				x.%s = new(%s)
+			}
+		`,
+		name, name, m.getFieldTypeName(f),
+	)
+
+	_, _ = fmt.Fprintf(declBuilder, `
+			fs.VarP(types.Any(x.%s, builder.Resolver()%s), builder.Build(%q), %q, %q)
+		`,
+		name, allowed, flag.GetName(), flag.GetShort(), flag.GetUsage(),
+	)
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
+	return declBuilder.String()
+}
+
+// genAnyDefaults generates the default value assignment code for a google.protobuf.Any field,
+// decoding the JSON default through protoregistry.GlobalTypes at run time.
+func (m *Module) genAnyDefaults(f pgs.Field, name pgs.Name, flag *annotations.AnyFlag) string {
+	if flag.GetDisabled() || flag.Default == nil {
+		return ""
+	}
+	return fmt.Sprintf(`
+		if x.%s == nil {
+			x.%s = utils.MustUnmarshalJSON(%q, new(%s))
+		}`, name, name, flag.GetDefault(), m.getFieldTypeName(f))
+}
@@ -6,32 +6,57 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/utils"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 )
 
+// bytesEncoding is an encoding implemented by types.EncodedBytes.
+type bytesEncoding struct {
+	// name is the name of the encoding constant in the types and utils packages.
+	name     string
+	encoding utils.BytesEncoding
+}
+
+// bytesEncodings holds the encodings bound with types.EncodedBytes and types.EncodedBytesSlice.
+// Base64 and hex keep their historical values.
+var bytesEncodings = map[annotations.BytesEncodingType]bytesEncoding{
+	annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64_URL:     {"EncodingBase64URL", utils.EncodingBase64URL},
+	annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64_RAW:     {"EncodingBase64Raw", utils.EncodingBase64Raw},
+	annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64_RAW_URL: {"EncodingBase64RawURL", utils.EncodingBase64RawURL},
+	annotations.BytesEncodingType_BYTES_ENCODING_TYPE_UTF8:           {"EncodingUTF8", utils.EncodingUTF8},
+	annotations.BytesEncodingType_BYTES_ENCODING_TYPE_FILE:           {"EncodingFile", utils.EncodingFile},
+}
+
+// bytesDefault returns the Go expression decoding a default value with one of bytesEncodings.
+func bytesDefault(enc bytesEncoding, data []byte) string {
+	return fmt.Sprintf("utils.MustDecodeBytes(%q, utils.%s)", data, enc.name)
+}
+
 // validateBytesEncoding validates the encoding type for bytes fields.
 // Returns an error if the encoding type is not supported.
-func (m *Module) validateBytesEncoding(encoding flags.BytesEncodingType) error {
+func (m *Module) validateBytesEncoding(encoding annotations.BytesEncodingType) error {
 	switch encoding {
-	case flags.BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED,
-		flags.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64,
-		flags.BytesEncodingType_BYTES_ENCODING_TYPE_HEX:
+	case annotations.BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED,
+		annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64,
+		annotations.BytesEncodingType_BYTES_ENCODING_TYPE_HEX:
 		return nil
-	default:
-		return fmt.Errorf("invalid bytes encoding type: %v", encoding)
 	}
+	if _, ok := bytesEncodings[encoding]; ok {
+		return nil
+	}
+	return fmt.Errorf("invalid bytes encoding type: %v", encoding)
 }
 
 // validateBytesDefault validates a default bytes value based on the encoding type.
 // If index is >= 0, it's for repeated fields and will be used in error messages.
-func (m *Module) validateBytesDefault(data []byte, encoding flags.BytesEncodingType, index int) error {
+func (m *Module) validateBytesDefault(data []byte, encoding annotations.BytesEncodingType, index int) error {
 	if len(data) == 0 {
 		return nil
 	}
 
 	switch encoding {
-	case flags.BytesEncodingType_BYTES_ENCODING_TYPE_HEX:
+	case annotations.BytesEncodingType_BYTES_ENCODING_TYPE_HEX:
 		// Verify the default value is valid hexadecimal
 		if _, err := hex.DecodeString(string(data)); err != nil {
 			if index >= 0 {
@@ -40,7 +65,7 @@ func (m *Module) validateBytesDefault(data []byte, encoding flags.BytesEncodingT
 			return fmt.Errorf("bytes default value is not valid hex: %v", err)
 		}
 
-	case flags.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64, flags.BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED:
+	case annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64, annotations.BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED:
 		// Verify the default value is valid base64
 		if _, err := base64.StdEncoding.DecodeString(string(data)); err != nil {
 			if index >= 0 {
@@ -48,6 +73,17 @@ func (m *Module) validateBytesDefault(data []byte, encoding flags.BytesEncodingT
 			}
 			return fmt.Errorf("bytes default value is not valid base64: %v", err)
 		}
+
+	case annotations.BytesEncodingType_BYTES_ENCODING_TYPE_FILE:
+		return fmt.Errorf("defaults are not supported with %v", encoding)
+
+	default:
+		if _, err := utils.DecodeBytes(string(data), bytesEncodings[encoding].encoding); err != nil {
+			if index >= 0 {
+				return fmt.Errorf("bytes default value at index %d is not valid for %v: %v", index, encoding, err)
+			}
+			return fmt.Errorf("bytes default value is not valid for %v: %v", encoding, err)
+		}
 	}
 
 	return nil
@@ -56,7 +92,7 @@ func (m *Module) validateBytesDefault(data []byte, encoding flags.BytesEncodingT
 // checkBytes validates the configuration of a bytes flag field.
 // It performs comprehensive validation including encoding type, default values,
 // and required fields for bytes-type protobuf fields.
-func (m *Module) checkBytes(ft pgs.FieldType, r *flags.BytesFlag) {
+func (m *Module) checkBytes(ft pgs.FieldType, r *annotations.BytesFlag) {
 	// Perform common validation first (name, type compatibility, etc.)
 	m.checkCommon(ft, r, pgs.BytesT, pgs.BytesValueWKT, false)
 
@@ -77,6 +113,10 @@ func (m *Module) checkBytes(ft pgs.FieldType, r *flags.BytesFlag) {
 		}
 	}
 
+	if r.GetAllowFileRef() && r.GetEncoding() == annotations.BytesEncodingType_BYTES_ENCODING_TYPE_FILE {
+		m.Failf("allow_file_ref cannot be used with %v", r.GetEncoding())
+	}
+
 	// Ensure deprecated flags have proper deprecation messages
 	if r.GetDeprecated() && r.GetDeprecatedUsage() == "" {
 		m.Failf("deprecated bytes flag must provide deprecated_usage message")
@@ -85,7 +125,7 @@ func (m *Module) checkBytes(ft pgs.FieldType, r *flags.BytesFlag) {
 
 // checkBytesSlice validates the configuration of a repeated bytes flag field.
 // Similar to checkBytes but handles slice/repeated fields with multiple default values.
-func (m *Module) checkBytesSlice(ft FieldType, r *flags.RepeatedBytesFlag) {
+func (m *Module) checkBytesSlice(ft FieldType, r *annotations.RepeatedBytesFlag) {
 	// Perform common validation first for repeated fields (name, type compatibility, etc.)
 	m.checkCommon(ft, r, pgs.BytesT, pgs.BytesValueWKT, true)
 
@@ -106,6 +146,10 @@ func (m *Module) checkBytesSlice(ft FieldType, r *flags.RepeatedBytesFlag) {
 		}
 	}
 
+	if r.GetAllowFileRef() && r.GetEncoding() == annotations.BytesEncodingType_BYTES_ENCODING_TYPE_FILE {
+		m.Failf("allow_file_ref cannot be used with %v", r.GetEncoding())
+	}
+
 	// Ensure deprecated flags have proper deprecation messages
 	if r.GetDeprecated() && r.GetDeprecatedUsage() == "" {
 		m.Failf("deprecated repeated bytes flag must provide deprecated_usage message")
@@ -121,12 +165,16 @@ func (m *Module) checkBytesSlice(ft FieldType, r *flags.RepeatedBytesFlag) {
 //   - name: The field name for code generation
 //   - flag: The bytes flag configuration
 //   - wk: Well-known type information (e.g., google.protobuf.BytesValue)
-func (m *Module) genBytes(f pgs.Field, name pgs.Name, flag *flags.BytesFlag, wk pgs.WellKnownType) string {
+func (m *Module) genBytes(f pgs.Field, name pgs.Name, flag *annotations.BytesFlag, wk pgs.WellKnownType) string {
 	// Configure the flag and check if it's disabled
 	if flag.GetDisabled() {
 		return fmt.Sprintf("// %s: flags disabled by disabled=true\n", name)
 	}
 
+	if flag.GetName() == "" {
+		flag.Name = strings.ToLower(name.String())
+	}
+
 	var (
 		wrapper       = "Bytes"
 		nativeWrapper = "BytesBase64VarP"
@@ -134,13 +182,33 @@ func (m *Module) genBytes(f pgs.Field, name pgs.Name, flag *flags.BytesFlag, wk
 	)
 
 	// Set wrapper based on encoding
-	if flag.GetEncoding() == flags.BytesEncodingType_BYTES_ENCODING_TYPE_HEX {
+	if flag.GetEncoding() == annotations.BytesEncodingType_BYTES_ENCODING_TYPE_HEX {
 		wrapper = "BytesHex"
 		nativeWrapper = "BytesHexVarP"
 	}
+	enc, encoded := bytesEncodings[flag.GetEncoding()]
 
 	// Handle google.protobuf.BytesValue wrapper types
-	if wk != "" && wk != pgs.UnknownWKT {
+	if wk != "" && wk != pgs.UnknownWKT && encoded {
+		_, _ = fmt.Fprintf(declBuilder, `
+			if x.%s  == nil {
+				x.%s = new(%s)
+			}
+		`,
+			name, name, m.getFieldTypeName(f),
+		)
+		_, _ = fmt.Fprintf(declBuilder, `
+			fs.VarP(types.EncodedBytes(&x.%s.Value, types.%s), builder.Build(%q), %q, %q)
+		`,
+			name, enc.name, flag.GetName(), flag.GetShort(), m.flagUsage(flag),
+		)
+	} else if encoded {
+		_, _ = fmt.Fprintf(declBuilder, `
+			fs.VarP(types.EncodedBytes(&x.%s, types.%s), builder.Build(%q), %q, %q)
+		`,
+			name, enc.name, flag.GetName(), flag.GetShort(), m.flagUsage(flag),
+		)
+	} else if wk != "" && wk != pgs.UnknownWKT {
 		_, _ = fmt.Fprintf(declBuilder, `
 			if x.%s  == nil {
 				x.%s = new(%s)
@@ -151,15 +219,16 @@ func (m *Module) genBytes(f pgs.Field, name pgs.Name, flag *flags.BytesFlag, wk
 		_, _ = fmt.Fprintf(declBuilder, `
 			fs.VarP(types.%s(x.%s), builder.Build(%q), %q, %q)
 		`,
-			wrapper, name, flag.GetName(), flag.GetShort(), flag.GetUsage(),
+			wrapper, name, flag.GetName(), flag.GetShort(), m.flagUsage(flag),
 		)
 	} else {
 		_, _ = fmt.Fprintf(declBuilder, `
 				fs.%s(&x.%s, builder.Build(%q), %q, x.%s, %q)
 			`,
-			nativeWrapper, name, flag.GetName(), flag.GetShort(), name, flag.GetUsage())
+			nativeWrapper, name, flag.GetName(), flag.GetShort(), name, m.flagUsage(flag))
 	}
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	_, _ = declBuilder.WriteString(m.genFileRef(flag, flag.GetName()))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
 	return declBuilder.String()
 }
 
@@ -172,28 +241,39 @@ func (m *Module) genBytes(f pgs.Field, name pgs.Name, flag *flags.BytesFlag, wk
 //   - name: The field name for code generation
 //   - flag: The repeated bytes flag configuration
 //   - wk: Well-known type information (unused for slice types)
-func (m *Module) genBytesSlice(name pgs.Name, flag *flags.RepeatedBytesFlag) string {
+func (m *Module) genBytesSlice(name pgs.Name, flag *annotations.RepeatedBytesFlag) string {
 	// Configure the flag and check if it's disabled
 	if flag.GetDisabled() {
 		return fmt.Sprintf("// %s: flags disabled by disabled=true\n", name)
 	}
 
+	if flag.GetName() == "" {
+		flag.Name = strings.ToLower(name.String())
+	}
+
 	var (
 		wrapper     = "BytesSlice"
 		declBuilder = &strings.Builder{}
 	)
 
 	// Set wrapper based on encoding
-	if flag.GetEncoding() == flags.BytesEncodingType_BYTES_ENCODING_TYPE_HEX {
+	if flag.GetEncoding() == annotations.BytesEncodingType_BYTES_ENCODING_TYPE_HEX {
 		wrapper = "BytesHexSlice"
 	}
 
-	_, _ = fmt.Fprintf(declBuilder, `
-			fs.VarP(types.%s(&x.%s), builder.Build(%q), %q, %q)
+	if enc, ok := bytesEncodings[flag.GetEncoding()]; ok {
+		_, _ = fmt.Fprintf(declBuilder, `
+			fs.VarP(types.EncodedBytesSlice(&x.%s, types.%s%s), builder.Build(%q), %q, %q)
 		`,
-		wrapper, name, flag.GetName(), flag.GetShort(), flag.GetUsage())
+			name, enc.name, m.genSliceOptions(flag), flag.GetName(), flag.GetShort(), m.flagUsage(flag))
+	} else {
+		_, _ = fmt.Fprintf(declBuilder, `
+			fs.VarP(types.%s(&x.%s%s), builder.Build(%q), %q, %q)
+		`,
+			wrapper, name, m.genSliceOptions(flag), flag.GetName(), flag.GetShort(), m.flagUsage(flag))
+	}
 
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
 	return declBuilder.String()
 }
 
@@ -206,7 +286,7 @@ func (m *Module) genBytesSlice(name pgs.Name, flag *flags.RepeatedBytesFlag) str
 //   - name: The field name for code generation
 //   - flag: The bytes flag configuration
 //   - wk: Well-known type information (e.g., google.protobuf.BytesValue)
-func (m *Module) genBytesDefaults(f pgs.Field, name pgs.Name, flag *flags.BytesFlag, wk pgs.WellKnownType) string {
+func (m *Module) genBytesDefaults(f pgs.Field, name pgs.Name, flag *annotations.BytesFlag, wk pgs.WellKnownType) string {
 	// Return empty string if no default value is configured
 	if flag.Default == nil || len(flag.GetDefault()) == 0 {
 		return ""
@@ -218,7 +298,7 @@ func (m *Module) genBytesDefaults(f pgs.Field, name pgs.Name, flag *flags.BytesF
 	isWrapper := wk != "" && wk != pgs.UnknownWKT
 
 	switch encoding {
-	case flags.BytesEncodingType_BYTES_ENCODING_TYPE_HEX:
+	case annotations.BytesEncodingType_BYTES_ENCODING_TYPE_HEX:
 		if isWrapper {
 			return fmt.Sprintf(`
 			if x.%s == nil {
@@ -230,7 +310,7 @@ func (m *Module) genBytesDefaults(f pgs.Field, name pgs.Name, flag *flags.BytesF
 				x.%s =  utils.MustDecodeHex(%q)
 			}`, fieldName, fieldName, defaultBytes)
 
-	case flags.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64, flags.BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED:
+	case annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64, annotations.BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED:
 		if isWrapper {
 			return fmt.Sprintf(`
 			if x.%s == nil {
@@ -242,6 +322,18 @@ func (m *Module) genBytesDefaults(f pgs.Field, name pgs.Name, flag *flags.BytesF
 				x.%s =  utils.MustDecodeBase64(%q)
 			}`, fieldName, fieldName, defaultBytes)
 	}
+	if enc, ok := bytesEncodings[encoding]; ok {
+		if isWrapper {
+			return fmt.Sprintf(`
+			if x.%s == nil {
+				x.%s = &wrapperspb.BytesValue{Value: %s}
+			}`, fieldName, fieldName, bytesDefault(enc, defaultBytes))
+		}
+		return fmt.Sprintf(`
+			if len(x.%s) == 0 {
+				x.%s = %s
+			}`, fieldName, fieldName, bytesDefault(enc, defaultBytes))
+	}
 	return ""
 }
 
@@ -254,7 +346,7 @@ func (m *Module) genBytesDefaults(f pgs.Field, name pgs.Name, flag *flags.BytesF
 //   - name: The field name for code generation
 //   - flag: The repeated bytes flag configuration
 //   - wk: Well-known type information (unused for slice types)
-func (m *Module) genBytesSliceDefaults(f pgs.Field, name pgs.Name, flag *flags.RepeatedBytesFlag, wk pgs.WellKnownType) string {
+func (m *Module) genBytesSliceDefaults(f pgs.Field, name pgs.Name, flag *annotations.RepeatedBytesFlag, wk pgs.WellKnownType) string {
 	// Return empty string if no default value is configured
 	if flag.Default == nil || len(flag.GetDefault()) == 0 {
 		return ""
@@ -271,18 +363,24 @@ func (m *Module) genBytesSliceDefaults(f pgs.Field, name pgs.Name, flag *flags.R
 	// Generate default assignments for each value in the slice
 	for i, defaultBytes := range flag.Default {
 		switch flag.GetEncoding() {
-		case flags.BytesEncodingType_BYTES_ENCODING_TYPE_HEX:
+		case annotations.BytesEncodingType_BYTES_ENCODING_TYPE_HEX:
 			if wk != "" && wk != pgs.UnknownWKT {
 				defaultValues[i] = fmt.Sprintf("{Value:  utils.MustDecodeHex(%q) }", defaultBytes)
 			} else {
 				defaultValues[i] = fmt.Sprintf(" utils.MustDecodeHex(%q)", defaultBytes)
 			}
-		case flags.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64, flags.BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED:
+		case annotations.BytesEncodingType_BYTES_ENCODING_TYPE_BASE64, annotations.BytesEncodingType_BYTES_ENCODING_TYPE_UNSPECIFIED:
 			if wk != "" && wk != pgs.UnknownWKT {
 				defaultValues[i] = fmt.Sprintf("{Value:  utils.MustDecodeBase64(%q) }", defaultBytes)
 			} else {
 				defaultValues[i] = fmt.Sprintf(" utils.MustDecodeBase64(%q)", defaultBytes)
 			}
+		default:
+			if wk != "" && wk != pgs.UnknownWKT {
+				defaultValues[i] = fmt.Sprintf("{Value: %s }", bytesDefault(bytesEncodings[flag.GetEncoding()], defaultBytes))
+			} else {
+				defaultValues[i] = bytesDefault(bytesEncodings[flag.GetEncoding()], defaultBytes)
+			}
 		}
 	}
 
@@ -1,7 +1,8 @@
 package module
 
 import (
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/utils"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 	"google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -49,9 +50,9 @@ func (m *Module) shouldGenerate(f pgs.File) bool {
 // Returns true if disabled, unexported, or allow_empty options are present.
 func (m *Module) hasMessageLevelOptions(msg pgs.Message) bool {
 	extensions := []*protoimpl.ExtensionInfo{
-		flags.E_Disabled,
-		flags.E_Unexported,
-		flags.E_AllowEmpty,
+		annotations.E_Disabled,
+		annotations.E_Unexported,
+		annotations.E_AllowEmpty,
 	}
 
 	for _, ext := range extensions {
@@ -72,8 +73,8 @@ func (m *Module) hasMessageLevelOptions(msg pgs.Message) bool {
 // Returns true if any field has flags.E_Value extension.
 func (m *Module) hasFieldLevelOptions(msg pgs.Message) bool {
 	for _, field := range msg.Fields() {
-		var fieldFlags flags.FieldFlags
-		ok, err := field.Extension(flags.E_Value, &fieldFlags)
+		var fieldFlags annotations.FieldFlags
+		ok, err := field.Extension(annotations.E_Value, &fieldFlags)
 		if err != nil {
 			m.CheckErr(err, "unable to read flags extension from field")
 		}
@@ -89,7 +90,7 @@ func (m *Module) Check(msg pgs.Message) {
 	defer m.Pop()
 
 	var disabled bool
-	_, err := msg.Extension(flags.E_Disabled, &disabled)
+	_, err := msg.Extension(annotations.E_Disabled, &disabled)
 	m.CheckErr(err, "unable to read flags extension from message")
 
 	if disabled {
@@ -103,8 +104,8 @@ func (m *Module) Check(msg pgs.Message) {
 	for _, f := range msg.Fields() {
 		m.Push(f.Name().String())
 
-		var field flags.FieldFlags
-		_, err := f.Extension(flags.E_Value, &field)
+		var field annotations.FieldFlags
+		_, err := f.Extension(annotations.E_Value, &field)
 
 		m.CheckErr(err, "unable to read flags from field")
 		m.CheckFieldRules(f, &field)
@@ -115,76 +116,122 @@ func (m *Module) Check(msg pgs.Message) {
 func (m *Module) checkFlagName(msg pgs.Message) {
 	// Track flag names to detect duplicates
 	flagNames := make(map[string]string) // flag name -> field name
+	m.collectFlagNames(msg, msg, "", flagNames, map[string]struct{}{msg.FullyQualifiedName(): {}})
+}
 
+// collectFlagNames adds the flag names of the fields of msg to flagNames, failing on duplicates.
+// The flags of inlined messages share the namespace of root, their fields are reported with
+// their path from root. chain holds the messages being inlined, to reject cycles.
+func (m *Module) collectFlagNames(root, msg pgs.Message, path string, flagNames map[string]string, chain map[string]struct{}) {
 	for _, f := range msg.Fields() {
-		flagName := m.getFlagName(f)
-		if flagName == "" {
-			continue // Skip if no flag name
+		fieldName := path + f.Name().String()
+		if flagName := m.getFlagName(f); flagName != "" {
+			if existingField, exists := flagNames[flagName]; exists {
+				m.Failf("duplicate flag name '%s' found in message '%s': field '%s' and field '%s' both use this flag name",
+					flagName, root.Name().String(), existingField, fieldName)
+			}
+			flagNames[flagName] = fieldName
 		}
 
-		if existingField, exists := flagNames[flagName]; exists {
-			m.Failf("duplicate flag name '%s' found in message '%s': field '%s' and field '%s' both use this flag name",
-				flagName, msg.Name().String(), existingField, f.Name().String())
+		nested := m.inlinedMessage(f)
+		if nested == nil {
+			continue
+		}
+		if _, ok := chain[nested.FullyQualifiedName()]; ok {
+			m.Failf("field '%s' inlines message '%s' into itself", fieldName, nested.Name())
+			continue
 		}
+		chain[nested.FullyQualifiedName()] = struct{}{}
+		m.collectFlagNames(root, nested, fieldName+".", flagNames, chain)
+		delete(chain, nested.FullyQualifiedName())
+	}
+}
 
-		flagNames[flagName] = f.Name().String()
+// inlinedMessage returns the message of f when its nested flags are inlined, nil otherwise.
+func (m *Module) inlinedMessage(f pgs.Field) pgs.Message {
+	var field annotations.FieldFlags
+	if ok, err := f.Extension(annotations.E_Value, &field); err != nil || !ok {
+		return nil
 	}
+	if flag := field.GetMessage(); flag.GetNested() && flag.GetInline() && f.Type().IsEmbed() {
+		return f.Type().Embed()
+	}
+	return nil
 }
 
 // getFlagName extracts the flag name from a protobuf field's flag configuration.
 // It returns the custom flag name if specified, otherwise returns the field name.
 // Returns empty string if the field is disabled or has no flag configuration.
 func (m *Module) getFlagName(f pgs.Field) string {
-	var field flags.FieldFlags
-	ok, err := f.Extension(flags.E_Value, &field)
+	var field annotations.FieldFlags
+	ok, err := f.Extension(annotations.E_Value, &field)
 	if err != nil || !ok {
 		return ""
 	}
 
 	// Extract flag name from the specific flag type
 	switch r := field.Type.(type) {
-	case *flags.FieldFlags_Float:
+	case *annotations.FieldFlags_Float:
 		return m.getNameFromCommonFlag(r.Float, f.Name().String())
-	case *flags.FieldFlags_Double:
+	case *annotations.FieldFlags_Double:
 		return m.getNameFromCommonFlag(r.Double, f.Name().String())
-	case *flags.FieldFlags_Int32:
+	case *annotations.FieldFlags_Int32:
 		return m.getNameFromCommonFlag(r.Int32, f.Name().String())
-	case *flags.FieldFlags_Int64:
+	case *annotations.FieldFlags_Int64:
 		return m.getNameFromCommonFlag(r.Int64, f.Name().String())
-	case *flags.FieldFlags_Uint32:
+	case *annotations.FieldFlags_Uint32:
 		return m.getNameFromCommonFlag(r.Uint32, f.Name().String())
-	case *flags.FieldFlags_Uint64:
+	case *annotations.FieldFlags_Uint64:
 		return m.getNameFromCommonFlag(r.Uint64, f.Name().String())
-	case *flags.FieldFlags_Sint32:
+	case *annotations.FieldFlags_Sint32:
 		return m.getNameFromCommonFlag(r.Sint32, f.Name().String())
-	case *flags.FieldFlags_Sint64:
+	case *annotations.FieldFlags_Sint64:
 		return m.getNameFromCommonFlag(r.Sint64, f.Name().String())
-	case *flags.FieldFlags_Fixed32:
+	case *annotations.FieldFlags_Fixed32:
 		return m.getNameFromCommonFlag(r.Fixed32, f.Name().String())
-	case *flags.FieldFlags_Fixed64:
+	case *annotations.FieldFlags_Fixed64:
 		return m.getNameFromCommonFlag(r.Fixed64, f.Name().String())
-	case *flags.FieldFlags_Sfixed32:
+	case *annotations.FieldFlags_Sfixed32:
 		return m.getNameFromCommonFlag(r.Sfixed32, f.Name().String())
-	case *flags.FieldFlags_Sfixed64:
+	case *annotations.FieldFlags_Sfixed64:
 		return m.getNameFromCommonFlag(r.Sfixed64, f.Name().String())
-	case *flags.FieldFlags_Bool:
+	case *annotations.FieldFlags_Bool:
 		return m.getNameFromCommonFlag(r.Bool, f.Name().String())
-	case *flags.FieldFlags_String_:
+	case *annotations.FieldFlags_String_:
 		return m.getNameFromCommonFlag(r.String_, f.Name().String())
-	case *flags.FieldFlags_Bytes:
+	case *annotations.FieldFlags_Bytes:
 		return m.getNameFromCommonFlag(r.Bytes, f.Name().String())
-	case *flags.FieldFlags_Enum:
+	case *annotations.FieldFlags_Enum:
 		return m.getNameFromCommonFlag(r.Enum, f.Name().String())
-	case *flags.FieldFlags_Duration:
+	case *annotations.FieldFlags_Duration:
 		return m.getNameFromCommonFlag(r.Duration, f.Name().String())
-	case *flags.FieldFlags_Timestamp:
+	case *annotations.FieldFlags_Timestamp:
 		return m.getNameFromCommonFlag(r.Timestamp, f.Name().String())
-	case *flags.FieldFlags_Repeated:
+	case *annotations.FieldFlags_Repeated:
 		return m.getNameFromRepeatedFlag(r.Repeated, f.Name().String())
-	case *flags.FieldFlags_Map:
+	case *annotations.FieldFlags_Map:
 		return m.getNameFromCommonFlag(r.Map, f.Name().String())
-	case *flags.FieldFlags_Message:
-		return "" // Skip Message types
+	case *annotations.FieldFlags_Struct:
+		return m.getNameFromCommonFlag(r.Struct, f.Name().String())
+	case *annotations.FieldFlags_FieldMask:
+		return m.getNameFromCommonFlag(r.FieldMask, f.Name().String())
+	case *annotations.FieldFlags_Any:
+		return m.getNameFromCommonFlag(r.Any, f.Name().String())
+	case *annotations.FieldFlags_Date:
+		return m.getNameFromCommonFlag(r.Date, f.Name().String())
+	case *annotations.FieldFlags_TimeOfDay:
+		return m.getNameFromCommonFlag(r.TimeOfDay, f.Name().String())
+	case *annotations.FieldFlags_Money:
+		return m.getNameFromCommonFlag(r.Money, f.Name().String())
+	case *annotations.FieldFlags_LatLng:
+		return m.getNameFromCommonFlag(r.LatLng, f.Name().String())
+	case *annotations.FieldFlags_Decimal:
+		return m.getNameFromCommonFlag(r.Decimal, f.Name().String())
+	case *annotations.FieldFlags_Message:
+		if r.Message.GetLiteral() || hasPresenceFlag(f.Type(), r.Message) {
+			return messageFlagPrefix(f, r.Message)
+		}
+		return "" // Skip nested Message types
 	default:
 		return ""
 	}
@@ -209,97 +256,114 @@ func (m *Module) getNameFromCommonFlag(flag commonFlag, fallbackName string) str
 // getNameFromRepeatedFlag extracts the flag name from a repeated flag configuration.
 // Returns the custom flag name if specified, otherwise returns the fallback name.
 // Returns empty string if the flag is disabled or nil.
-func (m *Module) getNameFromRepeatedFlag(flag *flags.RepeatedFlags, fallbackName string) string {
+func (m *Module) getNameFromRepeatedFlag(flag *annotations.RepeatedFlags, fallbackName string) string {
 	if flag == nil {
 		return ""
 	}
 
 	switch r := flag.Type.(type) {
-	case *flags.RepeatedFlags_Float:
+	case *annotations.RepeatedFlags_Float:
 		return m.getNameFromCommonFlag(r.Float, fallbackName)
-	case *flags.RepeatedFlags_Double:
+	case *annotations.RepeatedFlags_Double:
 		return m.getNameFromCommonFlag(r.Double, fallbackName)
-	case *flags.RepeatedFlags_Int32:
+	case *annotations.RepeatedFlags_Int32:
 		return m.getNameFromCommonFlag(r.Int32, fallbackName)
-	case *flags.RepeatedFlags_Int64:
+	case *annotations.RepeatedFlags_Int64:
 		return m.getNameFromCommonFlag(r.Int64, fallbackName)
-	case *flags.RepeatedFlags_Uint32:
+	case *annotations.RepeatedFlags_Uint32:
 		return m.getNameFromCommonFlag(r.Uint32, fallbackName)
-	case *flags.RepeatedFlags_Uint64:
+	case *annotations.RepeatedFlags_Uint64:
 		return m.getNameFromCommonFlag(r.Uint64, fallbackName)
-	case *flags.RepeatedFlags_Sint32:
+	case *annotations.RepeatedFlags_Sint32:
 		return m.getNameFromCommonFlag(r.Sint32, fallbackName)
-	case *flags.RepeatedFlags_Sint64:
+	case *annotations.RepeatedFlags_Sint64:
 		return m.getNameFromCommonFlag(r.Sint64, fallbackName)
-	case *flags.RepeatedFlags_Fixed32:
+	case *annotations.RepeatedFlags_Fixed32:
 		return m.getNameFromCommonFlag(r.Fixed32, fallbackName)
-	case *flags.RepeatedFlags_Fixed64:
+	case *annotations.RepeatedFlags_Fixed64:
 		return m.getNameFromCommonFlag(r.Fixed64, fallbackName)
-	case *flags.RepeatedFlags_Sfixed32:
+	case *annotations.RepeatedFlags_Sfixed32:
 		return m.getNameFromCommonFlag(r.Sfixed32, fallbackName)
-	case *flags.RepeatedFlags_Sfixed64:
+	case *annotations.RepeatedFlags_Sfixed64:
 		return m.getNameFromCommonFlag(r.Sfixed64, fallbackName)
-	case *flags.RepeatedFlags_Bool:
+	case *annotations.RepeatedFlags_Bool:
 		return m.getNameFromCommonFlag(r.Bool, fallbackName)
-	case *flags.RepeatedFlags_String_:
+	case *annotations.RepeatedFlags_String_:
 		return m.getNameFromCommonFlag(r.String_, fallbackName)
-	case *flags.RepeatedFlags_Bytes:
+	case *annotations.RepeatedFlags_Bytes:
 		return m.getNameFromCommonFlag(r.Bytes, fallbackName)
-	case *flags.RepeatedFlags_Enum:
+	case *annotations.RepeatedFlags_Enum:
 		return m.getNameFromCommonFlag(r.Enum, fallbackName)
-	case *flags.RepeatedFlags_Duration:
+	case *annotations.RepeatedFlags_Duration:
 		return m.getNameFromCommonFlag(r.Duration, fallbackName)
-	case *flags.RepeatedFlags_Timestamp:
+	case *annotations.RepeatedFlags_Timestamp:
 		return m.getNameFromCommonFlag(r.Timestamp, fallbackName)
 	default:
 		return ""
 	}
 }
 
-func (m *Module) CheckFieldRules(f pgs.Field, field *flags.FieldFlags) {
+func (m *Module) CheckFieldRules(f pgs.Field, field *annotations.FieldFlags) {
 	if field == nil {
 		return
 	}
 	typ := f.Type()
 
 	switch r := field.Type.(type) {
-	case *flags.FieldFlags_Float:
+	case *annotations.FieldFlags_Float:
 		m.checkCommon(typ, r.Float, pgs.FloatT, pgs.FloatValueWKT, false)
-	case *flags.FieldFlags_Double:
+		checkUnit(m, r.Float, r.Float.Default)
+	case *annotations.FieldFlags_Double:
 		m.checkCommon(typ, r.Double, pgs.DoubleT, pgs.DoubleValueWKT, false)
-	case *flags.FieldFlags_Int32:
+		checkUnit(m, r.Double, r.Double.Default)
+	case *annotations.FieldFlags_Int32:
 		m.checkCommon(typ, r.Int32, pgs.Int32T, pgs.Int32ValueWKT, false)
-	case *flags.FieldFlags_Int64:
+		checkUnit(m, r.Int32, r.Int32.Default)
+	case *annotations.FieldFlags_Int64:
 		m.checkCommon(typ, r.Int64, pgs.Int64T, pgs.Int64ValueWKT, false)
-	case *flags.FieldFlags_Uint32:
+		checkUnit(m, r.Int64, r.Int64.Default)
+	case *annotations.FieldFlags_Uint32:
 		m.checkCommon(typ, r.Uint32, pgs.UInt32T, pgs.UInt32ValueWKT, false)
-	case *flags.FieldFlags_Uint64:
+		checkUnit(m, r.Uint32, r.Uint32.Default)
+	case *annotations.FieldFlags_Uint64:
 		m.checkCommon(typ, r.Uint64, pgs.UInt64T, pgs.UInt64ValueWKT, false)
-	case *flags.FieldFlags_Sint32:
+		checkUnit(m, r.Uint64, r.Uint64.Default)
+	case *annotations.FieldFlags_Sint32:
 		m.checkCommon(typ, r.Sint32, pgs.SInt32, pgs.UnknownWKT, false)
-	case *flags.FieldFlags_Sint64:
+		checkUnit(m, r.Sint32, r.Sint32.Default)
+	case *annotations.FieldFlags_Sint64:
 		m.checkCommon(typ, r.Sint64, pgs.SInt64, pgs.UnknownWKT, false)
-	case *flags.FieldFlags_Fixed32:
+		checkUnit(m, r.Sint64, r.Sint64.Default)
+	case *annotations.FieldFlags_Fixed32:
 		m.checkCommon(typ, r.Fixed32, pgs.Fixed32T, pgs.UnknownWKT, false)
-	case *flags.FieldFlags_Fixed64:
+		checkUnit(m, r.Fixed32, r.Fixed32.Default)
+	case *annotations.FieldFlags_Fixed64:
 		m.checkCommon(typ, r.Fixed64, pgs.Fixed64T, pgs.UnknownWKT, false)
-	case *flags.FieldFlags_Sfixed32:
+		checkUnit(m, r.Fixed64, r.Fixed64.Default)
+	case *annotations.FieldFlags_Sfixed32:
 		m.checkCommon(typ, r.Sfixed32, pgs.SFixed32, pgs.UnknownWKT, false)
-	case *flags.FieldFlags_Sfixed64:
+		checkUnit(m, r.Sfixed32, r.Sfixed32.Default)
+	case *annotations.FieldFlags_Sfixed64:
 		m.checkCommon(typ, r.Sfixed64, pgs.SFixed64, pgs.UnknownWKT, false)
-	case *flags.FieldFlags_Bool:
+		checkUnit(m, r.Sfixed64, r.Sfixed64.Default)
+	case *annotations.FieldFlags_Bool:
 		m.checkCommon(typ, r.Bool, pgs.BoolT, pgs.BoolValueWKT, false)
-	case *flags.FieldFlags_String_:
+	case *annotations.FieldFlags_String_:
 		m.checkCommon(typ, r.String_, pgs.StringT, pgs.StringValueWKT, false)
-	case *flags.FieldFlags_Bytes:
+		var defaults []string
+		if r.String_.Default != nil {
+			defaults = append(defaults, r.String_.GetDefault())
+		}
+		m.checkString(r.String_, defaults...)
+	case *annotations.FieldFlags_Bytes:
 		m.checkBytes(typ, r.Bytes)
-	case *flags.FieldFlags_Enum:
+	case *annotations.FieldFlags_Enum:
 		m.checkEnum(typ, r.Enum, pgs.EnumT, pgs.UnknownWKT)
-	case *flags.FieldFlags_Duration:
-		m.checkCommon(typ, r.Duration, pgs.MessageT, pgs.DurationWKT, false)
-	case *flags.FieldFlags_Timestamp:
+	case *annotations.FieldFlags_Duration:
+		m.checkDuration(typ, r.Duration)
+	case *annotations.FieldFlags_Timestamp:
 		m.checkTimestamp(typ, r.Timestamp)
-	case *flags.FieldFlags_Repeated:
+	case *annotations.FieldFlags_Repeated:
 		el, ok := typ.(Element)
 		if !ok || el.Element() == nil {
 			m.Failf("field '%s' is not a repeated field (actual type: %v), "+
@@ -307,14 +371,30 @@ func (m *Module) CheckFieldRules(f pgs.Field, field *flags.FieldFlags) {
 			return
 		}
 		m.CheckRepeatedFlag(el.Element(), r.Repeated)
-	case *flags.FieldFlags_Message:
-		current := m.ctx.ImportPath(f).String()
-		if i := m.ctx.ImportPath(typ.Embed()).String(); i != current {
-			m.imports[i] = struct{}{}
+	case *annotations.FieldFlags_Message:
+		if typ.IsEmbed() && (r.Message.GetNested() || r.Message.GetLiteral() || hasPresenceFlag(typ, r.Message)) {
+			// The generated code allocates the message, unless its flags are disabled.
+			m.addImport(m.ctx.ImportPath(typ.Embed()).String())
 		}
-		m.checkMessage(typ, r.Message)
-	case *flags.FieldFlags_Map:
+		m.checkMessage(f, typ, r.Message)
+	case *annotations.FieldFlags_Map:
 		m.checkMap(typ, r.Map)
+	case *annotations.FieldFlags_Struct:
+		m.checkStruct(typ, r.Struct)
+	case *annotations.FieldFlags_FieldMask:
+		m.checkFieldMask(f, r.FieldMask)
+	case *annotations.FieldFlags_Any:
+		m.checkAny(f, r.Any)
+	case *annotations.FieldFlags_Date:
+		m.checkGoogleType(f, typ, r.Date, utils.DateName)
+	case *annotations.FieldFlags_TimeOfDay:
+		m.checkGoogleType(f, typ, r.TimeOfDay, utils.TimeOfDayName)
+	case *annotations.FieldFlags_Money:
+		m.checkGoogleType(f, typ, r.Money, utils.MoneyName)
+	case *annotations.FieldFlags_LatLng:
+		m.checkGoogleType(f, typ, r.LatLng, utils.LatLngName)
+	case *annotations.FieldFlags_Decimal:
+		m.checkGoogleType(f, typ, r.Decimal, utils.DecimalName)
 	case nil: // noop
 	default:
 		m.Failf("unknown rule type (%T)", field.Type)
@@ -345,9 +425,30 @@ type commonFlag interface {
 	GetDeprecatedUsage() string
 	GetHidden() bool
 	GetShort() string
+	GetSensitive() bool
 }
 
-func (m *Module) CheckRepeatedFlag(typ FieldType, repeated *flags.RepeatedFlags) {
+type repeatedFlag interface {
+	commonFlag
+	GetSplit() annotations.SplitType
+	GetSeparator() string
+	GetMerge() annotations.MergeType
+	GetUnique() bool
+	GetSorted() bool
+}
+
+// fileRefFlag is implemented by the string and bytes flags supporting file references.
+type fileRefFlag interface {
+	GetAllowFileRef() bool
+}
+
+// rangeFlag is implemented by the repeated integer flags supporting range expansion.
+type rangeFlag interface {
+	GetRanges() bool
+	GetMaxRangeSize() uint32
+}
+
+func (m *Module) CheckRepeatedFlag(typ FieldType, repeated *annotations.RepeatedFlags) {
 	if repeated == nil {
 		return
 	}
@@ -357,45 +458,73 @@ func (m *Module) CheckRepeatedFlag(typ FieldType, repeated *flags.RepeatedFlags)
 	}
 
 	switch r := repeated.Type.(type) {
-	case *flags.RepeatedFlags_Float:
+	case *annotations.RepeatedFlags_Float:
 		m.checkCommon(typ, r.Float, pgs.FloatT, pgs.FloatValueWKT, true)
-	case *flags.RepeatedFlags_Double:
+	case *annotations.RepeatedFlags_Double:
 		m.checkCommon(typ, r.Double, pgs.DoubleT, pgs.DoubleValueWKT, true)
-	case *flags.RepeatedFlags_Int32:
+	case *annotations.RepeatedFlags_Int32:
 		m.checkCommon(typ, r.Int32, pgs.Int32T, pgs.Int32ValueWKT, true)
-	case *flags.RepeatedFlags_Int64:
+	case *annotations.RepeatedFlags_Int64:
 		m.checkCommon(typ, r.Int64, pgs.Int64T, pgs.Int64ValueWKT, true)
-	case *flags.RepeatedFlags_Uint32:
+	case *annotations.RepeatedFlags_Uint32:
 		m.checkCommon(typ, r.Uint32, pgs.UInt32T, pgs.UInt32ValueWKT, true)
-	case *flags.RepeatedFlags_Uint64:
-		m.checkCommon(typ, r.Uint64, pgs.UInt64T, pgs.UInt32ValueWKT, true)
-	case *flags.RepeatedFlags_Sint32:
+	case *annotations.RepeatedFlags_Uint64:
+		m.checkCommon(typ, r.Uint64, pgs.UInt64T, pgs.UInt64ValueWKT, true)
+	case *annotations.RepeatedFlags_Sint32:
 		m.checkCommon(typ, r.Sint32, pgs.SInt32, pgs.UnknownWKT, true)
-	case *flags.RepeatedFlags_Sint64:
+	case *annotations.RepeatedFlags_Sint64:
 		m.checkCommon(typ, r.Sint64, pgs.SInt64, pgs.UnknownWKT, true)
-	case *flags.RepeatedFlags_Fixed32:
+	case *annotations.RepeatedFlags_Fixed32:
 		m.checkCommon(typ, r.Fixed32, pgs.Fixed32T, pgs.UnknownWKT, true)
-	case *flags.RepeatedFlags_Fixed64:
+	case *annotations.RepeatedFlags_Fixed64:
 		m.checkCommon(typ, r.Fixed64, pgs.Fixed64T, pgs.UnknownWKT, true)
-	case *flags.RepeatedFlags_Sfixed32:
+	case *annotations.RepeatedFlags_Sfixed32:
 		m.checkCommon(typ, r.Sfixed32, pgs.SFixed32, pgs.UnknownWKT, true)
-	case *flags.RepeatedFlags_Sfixed64:
+	case *annotations.RepeatedFlags_Sfixed64:
 		m.checkCommon(typ, r.Sfixed64, pgs.SFixed64, pgs.UnknownWKT, true)
-	case *flags.RepeatedFlags_Bool:
+	case *annotations.RepeatedFlags_Bool:
 		m.checkCommon(typ, r.Bool, pgs.BoolT, pgs.BoolValueWKT, true)
-	case *flags.RepeatedFlags_String_:
+	case *annotations.RepeatedFlags_String_:
 		m.checkCommon(typ, r.String_, pgs.StringT, pgs.StringValueWKT, true)
-	case *flags.RepeatedFlags_Bytes:
+		m.checkString(r.String_, r.String_.GetDefault()...)
+	case *annotations.RepeatedFlags_Bytes:
 		m.checkBytesSlice(typ, r.Bytes)
-	case *flags.RepeatedFlags_Enum:
+	case *annotations.RepeatedFlags_Enum:
 		m.checkEnumSlice(typ, r.Enum, pgs.EnumT, pgs.UnknownWKT)
-	case *flags.RepeatedFlags_Duration:
+	case *annotations.RepeatedFlags_Duration:
 		m.checkCommon(typ, r.Duration, pgs.MessageT, pgs.DurationWKT, true)
-	case *flags.RepeatedFlags_Timestamp:
+	case *annotations.RepeatedFlags_Timestamp:
 		m.checkTimestampSlice(typ, r.Timestamp)
 	default:
 		m.Failf("unknown repeated flag type (%T)", repeated.Type)
 	}
+
+	m.checkSplit(m.repeatedFlagOf(repeated))
+	m.checkRanges(m.repeatedFlagOf(repeated))
+}
+
+// checkRanges validates the range expansion settings of a repeated integer flag.
+func (m *Module) checkRanges(flag repeatedFlag) {
+	r, ok := flag.(rangeFlag)
+	if !ok {
+		return
+	}
+	if !r.GetRanges() && r.GetMaxRangeSize() != 0 {
+		m.Failf("max_range_size is only allowed when ranges is enabled")
+	}
+}
+
+// checkSplit validates the split and separator settings of a repeated flag.
+func (m *Module) checkSplit(flag repeatedFlag) {
+	if flag == nil {
+		return
+	}
+	if flag.GetSplit() == annotations.SplitType_SPLIT_TYPE_SEPARATOR && flag.GetSeparator() == "" {
+		m.Failf("separator is required when split is SPLIT_TYPE_SEPARATOR")
+	}
+	if flag.GetSplit() != annotations.SplitType_SPLIT_TYPE_SEPARATOR && flag.GetSeparator() != "" {
+		m.Failf("separator is only allowed when split is SPLIT_TYPE_SEPARATOR")
+	}
 }
 
 func (m *Module) mustFieldType(ft FieldType) pgs.FieldType {
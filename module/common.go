@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 )
 
@@ -110,6 +111,15 @@ func (m *Module) genCommon(f pgs.Field, name pgs.Name, flag commonFlag, wk pgs.W
 	if flagName == "" {
 		flagName = strings.ToLower(name.String())
 	}
+	if u, ok := flag.(unitFlag); ok && u.GetUnit() != annotations.UnitType_UNIT_TYPE_UNSPECIFIED {
+		return m.genUnit(f, name, flag, u.GetUnit(), wk, flagName)
+	}
+	if sf, ok := flag.(stringFlag); ok {
+		if typ, checks := m.stringChecks(sf); len(checks) > 0 {
+			return m.genCheckedString(f, name, flag, sf, typ, checks, wk, flagName)
+		}
+	}
+	usage := m.flagUsage(flag)
 	if wk != "" && wk != pgs.UnknownWKT {
 		_, _ = fmt.Fprintf(declBuilder, `
 				if x.%s == nil {
@@ -120,7 +130,7 @@ func (m *Module) genCommon(f pgs.Field, name pgs.Name, flag commonFlag, wk pgs.W
 		_, _ = fmt.Fprintf(declBuilder, `
 				fs.VarP(types.%s(x.%s), builder.Build(%q), %q, %q)
 			`,
-			wrapper, name, flagName, flag.GetShort(), flag.GetUsage())
+			wrapper, name, flagName, flag.GetShort(), usage)
 	} else if f.HasOptionalKeyword() {
 		_, _ = fmt.Fprintf(declBuilder, `
 				if x.%s == nil {
@@ -131,18 +141,22 @@ func (m *Module) genCommon(f pgs.Field, name pgs.Name, flag commonFlag, wk pgs.W
 		_, _ = fmt.Fprintf(declBuilder, `
 				fs.%s(x.%s, builder.Build(%q), %q, *(x.%s), %q)
 			`,
-			nativeWrapper, name, flagName, flag.GetShort(), name, flag.GetUsage())
+			nativeWrapper, name, flagName, flag.GetShort(), name, usage)
 	} else {
 		_, _ = fmt.Fprintf(declBuilder, `
 				fs.%s(&x.%s, builder.Build(%q), %q, x.%s, %q)
 			`,
-			nativeWrapper, name, flagName, flag.GetShort(), name, flag.GetUsage())
+			nativeWrapper, name, flagName, flag.GetShort(), name, usage)
 	}
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	_, _ = declBuilder.WriteString(m.genFileRef(flag, flagName))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flagName))
 	return declBuilder.String()
 }
 
-func (m *Module) genCommonSlice(f pgs.Field, name pgs.Name, flag commonFlag, wk pgs.WellKnownType, wrapper, nativeWrapper string) string {
+// genCommonSlice generates the flag binding code for a repeated scalar field. Native fields use the
+// types.Native<wrapper> value rather than the pflag slice binding, so that an empty argument clears
+// them whatever the options.
+func (m *Module) genCommonSlice(f pgs.Field, name pgs.Name, flag repeatedFlag, wk pgs.WellKnownType, wrapper string) string {
 	var (
 		declBuilder = &strings.Builder{}
 	)
@@ -157,18 +171,24 @@ func (m *Module) genCommonSlice(f pgs.Field, name pgs.Name, flag commonFlag, wk
 		flagName = strings.ToLower(name.String())
 	}
 
+	opts := m.genSliceOptions(flag)
+	usage := m.flagUsage(flag)
+
 	if wk != "" && wk != pgs.UnknownWKT {
 		_, _ = fmt.Fprintf(declBuilder, `
-				fs.VarP(types.%s(&x.%s), builder.Build(%q), %q, %q)
+				fs.VarP(types.%s(&x.%s%s), builder.Build(%q), %q, %q)
 			`,
-			wrapper, name, flagName, flag.GetShort(), flag.GetUsage())
+			wrapper, name, opts, flagName, flag.GetShort(), usage)
 	} else {
 		_, _ = fmt.Fprintf(declBuilder, `
-				fs.%s(&x.%s, builder.Build(%q), %q, x.%s, %q)
+				fs.VarP(types.Native%s(&x.%s%s), builder.Build(%q), %q, %q)
 			`,
-			nativeWrapper, name, flagName, flag.GetShort(), name, flag.GetUsage())
+			wrapper, name, opts, flagName, flag.GetShort(), usage)
 	}
 
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	if sf, ok := flag.(stringFlag); ok {
+		_, _ = declBuilder.WriteString(m.genCompletion(sf, flagName))
+	}
+	_, _ = declBuilder.WriteString(m.genMark(flag, flagName))
 	return declBuilder.String()
 }
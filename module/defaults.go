@@ -3,15 +3,15 @@ package module
 import (
 	"fmt"
 
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 )
 
 func (m *Module) genFieldDefaults(f pgs.Field) string {
 	m.Push(f.Name().String())
 	defer m.Pop()
-	var field flags.FieldFlags
-	ok, err := f.Extension(flags.E_Value, &field)
+	var field annotations.FieldFlags
+	ok, err := f.Extension(annotations.E_Value, &field)
 	if err != nil || !ok {
 		return ""
 	}
@@ -29,53 +29,70 @@ func (m *Module) genFieldDefaults(f pgs.Field) string {
 
 	name := m.ctx.Name(f)
 	switch r := field.Type.(type) {
-	case *flags.FieldFlags_Float:
-		return m.genCommonDefaults(f, name, 0, r.Float.Default, wk)
-	case *flags.FieldFlags_Double:
-		return m.genCommonDefaults(f, name, 0.0, r.Double.Default, wk)
-	case *flags.FieldFlags_Int32:
-		return m.genCommonDefaults(f, name, 0, r.Int32.Default, wk)
-	case *flags.FieldFlags_Int64:
-		return m.genCommonDefaults(f, name, 0, r.Int64.Default, wk)
-	case *flags.FieldFlags_Uint32:
-		return m.genCommonDefaults(f, name, 0, r.Uint32.Default, wk)
-	case *flags.FieldFlags_Uint64:
-		return m.genCommonDefaults(f, name, 0, r.Uint64.Default, wk)
-	case *flags.FieldFlags_Sint32:
-		return m.genCommonDefaults(f, name, 0, r.Sint32.Default, wk)
-	case *flags.FieldFlags_Sint64:
-		return m.genCommonDefaults(f, name, 0, r.Sint64.Default, wk)
-	case *flags.FieldFlags_Fixed32:
-		return m.genCommonDefaults(f, name, 0, r.Fixed32.Default, wk)
-	case *flags.FieldFlags_Fixed64:
-		return m.genCommonDefaults(f, name, 0, r.Fixed64.Default, wk)
-	case *flags.FieldFlags_Sfixed32:
-		return m.genCommonDefaults(f, name, 0, r.Sfixed32.Default, wk)
-	case *flags.FieldFlags_Sfixed64:
-		return m.genCommonDefaults(f, name, 0, r.Sfixed64.Default, wk)
-	case *flags.FieldFlags_Bool:
+	case *annotations.FieldFlags_Float:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Float, r.Float.Default), wk)
+	case *annotations.FieldFlags_Double:
+		return m.genCommonDefaults(f, name, 0.0, unitDefault(m, r.Double, r.Double.Default), wk)
+	case *annotations.FieldFlags_Int32:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Int32, r.Int32.Default), wk)
+	case *annotations.FieldFlags_Int64:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Int64, r.Int64.Default), wk)
+	case *annotations.FieldFlags_Uint32:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Uint32, r.Uint32.Default), wk)
+	case *annotations.FieldFlags_Uint64:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Uint64, r.Uint64.Default), wk)
+	case *annotations.FieldFlags_Sint32:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Sint32, r.Sint32.Default), wk)
+	case *annotations.FieldFlags_Sint64:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Sint64, r.Sint64.Default), wk)
+	case *annotations.FieldFlags_Fixed32:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Fixed32, r.Fixed32.Default), wk)
+	case *annotations.FieldFlags_Fixed64:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Fixed64, r.Fixed64.Default), wk)
+	case *annotations.FieldFlags_Sfixed32:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Sfixed32, r.Sfixed32.Default), wk)
+	case *annotations.FieldFlags_Sfixed64:
+		return m.genCommonDefaults(f, name, 0, unitDefault(m, r.Sfixed64, r.Sfixed64.Default), wk)
+	case *annotations.FieldFlags_Bool:
 		return m.genCommonDefaults(f, name, false, r.Bool.Default, wk)
-	case *flags.FieldFlags_String_:
+	case *annotations.FieldFlags_String_:
 		if r.String_.Default != nil {
-			return m.genCommonDefaults(f, name, `""`, fmt.Sprint(`"`, r.String_.GetDefault(), `"`), wk)
+			def := m.stringDefaults(r.String_, []string{r.String_.GetDefault()})[0]
+			return m.genCommonDefaults(f, name, `""`, def, wk)
 		}
 		return ""
-	case *flags.FieldFlags_Bytes:
+	case *annotations.FieldFlags_Bytes:
 		return m.genBytesDefaults(f, name, r.Bytes, wk)
-	case *flags.FieldFlags_Enum:
+	case *annotations.FieldFlags_Enum:
 		if r.Enum.Default != nil {
 			return m.genCommonDefaults(f, name, 0, r.Enum.GetDefault(), wk)
 		}
 		return ""
-	case *flags.FieldFlags_Duration:
-		return m.genDurationDefaults(f, name, r.Duration)
-	case *flags.FieldFlags_Timestamp:
+	case *annotations.FieldFlags_Duration:
+		return m.genDurationDefaults(f, name, r.Duration, wk)
+	case *annotations.FieldFlags_Timestamp:
 		return m.genTimestampDefaults(f, name, r.Timestamp)
-	case *flags.FieldFlags_Message:
+	case *annotations.FieldFlags_Message:
 		return m.genMessageDefaults(f, name, r.Message)
-	case *flags.FieldFlags_Map:
+	case *annotations.FieldFlags_Map:
 		return ""
-	case *flags.FieldFlags_Repeated:
+	case *annotations.FieldFlags_Struct:
+		return m.genStructDefaults(f, name, r.Struct)
+	case *annotations.FieldFlags_FieldMask:
+		return m.genFieldMaskDefaults(f, name, r.FieldMask)
+	case *annotations.FieldFlags_Any:
+		return m.genAnyDefaults(f, name, r.Any)
+	case *annotations.FieldFlags_Date:
+		return m.genGoogleTypeDefaults(f, name, r.Date)
+	case *annotations.FieldFlags_TimeOfDay:
+		return m.genGoogleTypeDefaults(f, name, r.TimeOfDay)
+	case *annotations.FieldFlags_Money:
+		return m.genGoogleTypeDefaults(f, name, r.Money)
+	case *annotations.FieldFlags_LatLng:
+		return m.genGoogleTypeDefaults(f, name, r.LatLng)
+	case *annotations.FieldFlags_Decimal:
+		return m.genGoogleTypeDefaults(f, name, r.Decimal)
+	case *annotations.FieldFlags_Repeated:
 		return m.processRepeatedDefaults(f, name, r.Repeated)
 	case nil: // noop
 	default:
@@ -85,7 +102,7 @@ func (m *Module) genFieldDefaults(f pgs.Field) string {
 }
 
 // processRepeatedDefaults handles default value generation for repeated fields
-func (m *Module) processRepeatedDefaults(f pgs.Field, name pgs.Name, repeated *flags.RepeatedFlags) string {
+func (m *Module) processRepeatedDefaults(f pgs.Field, name pgs.Name, repeated *annotations.RepeatedFlags) string {
 	if repeated == nil {
 		return ""
 	}
@@ -95,41 +112,41 @@ func (m *Module) processRepeatedDefaults(f pgs.Field, name pgs.Name, repeated *f
 	}
 
 	switch r := repeated.Type.(type) {
-	case *flags.RepeatedFlags_Bytes:
+	case *annotations.RepeatedFlags_Bytes:
 		return m.genBytesSliceDefaults(f, name, r.Bytes, wk)
-	case *flags.RepeatedFlags_Float:
+	case *annotations.RepeatedFlags_Float:
 		return m.genCommonSliceDefaults(f, name, r.Float.GetDefault(), "%f", wk)
-	case *flags.RepeatedFlags_Double:
+	case *annotations.RepeatedFlags_Double:
 		return m.genCommonSliceDefaults(f, name, r.Double.GetDefault(), "%f", wk)
-	case *flags.RepeatedFlags_Int32:
+	case *annotations.RepeatedFlags_Int32:
 		return m.genCommonSliceDefaults(f, name, r.Int32.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Int64:
+	case *annotations.RepeatedFlags_Int64:
 		return m.genCommonSliceDefaults(f, name, r.Int64.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Uint32:
+	case *annotations.RepeatedFlags_Uint32:
 		return m.genCommonSliceDefaults(f, name, r.Uint32.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Uint64:
+	case *annotations.RepeatedFlags_Uint64:
 		return m.genCommonSliceDefaults(f, name, r.Uint64.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Sint32:
+	case *annotations.RepeatedFlags_Sint32:
 		return m.genCommonSliceDefaults(f, name, r.Sint32.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Sint64:
+	case *annotations.RepeatedFlags_Sint64:
 		return m.genCommonSliceDefaults(f, name, r.Sint64.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Fixed32:
+	case *annotations.RepeatedFlags_Fixed32:
 		return m.genCommonSliceDefaults(f, name, r.Fixed32.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Fixed64:
+	case *annotations.RepeatedFlags_Fixed64:
 		return m.genCommonSliceDefaults(f, name, r.Fixed64.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Sfixed32:
+	case *annotations.RepeatedFlags_Sfixed32:
 		return m.genCommonSliceDefaults(f, name, r.Sfixed32.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Sfixed64:
+	case *annotations.RepeatedFlags_Sfixed64:
 		return m.genCommonSliceDefaults(f, name, r.Sfixed64.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Bool:
+	case *annotations.RepeatedFlags_Bool:
 		return m.genCommonSliceDefaults(f, name, r.Bool.GetDefault(), "%v", wk)
-	case *flags.RepeatedFlags_String_:
-		return m.genCommonSliceDefaults(f, name, r.String_.GetDefault(), "%q", wk)
-	case *flags.RepeatedFlags_Enum:
+	case *annotations.RepeatedFlags_String_:
+		return m.genCommonSliceDefaults(f, name, m.stringDefaults(r.String_, r.String_.GetDefault()), "%s", wk)
+	case *annotations.RepeatedFlags_Enum:
 		return m.genCommonSliceDefaults(f, name, r.Enum.GetDefault(), "%d", wk)
-	case *flags.RepeatedFlags_Duration:
+	case *annotations.RepeatedFlags_Duration:
 		return m.genDurationSliceDefaults(f, name, r.Duration, wk)
-	case *flags.RepeatedFlags_Timestamp:
+	case *annotations.RepeatedFlags_Timestamp:
 		return m.genTimestampSliceDefaults(f, name, r.Timestamp)
 	case nil: // noop
 	default:
@@ -5,11 +5,12 @@ import (
 	"strings"
 	"time"
 
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/utils"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 )
 
-func (m *Module) genDuration(f pgs.Field, name pgs.Name, flag *flags.DurationFlag, wk pgs.WellKnownType) string {
+func (m *Module) genDuration(f pgs.Field, name pgs.Name, flag *annotations.DurationFlag, wk pgs.WellKnownType) string {
 	var declBuilder = &strings.Builder{}
 
 	if flag.GetDisabled() {
@@ -20,6 +21,17 @@ func (m *Module) genDuration(f pgs.Field, name pgs.Name, flag *flags.DurationFla
 		flag.Name = strings.ToLower(name.String())
 	}
 
+	if unit, ok := durationUnits[flag.GetUnit()]; ok {
+		target := m.genScalarTarget(declBuilder, f, name, wk)
+		_, _ = fmt.Fprintf(declBuilder, `
+			fs.VarP(types.DurationAs(%s, types.%s), builder.Build(%q), %q, %q)
+		`,
+			target, unit.name, flag.Name, flag.GetShort(), flag.GetUsage(),
+		)
+		_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
+		return declBuilder.String()
+	}
+
 	_, _ = fmt.Fprintf(declBuilder, `
 			if x.%s  == nil {
 				x.%s = new(%s)
@@ -35,11 +47,11 @@ func (m *Module) genDuration(f pgs.Field, name pgs.Name, flag *flags.DurationFla
 	)
 
 	// 添加可选的 flag 配置
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
 	return declBuilder.String()
 }
 
-func (m *Module) genDurationSlice(f pgs.Field, name pgs.Name, flag *flags.RepeatedDurationFlag, wk pgs.WellKnownType) string {
+func (m *Module) genDurationSlice(f pgs.Field, name pgs.Name, flag *annotations.RepeatedDurationFlag, wk pgs.WellKnownType) string {
 	var declBuilder = &strings.Builder{}
 
 	if flag.GetDisabled() {
@@ -51,13 +63,13 @@ func (m *Module) genDurationSlice(f pgs.Field, name pgs.Name, flag *flags.Repeat
 	}
 
 	_, _ = fmt.Fprintf(declBuilder, `
-			fs.VarP(types.DurationSlice(&x.%s), builder.Build(%q), %q, %q)
+			fs.VarP(types.DurationSlice(&x.%s%s), builder.Build(%q), %q, %q)
 		`,
-		name, flag.Name, flag.GetShort(), flag.GetUsage(),
+		name, m.genSliceOptions(flag), flag.Name, flag.GetShort(), flag.GetUsage(),
 	)
 
 	// 添加可选的 flag 配置
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
 	return declBuilder.String()
 }
 
@@ -68,12 +80,12 @@ func (m *Module) genDurationSlice(f pgs.Field, name pgs.Name, flag *flags.Repeat
 //   - f: The protobuf field (should be a duration field)
 //   - name: The Go field name
 //   - flag: The duration flag configuration
-//   - wk: Well-known type information
+//   - wk: Well-known type information, the wrapper type for integer fields
 //
 // Returns:
 //   - Generated Go code for duration default assignment
 //   - Empty string if no default should be generated
-func (m *Module) genDurationDefaults(f pgs.Field, name pgs.Name, flag *flags.DurationFlag) string {
+func (m *Module) genDurationDefaults(f pgs.Field, name pgs.Name, flag *annotations.DurationFlag, wk pgs.WellKnownType) string {
 	var declBuilder = &strings.Builder{}
 
 	if flag.GetDisabled() {
@@ -88,7 +100,7 @@ func (m *Module) genDurationDefaults(f pgs.Field, name pgs.Name, flag *flags.Dur
 	durationStr := *flag.Default
 
 	// Parse the duration string
-	duration, err := time.ParseDuration(durationStr)
+	duration, err := utils.ParseDuration(durationStr)
 	if err != nil {
 		m.Failf("duration default value '%s' is invalid: %v", durationStr, err)
 		return ""
@@ -98,36 +110,119 @@ func (m *Module) genDurationDefaults(f pgs.Field, name pgs.Name, flag *flags.Dur
 	secs := nanos / 1e9
 	nanos -= secs * 1e9
 
+	// For integer fields holding a number of unit
+	if _, ok := durationUnits[flag.GetUnit()]; ok {
+		n, ok := m.intDurationDefault(f.Type(), flag, duration)
+		if !ok {
+			return ""
+		}
+		return m.genCommonDefaults(f, name, 0, n, wk)
+	}
+
 	// For regular duration fields
 	_, _ = fmt.Fprintf(declBuilder, `
 		if x.%s == nil {
 			x.%s = &durationpb.Duration{Seconds: %d, Nanos: %d}
 		}`, name, name, int64(secs), int32(nanos))
-
-	_, _ = declBuilder.WriteString(m.genMark(flag))
 	return declBuilder.String()
 }
 
 // genDurationSliceDefaults generates default value assignment code for repeated duration fields
-func (m *Module) genDurationSliceDefaults(f pgs.Field, name pgs.Name, flag *flags.RepeatedDurationFlag, wk pgs.WellKnownType) string {
+func (m *Module) genDurationSliceDefaults(f pgs.Field, name pgs.Name, flag *annotations.RepeatedDurationFlag, wk pgs.WellKnownType) string {
 	if flag.Default == nil || len(flag.GetDefault()) == 0 {
 		return ""
 	}
 
-	var code strings.Builder
+	values := make([]string, 0, len(flag.GetDefault()))
+	for _, defaultValue := range flag.GetDefault() {
+		duration, err := utils.ParseDuration(defaultValue)
+		if err != nil {
+			m.Failf("duration default value '%s' is invalid: %v", defaultValue, err)
+			return ""
+		}
+		nanos := duration.Nanoseconds()
+		secs := nanos / 1e9
+		nanos -= secs * 1e9
+		values = append(values, fmt.Sprintf("{Seconds: %d, Nanos: %d}", secs, nanos))
+	}
+
+	return fmt.Sprintf(`
+	if len(x.%s) == 0 {
+		x.%s = []*durationpb.Duration{%s}
+	}`, name, name, strings.Join(values, ", "))
+}
+
+// durationUnits maps the storage units of integer duration fields to the types constants.
+var durationUnits = map[annotations.DurationUnitType]struct {
+	name string
+	unit time.Duration
+}{
+	annotations.DurationUnitType_DURATION_UNIT_TYPE_NANOSECONDS:  {"Nanoseconds", time.Nanosecond},
+	annotations.DurationUnitType_DURATION_UNIT_TYPE_MICROSECONDS: {"Microseconds", time.Microsecond},
+	annotations.DurationUnitType_DURATION_UNIT_TYPE_MILLISECONDS: {"Milliseconds", time.Millisecond},
+	annotations.DurationUnitType_DURATION_UNIT_TYPE_SECONDS:      {"Seconds", time.Second},
+	annotations.DurationUnitType_DURATION_UNIT_TYPE_MINUTES:      {"Minutes", time.Minute},
+	annotations.DurationUnitType_DURATION_UNIT_TYPE_HOURS:        {"Hours", time.Hour},
+}
 
-	// Check if the slice is empty before setting defaults
-	code.WriteString(fmt.Sprintf(`
-	if len(x.%s) == 0 {`, name))
+// integerStorage returns the bit size and signedness of an integer field, unwrapping the
+// integer wrapper types. ok is false for any other field type.
+func integerStorage(typ FieldType) (pt pgs.ProtoType, wrapper pgs.WellKnownType, bitSize int, unsigned, ok bool) {
+	pt = typ.ProtoType()
+	if emb := typ.Embed(); emb != nil && emb.IsWellKnown() {
+		switch wrapper = emb.WellKnownType(); wrapper {
+		case pgs.Int32ValueWKT, pgs.Int64ValueWKT, pgs.UInt32ValueWKT, pgs.UInt64ValueWKT:
+			pt = emb.Fields()[0].Type().ProtoType()
+		default:
+			return pt, wrapper, 0, false, false
+		}
+	}
+	switch pt {
+	case pgs.Int32T, pgs.SInt32, pgs.SFixed32:
+		return pt, wrapper, 32, false, true
+	case pgs.Int64T, pgs.SInt64, pgs.SFixed64:
+		return pt, wrapper, 64, false, true
+	case pgs.UInt32T, pgs.Fixed32T:
+		return pt, wrapper, 32, true, true
+	case pgs.UInt64T, pgs.Fixed64T:
+		return pt, wrapper, 64, true, true
+	}
+	return pt, wrapper, 0, false, false
+}
 
-	for i, defaultValue := range flag.Default {
-		varName := fmt.Sprintf("value%d", i)
-		code.WriteString(fmt.Sprintf(`
-		%s, _ := time.ParseDuration(%q)
-		x.%s = append(x.%s, %s)`, varName, defaultValue, name, name, varName))
+// checkDuration validates a duration flag, which applies to google.protobuf.Duration fields
+// or, with a storage unit, to integer fields.
+func (m *Module) checkDuration(typ FieldType, r *annotations.DurationFlag) {
+	pt, wrapper, _, _, isInt := integerStorage(typ)
+	if r.GetUnit() == annotations.DurationUnitType_DURATION_UNIT_TYPE_UNSPECIFIED {
+		if isInt {
+			m.Failf("unit is required for duration flags on integer fields")
+		}
+		m.checkCommon(typ, r, pgs.MessageT, pgs.DurationWKT, false)
+		return
 	}
+	if !isInt {
+		m.Failf("unit is only allowed for duration flags on integer fields")
+		return
+	}
+	m.checkCommon(typ, r, pt, wrapper, false)
+	if r.Default != nil && *r.Default != "" {
+		duration, err := utils.ParseDuration(*r.Default)
+		if err != nil {
+			m.Failf("duration default value '%s' is invalid: %v", *r.Default, err)
+			return
+		}
+		m.intDurationDefault(typ, r, duration)
+	}
+}
 
-	code.WriteString(`
-	}`)
-	return code.String()
+// intDurationDefault converts the default of an integer duration field to its storage unit.
+func (m *Module) intDurationDefault(typ FieldType, flag *annotations.DurationFlag, duration time.Duration) (int64, bool) {
+	_, _, bitSize, unsigned, _ := integerStorage(typ)
+	n, err := utils.DurationToInt(duration, durationUnits[flag.GetUnit()].unit, bitSize, unsigned)
+	if err != nil {
+		m.Failf("duration default value '%s' is invalid: %v", flag.GetDefault(), err)
+		return 0, false
+	}
+	return n, true
 }
@@ -4,11 +4,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 )
 
-func (m *Module) checkEnum(ft pgs.FieldType, flag *flags.EnumFlag, pt pgs.ProtoType, wrapper pgs.WellKnownType) {
+func (m *Module) checkEnum(ft pgs.FieldType, flag *annotations.EnumFlag, pt pgs.ProtoType, wrapper pgs.WellKnownType) {
 	m.checkCommon(ft, flag, pt, wrapper, false)
 
 	typ, ok := ft.(interface {
@@ -31,7 +31,7 @@ func (m *Module) checkEnum(ft pgs.FieldType, flag *flags.EnumFlag, pt pgs.ProtoT
 	}
 }
 
-func (m *Module) checkEnumSlice(ft FieldType, flag *flags.RepeatedEnumFlag, pt pgs.ProtoType, wrapper pgs.WellKnownType) {
+func (m *Module) checkEnumSlice(ft FieldType, flag *annotations.RepeatedEnumFlag, pt pgs.ProtoType, wrapper pgs.WellKnownType) {
 	m.checkCommon(ft, flag, pt, wrapper, true)
 	typ, ok := ft.(interface {
 		Enum() pgs.Enum
@@ -55,7 +55,7 @@ func (m *Module) checkEnumSlice(ft FieldType, flag *flags.RepeatedEnumFlag, pt p
 	}
 }
 
-func (m *Module) genEnum(f pgs.Field, name pgs.Name, flag *flags.EnumFlag, wk pgs.WellKnownType) string {
+func (m *Module) genEnum(f pgs.Field, name pgs.Name, flag *annotations.EnumFlag, wk pgs.WellKnownType) string {
 	var declBuilder = &strings.Builder{}
 
 	if flag.GetDisabled() {
@@ -88,11 +88,11 @@ func (m *Module) genEnum(f pgs.Field, name pgs.Name, flag *flags.EnumFlag, wk pg
 	}
 
 	// 添加可选的 flag 配置
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
 	return declBuilder.String()
 }
 
-func (m *Module) genEnumSlice(f pgs.Field, name pgs.Name, flag *flags.RepeatedEnumFlag, wk pgs.WellKnownType) string {
+func (m *Module) genEnumSlice(f pgs.Field, name pgs.Name, flag *annotations.RepeatedEnumFlag, wk pgs.WellKnownType) string {
 	var declBuilder = &strings.Builder{}
 
 	if flag.GetDisabled() {
@@ -104,12 +104,12 @@ func (m *Module) genEnumSlice(f pgs.Field, name pgs.Name, flag *flags.RepeatedEn
 	}
 
 	_, _ = fmt.Fprintf(declBuilder, `
-			fs.VarP(types.EnumSlice(&x.%s), builder.Build(%q), %q, %q)
+			fs.VarP(types.EnumSlice(&x.%s%s), builder.Build(%q), %q, %q)
 		`,
-		name, flag.Name, flag.GetShort(), flag.GetUsage(),
+		name, m.genSliceOptions(flag), flag.Name, flag.GetShort(), flag.GetUsage(),
 	)
 
 	// 添加可选的 flag 配置
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
 	return declBuilder.String()
 }
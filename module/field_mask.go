@@ -0,0 +1,158 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	pgs "github.com/lyft/protoc-gen-star/v2"
+)
+
+const fieldMaskName = ".google.protobuf.FieldMask"
+
+func (m *Module) checkFieldMask(f pgs.Field, flag *annotations.FieldMaskFlag) {
+	if flag == nil {
+		return
+	}
+
+	typ := f.Type()
+	if emb := typ.Embed(); emb == nil || emb.FullyQualifiedName() != fieldMaskName || typ.IsRepeated() {
+		m.Failf("field_mask flag should be used for google.protobuf.FieldMask fields")
+	}
+
+	if flag.GetUsage() == "" {
+		m.Failf("usage is required for field_mask flag")
+	}
+	if flag.GetDeprecated() && flag.GetDeprecatedUsage() == "" {
+		m.Failf("deprecated field_mask flag must provide deprecated_usage message")
+	}
+
+	if flag.GetTarget() == "" {
+		m.Failf("target is required for field_mask flag")
+	}
+	target := m.findMessage(f, flag.GetTarget())
+	if target == nil {
+		m.Failf("field_mask target %q is not a message of package %s or of the files imported by %s",
+			flag.GetTarget(), f.Package().ProtoName(), f.File().Name())
+	}
+	for _, path := range flag.GetDefault() {
+		if _, err := m.resolveFieldPath(target, path); err != nil {
+			m.Failf("field_mask default: %v", err)
+		}
+	}
+	if !flag.GetDisabled() {
+		// The generated code passes the descriptor of the target, linking its package.
+		m.addImport(m.ctx.ImportPath(target).String())
+	}
+}
+
+// findMessage returns the message named name, a full name or a name relative to the package of f,
+// among the messages of the package of f and of the files imported by the file of f.
+func (m *Module) findMessage(f pgs.Field, name string) pgs.Message {
+	candidates := []string{"." + strings.TrimPrefix(name, ".")}
+	if !strings.HasPrefix(name, ".") {
+		candidates = append(candidates, fmt.Sprintf(".%s.%s", f.Package().ProtoName(), name))
+	}
+
+	files := append(append([]pgs.File(nil), f.Package().Files()...), f.File().Imports()...)
+	for _, file := range files {
+		for _, msg := range file.AllMessages() {
+			for _, candidate := range candidates {
+				if msg.FullyQualifiedName() == candidate {
+					return msg
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// resolveFieldPath checks the field mask path against msg at generation time and returns it with
+// the proto names of the fields, like utils.ResolveFieldPath does at run time.
+func (m *Module) resolveFieldPath(msg pgs.Message, path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("empty field mask path")
+	}
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		if msg == nil {
+			return "", fmt.Errorf("invalid field mask path %q: %s is not a message field", path, strings.Join(segments[:i], "."))
+		}
+		var field pgs.Field
+		for _, fd := range msg.Fields() {
+			if fd.Name().String() == segment || fd.Descriptor().GetJsonName() == segment {
+				field = fd
+				break
+			}
+		}
+		if field == nil {
+			return "", fmt.Errorf("invalid field mask path %q: %s has no field %q",
+				path, strings.TrimPrefix(msg.FullyQualifiedName(), "."), segment)
+		}
+		segments[i] = field.Name().String()
+		msg = nil
+		if field.Type().IsEmbed() {
+			msg = field.Type().Embed()
+		}
+	}
+	return strings.Join(segments, "."), nil
+}
+
+// genFieldMask generates the flag binding code for a google.protobuf.FieldMask field.
+func (m *Module) genFieldMask(f pgs.Field, name pgs.Name, flag *annotations.FieldMaskFlag) string {
+	var declBuilder = &strings.Builder{}
+
+	if flag.GetDisabled() {
+		return fmt.Sprintf("// %s: flags disabled by disabled=true\n", name)
+	}
+
+	if flag.GetName() == "" {
+		flag.Name = strings.ToLower(name.String())
+	}
+
+	target := m.findMessage(f, flag.GetTarget())
+	if target == nil {
+		m.Failf("field_mask target %q not found", flag.GetTarget())
+		return ""
+	}
+
+	_, _ = fmt.Fprintf(declBuilder, `
+			if x.%s  == nil {
+				x.%s = new(%s)
+			}
+		`,
+		name, name, m.getFieldTypeName(f),
+	)
+
+	_, _ = fmt.Fprintf(declBuilder, `
+			fs.VarP(types.FieldMask(x.%s, (*%s)(nil).ProtoReflect().Descriptor()), builder.Build(%q), %q, %q)
+		`,
+		name, m.messageTypeName(target), flag.GetName(), flag.GetShort(), flag.GetUsage(),
+	)
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
+	return declBuilder.String()
+}
+
+// genFieldMaskDefaults generates the default value assignment code for a google.protobuf.FieldMask
+// field, with the paths in canonical form.
+func (m *Module) genFieldMaskDefaults(f pgs.Field, name pgs.Name, flag *annotations.FieldMaskFlag) string {
+	if flag.GetDisabled() || len(flag.GetDefault()) == 0 {
+		return ""
+	}
+
+	target := m.findMessage(f, flag.GetTarget())
+	paths := make([]string, 0, len(flag.GetDefault()))
+	for _, path := range flag.GetDefault() {
+		path, err := m.resolveFieldPath(target, path)
+		if err != nil {
+			m.Failf("field_mask default: %v", err)
+			return ""
+		}
+		paths = append(paths, path)
+	}
+
+	return fmt.Sprintf(`
+		if x.%s == nil {
+			x.%s = &%s{Paths: %s}
+		}`, name, name, m.getFieldTypeName(f), stringsLiteral(paths))
+}
@@ -4,28 +4,152 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/utils"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 )
 
-func (m *Module) genMark(flag commonFlag) string {
+// genMark generates the code marking the flag registered under flagName as hidden, deprecated
+// or sensitive.
+func (m *Module) genMark(flag commonFlag, flagName string) string {
 	var declBuilder = &strings.Builder{}
 	if flag.GetHidden() {
 		_, _ = fmt.Fprintf(declBuilder, `
-				fs.MarkHidden(%q)
+				_ = fs.MarkHidden(builder.Build(%q))
 			`,
-			flag.GetName())
+			flagName)
 	}
 	if flag.GetDeprecated() {
 		_, _ = fmt.Fprintf(declBuilder, `
-				fs.MarkDeprecated(%q, %q)
+				_ = fs.MarkDeprecated(builder.Build(%q), %q)
 			`,
-			flag.GetName(), flag.GetDeprecatedUsage())
+			flagName, flag.GetDeprecatedUsage())
+	}
+	if flag.GetSensitive() {
+		_, _ = fmt.Fprintf(declBuilder, `
+				_ = flags.MarkSensitive(fs, builder.Build(%q))
+			`,
+			flagName)
 	}
 	return declBuilder.String()
 }
 
-func (m *Module) processRepeatedFlag(f pgs.Field, name pgs.Name, repeated *flags.RepeatedFlags) string {
+// flagUsage returns the usage text of a flag, listing the choices of string flags and
+// advertising the file reference syntax.
+func (m *Module) flagUsage(flag commonFlag) string {
+	usage := flag.GetUsage()
+	if s, ok := flag.(stringFlag); ok && len(s.GetChoices()) > 0 {
+		usage += " (one of: " + strings.Join(s.GetChoices(), ", ") + ")"
+	}
+	if r, ok := flag.(fileRefFlag); ok && r.GetAllowFileRef() {
+		if _, repeated := flag.(repeatedFlag); repeated {
+			usage += " (@file or - reads the values from a file or stdin, one per line)"
+		} else {
+			usage += " (@file or - reads the value from a file or stdin)"
+		}
+	}
+	return usage
+}
+
+// genFileRef generates the code accepting file references on a scalar flag bound by the
+// generated code before.
+func (m *Module) genFileRef(flag commonFlag, flagName string) string {
+	if r, ok := flag.(fileRefFlag); !ok || !r.GetAllowFileRef() {
+		return ""
+	}
+	return fmt.Sprintf(`
+				types.AllowFileRef(fs, builder.Build(%q))
+			`, flagName)
+}
+
+// repeatedFlagOf returns the configuration of the concrete flag type held by repeated.
+func (m *Module) repeatedFlagOf(repeated *annotations.RepeatedFlags) repeatedFlag {
+	switch r := repeated.GetType().(type) {
+	case *annotations.RepeatedFlags_Float:
+		return r.Float
+	case *annotations.RepeatedFlags_Double:
+		return r.Double
+	case *annotations.RepeatedFlags_Int32:
+		return r.Int32
+	case *annotations.RepeatedFlags_Int64:
+		return r.Int64
+	case *annotations.RepeatedFlags_Uint32:
+		return r.Uint32
+	case *annotations.RepeatedFlags_Uint64:
+		return r.Uint64
+	case *annotations.RepeatedFlags_Sint32:
+		return r.Sint32
+	case *annotations.RepeatedFlags_Sint64:
+		return r.Sint64
+	case *annotations.RepeatedFlags_Fixed32:
+		return r.Fixed32
+	case *annotations.RepeatedFlags_Fixed64:
+		return r.Fixed64
+	case *annotations.RepeatedFlags_Sfixed32:
+		return r.Sfixed32
+	case *annotations.RepeatedFlags_Sfixed64:
+		return r.Sfixed64
+	case *annotations.RepeatedFlags_Bool:
+		return r.Bool
+	case *annotations.RepeatedFlags_String_:
+		return r.String_
+	case *annotations.RepeatedFlags_Bytes:
+		return r.Bytes
+	case *annotations.RepeatedFlags_Enum:
+		return r.Enum
+	case *annotations.RepeatedFlags_Duration:
+		return r.Duration
+	case *annotations.RepeatedFlags_Timestamp:
+		return r.Timestamp
+	default:
+		return nil
+	}
+}
+
+// genSliceOptions generates the trailing types.SliceOption arguments of a repeated flag value constructor.
+func (m *Module) genSliceOptions(flag repeatedFlag) string {
+	var opts []string
+	switch flag.GetSplit() {
+	case annotations.SplitType_SPLIT_TYPE_CSV:
+		opts = append(opts, "types.WithSplitter(types.SplitCSV)")
+	case annotations.SplitType_SPLIT_TYPE_ARRAY:
+		opts = append(opts, "types.WithSplitter(types.SplitNone)")
+	case annotations.SplitType_SPLIT_TYPE_SEPARATOR:
+		opts = append(opts, fmt.Sprintf("types.WithSplitter(types.SplitSeparator(%q))", flag.GetSeparator()))
+	case annotations.SplitType_SPLIT_TYPE_JSON:
+		opts = append(opts, "types.WithSplitter(types.SplitJSON)")
+	}
+	switch flag.GetMerge() {
+	case annotations.MergeType_MERGE_TYPE_APPEND:
+		opts = append(opts, "types.WithMerge(types.MergeAppend)")
+	case annotations.MergeType_MERGE_TYPE_PREPEND:
+		opts = append(opts, "types.WithMerge(types.MergePrepend)")
+	}
+	if flag.GetUnique() {
+		opts = append(opts, "types.WithUnique()")
+	}
+	if flag.GetSorted() {
+		opts = append(opts, "types.WithSorted()")
+	}
+	if r, ok := flag.(rangeFlag); ok && r.GetRanges() {
+		opts = append(opts, fmt.Sprintf("types.WithRanges(%d)", r.GetMaxRangeSize()))
+	}
+	if r, ok := flag.(fileRefFlag); ok && r.GetAllowFileRef() {
+		opts = append(opts, "types.WithFileRef()")
+	}
+	if s, ok := flag.(stringFlag); ok {
+		_, checks := m.stringChecks(s)
+		for _, check := range checks {
+			opts = append(opts, fmt.Sprintf("types.WithCheck(%s)", check))
+		}
+	}
+	if len(opts) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(opts, ", ")
+}
+
+func (m *Module) processRepeatedFlag(f pgs.Field, name pgs.Name, repeated *annotations.RepeatedFlags) string {
 	if repeated == nil {
 		return ""
 	}
@@ -35,41 +159,41 @@ func (m *Module) processRepeatedFlag(f pgs.Field, name pgs.Name, repeated *flags
 	}
 
 	switch r := repeated.Type.(type) {
-	case *flags.RepeatedFlags_Float:
-		return m.genCommonSlice(f, name, r.Float, wk, "FloatSlice", "Float32SliceVarP")
-	case *flags.RepeatedFlags_Double:
-		return m.genCommonSlice(f, name, r.Double, wk, "DoubleSlice", "Float64SliceVarP")
-	case *flags.RepeatedFlags_Int32:
-		return m.genCommonSlice(f, name, r.Int32, wk, "Int32Slice", "Int32SliceVarP")
-	case *flags.RepeatedFlags_Int64:
-		return m.genCommonSlice(f, name, r.Int64, wk, "Int64Slice", "Int64SliceVarP")
-	case *flags.RepeatedFlags_Uint32:
-		return m.genCommonSlice(f, name, r.Uint32, wk, "UInt32Slice", "Uint32SliceVarP")
-	case *flags.RepeatedFlags_Uint64:
-		return m.genCommonSlice(f, name, r.Uint64, wk, "UInt64Slice", "Uint64SliceVarP")
-	case *flags.RepeatedFlags_Sint32:
-		return m.genCommonSlice(f, name, r.Sint32, wk, "Int32Slice", "Int32SliceVarP")
-	case *flags.RepeatedFlags_Sint64:
-		return m.genCommonSlice(f, name, r.Sint64, wk, "Int64Slice", "Int64SliceVarP")
-	case *flags.RepeatedFlags_Fixed32:
-		return m.genCommonSlice(f, name, r.Fixed32, wk, "UInt32Slice", "Uint32SliceVarP")
-	case *flags.RepeatedFlags_Fixed64:
-		return m.genCommonSlice(f, name, r.Fixed64, wk, "UInt32Slice", "Uint64SliceVarP")
-	case *flags.RepeatedFlags_Sfixed32:
-		return m.genCommonSlice(f, name, r.Sfixed32, wk, "Int32Slice", "Int32SliceVarP")
-	case *flags.RepeatedFlags_Sfixed64:
-		return m.genCommonSlice(f, name, r.Sfixed64, wk, "Int64Slice", "Int64SliceVarP")
-	case *flags.RepeatedFlags_Bool:
-		return m.genCommonSlice(f, name, r.Bool, wk, "BoolSlice", "BoolSliceVarP")
-	case *flags.RepeatedFlags_String_:
-		return m.genCommonSlice(f, name, r.String_, wk, "StringSlice", "StringSliceVarP")
-	case *flags.RepeatedFlags_Bytes:
+	case *annotations.RepeatedFlags_Float:
+		return m.genCommonSlice(f, name, r.Float, wk, "FloatSlice")
+	case *annotations.RepeatedFlags_Double:
+		return m.genCommonSlice(f, name, r.Double, wk, "DoubleSlice")
+	case *annotations.RepeatedFlags_Int32:
+		return m.genCommonSlice(f, name, r.Int32, wk, "Int32Slice")
+	case *annotations.RepeatedFlags_Int64:
+		return m.genCommonSlice(f, name, r.Int64, wk, "Int64Slice")
+	case *annotations.RepeatedFlags_Uint32:
+		return m.genCommonSlice(f, name, r.Uint32, wk, "UInt32Slice")
+	case *annotations.RepeatedFlags_Uint64:
+		return m.genCommonSlice(f, name, r.Uint64, wk, "UInt64Slice")
+	case *annotations.RepeatedFlags_Sint32:
+		return m.genCommonSlice(f, name, r.Sint32, wk, "Int32Slice")
+	case *annotations.RepeatedFlags_Sint64:
+		return m.genCommonSlice(f, name, r.Sint64, wk, "Int64Slice")
+	case *annotations.RepeatedFlags_Fixed32:
+		return m.genCommonSlice(f, name, r.Fixed32, wk, "UInt32Slice")
+	case *annotations.RepeatedFlags_Fixed64:
+		return m.genCommonSlice(f, name, r.Fixed64, wk, "UInt64Slice")
+	case *annotations.RepeatedFlags_Sfixed32:
+		return m.genCommonSlice(f, name, r.Sfixed32, wk, "Int32Slice")
+	case *annotations.RepeatedFlags_Sfixed64:
+		return m.genCommonSlice(f, name, r.Sfixed64, wk, "Int64Slice")
+	case *annotations.RepeatedFlags_Bool:
+		return m.genCommonSlice(f, name, r.Bool, wk, "BoolSlice")
+	case *annotations.RepeatedFlags_String_:
+		return m.genCommonSlice(f, name, r.String_, wk, "StringSlice")
+	case *annotations.RepeatedFlags_Bytes:
 		return m.genBytesSlice(name, r.Bytes)
-	case *flags.RepeatedFlags_Enum:
+	case *annotations.RepeatedFlags_Enum:
 		return m.genEnumSlice(f, name, r.Enum, wk)
-	case *flags.RepeatedFlags_Duration:
+	case *annotations.RepeatedFlags_Duration:
 		return m.genDurationSlice(f, name, r.Duration, wk)
-	case *flags.RepeatedFlags_Timestamp:
+	case *annotations.RepeatedFlags_Timestamp:
 		return m.genTimestampSlice(f, name, r.Timestamp)
 	case nil: // noop
 	default:
@@ -81,8 +205,8 @@ func (m *Module) processRepeatedFlag(f pgs.Field, name pgs.Name, repeated *flags
 func (m *Module) genFieldFlags(f pgs.Field) string {
 	m.Push(f.Name().String())
 	defer m.Pop()
-	var field flags.FieldFlags
-	ok, err := f.Extension(flags.E_Value, &field)
+	var field annotations.FieldFlags
+	ok, err := f.Extension(annotations.E_Value, &field)
 	if err != nil || !ok {
 		return ""
 	}
@@ -100,47 +224,63 @@ func (m *Module) genFieldFlags(f pgs.Field) string {
 
 	name := m.ctx.Name(f)
 	switch r := field.Type.(type) {
-	case *flags.FieldFlags_Float:
+	case *annotations.FieldFlags_Float:
 		return m.genCommon(f, name, r.Float, wk, "Float", "Float32VarP")
-	case *flags.FieldFlags_Double:
+	case *annotations.FieldFlags_Double:
 		return m.genCommon(f, name, r.Double, wk, "Double", "Float64VarP")
-	case *flags.FieldFlags_Int32:
+	case *annotations.FieldFlags_Int32:
 		return m.genCommon(f, name, r.Int32, wk, "Int32", "Int32VarP")
-	case *flags.FieldFlags_Int64:
+	case *annotations.FieldFlags_Int64:
 		return m.genCommon(f, name, r.Int64, wk, "Int64", "Int64VarP")
-	case *flags.FieldFlags_Uint32:
+	case *annotations.FieldFlags_Uint32:
 		return m.genCommon(f, name, r.Uint32, wk, "UInt32", "Uint32VarP")
-	case *flags.FieldFlags_Uint64:
+	case *annotations.FieldFlags_Uint64:
 		return m.genCommon(f, name, r.Uint64, wk, "UInt64", "Uint64VarP")
-	case *flags.FieldFlags_Sint32:
+	case *annotations.FieldFlags_Sint32:
 		return m.genCommon(f, name, r.Sint32, wk, "Int32", "Int32VarP")
-	case *flags.FieldFlags_Sint64:
+	case *annotations.FieldFlags_Sint64:
 		return m.genCommon(f, name, r.Sint64, wk, "Int64", "Int64VarP")
-	case *flags.FieldFlags_Fixed32:
+	case *annotations.FieldFlags_Fixed32:
 		return m.genCommon(f, name, r.Fixed32, wk, "UInt32", "Uint32VarP")
-	case *flags.FieldFlags_Fixed64:
+	case *annotations.FieldFlags_Fixed64:
 		return m.genCommon(f, name, r.Fixed64, wk, "UInt64", "Uint64VarP")
-	case *flags.FieldFlags_Sfixed32:
+	case *annotations.FieldFlags_Sfixed32:
 		return m.genCommon(f, name, r.Sfixed32, wk, "Int32", "Int32VarP")
-	case *flags.FieldFlags_Sfixed64:
+	case *annotations.FieldFlags_Sfixed64:
 		return m.genCommon(f, name, r.Sfixed64, wk, "Int64", "Int64VarP")
-	case *flags.FieldFlags_Bool:
+	case *annotations.FieldFlags_Bool:
 		return m.genCommon(f, name, r.Bool, wk, "Bool", "BoolVarP")
-	case *flags.FieldFlags_String_:
+	case *annotations.FieldFlags_String_:
 		return m.genCommon(f, name, r.String_, wk, "String", "StringVarP")
-	case *flags.FieldFlags_Bytes:
+	case *annotations.FieldFlags_Bytes:
 		return m.genBytes(f, name, r.Bytes, wk)
-	case *flags.FieldFlags_Enum:
+	case *annotations.FieldFlags_Enum:
 		return m.genEnum(f, name, r.Enum, wk)
-	case *flags.FieldFlags_Duration:
+	case *annotations.FieldFlags_Duration:
 		return m.genDuration(f, name, r.Duration, wk)
-	case *flags.FieldFlags_Timestamp:
+	case *annotations.FieldFlags_Timestamp:
 		return m.genTimestamp(f, name, r.Timestamp)
-	case *flags.FieldFlags_Message:
+	case *annotations.FieldFlags_Message:
 		return m.genMessage(f, name, r.Message)
-	case *flags.FieldFlags_Map:
+	case *annotations.FieldFlags_Map:
 		return m.genMap(f, name, r.Map)
-	case *flags.FieldFlags_Repeated:
+	case *annotations.FieldFlags_Struct:
+		return m.genStruct(f, name, r.Struct, wk)
+	case *annotations.FieldFlags_FieldMask:
+		return m.genFieldMask(f, name, r.FieldMask)
+	case *annotations.FieldFlags_Any:
+		return m.genAny(f, name, r.Any)
+	case *annotations.FieldFlags_Date:
+		return m.genGoogleType(f, name, r.Date, utils.DateName)
+	case *annotations.FieldFlags_TimeOfDay:
+		return m.genGoogleType(f, name, r.TimeOfDay, utils.TimeOfDayName)
+	case *annotations.FieldFlags_Money:
+		return m.genGoogleType(f, name, r.Money, utils.MoneyName)
+	case *annotations.FieldFlags_LatLng:
+		return m.genGoogleType(f, name, r.LatLng, utils.LatLngName)
+	case *annotations.FieldFlags_Decimal:
+		return m.genGoogleType(f, name, r.Decimal, utils.DecimalName)
+	case *annotations.FieldFlags_Repeated:
 		return m.processRepeatedFlag(f, name, r.Repeated)
 	case nil: // noop
 	default:
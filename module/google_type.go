@@ -0,0 +1,94 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/utils"
+	pgs "github.com/lyft/protoc-gen-star/v2"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// googleTypeKinds maps the google.type messages to the name of their flag kind in FieldFlags.
+var googleTypeKinds = map[protoreflect.FullName]string{
+	utils.DateName:      "date",
+	utils.TimeOfDayName: "time_of_day",
+	utils.MoneyName:     "money",
+	utils.LatLngName:    "lat_lng",
+	utils.DecimalName:   "decimal",
+}
+
+// checkGoogleType checks a flag of the google.type message name and imports the Go package of the
+// message, needed by the generated code to allocate it.
+func (m *Module) checkGoogleType(f pgs.Field, typ FieldType, flag *annotations.GoogleTypeFlag, name protoreflect.FullName) {
+	if flag == nil {
+		return
+	}
+
+	kind := googleTypeKinds[name]
+	emb := typ.Embed()
+	if emb == nil || emb.FullyQualifiedName() != "."+string(name) {
+		m.Failf("%s flag should be used for %s fields", kind, name)
+		return
+	}
+	if typ, ok := typ.(Repeatable); ok {
+		m.Assert(!typ.IsRepeated(), kind+" flag does not support repeated fields")
+	}
+	m.addImport(m.ctx.ImportPath(emb).String())
+
+	if flag.GetUsage() == "" {
+		m.Failf("usage is required for %s flag", kind)
+	}
+	if flag.GetDeprecated() && flag.GetDeprecatedUsage() == "" {
+		m.Failf("deprecated %s flag must provide deprecated_usage message", kind)
+	}
+
+	if flag.Default != nil {
+		if _, err := utils.ParseGoogleType(name, flag.GetDefault()); err != nil {
+			m.Failf("%s default value is invalid: %v", kind, err)
+		}
+	}
+}
+
+// genGoogleType generates the flag binding code for a field of the google.type message name.
+// The types constructor is named after the message, e.g. types.Money for google.type.Money.
+func (m *Module) genGoogleType(f pgs.Field, name pgs.Name, flag *annotations.GoogleTypeFlag, typeName protoreflect.FullName) string {
+	var declBuilder = &strings.Builder{}
+
+	if flag.GetDisabled() {
+		return fmt.Sprintf("// %s: flags disabled by disabled=true\n", name)
+	}
+
+	if flag.GetName() == "" {
+		flag.Name = strings.ToLower(name.String())
+	}
+
+	_, _ = fmt.Fprintf(declBuilder, `
+			if x.%s  == nil {
+				x.%s = new(%s)
+			}
+		`,
+		name, name, m.getFieldTypeName(f),
+	)
+
+	_, _ = fmt.Fprintf(declBuilder, `
+			fs.VarP(types.%s(x.%s), builder.Build(%q), %q, %q)
+		`,
+		typeName.Name(), name, flag.GetName(), flag.GetShort(), flag.GetUsage(),
+	)
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
+	return declBuilder.String()
+}
+
+// genGoogleTypeDefaults generates the default value assignment code for a google.type field,
+// parsing the default at run time.
+func (m *Module) genGoogleTypeDefaults(f pgs.Field, name pgs.Name, flag *annotations.GoogleTypeFlag) string {
+	if flag.GetDisabled() || flag.Default == nil {
+		return ""
+	}
+	return fmt.Sprintf(`
+		if x.%s == nil {
+			x.%s = utils.MustParseGoogleType(%q, new(%s))
+		}`, name, name, flag.GetDefault(), m.getFieldTypeName(f))
+}
@@ -4,11 +4,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 )
 
-func (m *Module) checkMap(typ FieldType, flag *flags.MapFlag) {
+func (m *Module) checkMap(typ FieldType, flag *annotations.MapFlag) {
 	if flag == nil {
 		return
 	}
@@ -32,7 +32,7 @@ func (m *Module) checkMap(typ FieldType, flag *flags.MapFlag) {
 	valueElem := fieldType.Element()
 
 	switch flag.GetFormat() {
-	case flags.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING:
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING:
 		// Validate key is string
 		if keyElem.ProtoType() != pgs.StringT {
 			m.Failf("STRING_TO_STRING format requires string keys, but got %v", keyElem.ProtoType())
@@ -42,7 +42,7 @@ func (m *Module) checkMap(typ FieldType, flag *flags.MapFlag) {
 			m.Failf("STRING_TO_STRING format requires string values, but got %v", valueElem.ProtoType())
 		}
 
-	case flags.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT:
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT:
 		// Validate key is string
 		if keyElem.ProtoType() != pgs.StringT {
 			m.Failf("STRING_TO_INT format requires string keys, but got %v", keyElem.ProtoType())
@@ -58,12 +58,12 @@ func (m *Module) checkMap(typ FieldType, flag *flags.MapFlag) {
 			m.Failf("STRING_TO_INT format requires integer values, but got %v", valueElem.ProtoType())
 		}
 
-	case flags.MapFormatType_MAP_FORMAT_TYPE_JSON:
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_JSON:
 		// JSON format is flexible, no strict type validation needed
 		// Just ensure it's actually a map
 		break
 
-	case flags.MapFormatType_MAP_FORMAT_TYPE_UNSPECIFIED:
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_UNSPECIFIED:
 		// Default to JSON format, no additional validation needed
 		break
 
@@ -72,7 +72,7 @@ func (m *Module) checkMap(typ FieldType, flag *flags.MapFlag) {
 	}
 }
 
-func (m *Module) genMap(f pgs.Field, name pgs.Name, flag *flags.MapFlag) string {
+func (m *Module) genMap(f pgs.Field, name pgs.Name, flag *annotations.MapFlag) string {
 	var (
 		declBuilder = &strings.Builder{}
 	)
@@ -95,15 +95,15 @@ func (m *Module) genMap(f pgs.Field, name pgs.Name, flag *flags.MapFlag) string
 	mapFormat := flag.GetFormat()
 
 	// If unspecified, default to JSON format for backward compatibility
-	if mapFormat == flags.MapFormatType_MAP_FORMAT_TYPE_UNSPECIFIED {
-		mapFormat = flags.MapFormatType_MAP_FORMAT_TYPE_JSON
+	if mapFormat == annotations.MapFormatType_MAP_FORMAT_TYPE_UNSPECIFIED {
+		mapFormat = annotations.MapFormatType_MAP_FORMAT_TYPE_JSON
 	}
 
 	keyType := f.Type().Key()
 	valueType := f.Type().Element()
 
 	switch mapFormat {
-	case flags.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING:
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING:
 		if keyType.ProtoType() != pgs.StringT {
 			m.Failf("field %s key type is not string for STRING_TO_STRING format", name)
 			return ""
@@ -112,7 +112,7 @@ func (m *Module) genMap(f pgs.Field, name pgs.Name, flag *flags.MapFlag) string
 			m.Failf("field %s value type is not string for STRING_TO_STRING format", name)
 			return ""
 		}
-	case flags.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT:
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT:
 		if keyType.ProtoType() != pgs.StringT {
 			m.Failf("field %s key type is not string for STRING_TO_INT format", name)
 			return ""
@@ -132,14 +132,14 @@ func (m *Module) genMap(f pgs.Field, name pgs.Name, flag *flags.MapFlag) string
 
 	// Generate flag binding based on format
 	switch mapFormat {
-	case flags.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING:
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_STRING:
 		_, _ = fmt.Fprintf(declBuilder, `
 				fs.StringToStringVarP(&x.%s, builder.Build(%q), %q, x.%s, %q)
 			`,
 			name, flag.GetName(), flag.GetShort(), name, flag.GetUsage(),
 		)
 
-	case flags.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT:
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_STRING_TO_INT:
 		// For string-to-int maps, determine the specific int type based on the field
 		valueType := f.Type().Element()
 
@@ -177,7 +177,7 @@ func (m *Module) genMap(f pgs.Field, name pgs.Name, flag *flags.MapFlag) string
 			return ""
 		}
 
-	case flags.MapFormatType_MAP_FORMAT_TYPE_JSON:
+	case annotations.MapFormatType_MAP_FORMAT_TYPE_JSON:
 		// For JSON format, use the existing JSON handling
 		_, _ = fmt.Fprintf(declBuilder, `
 				fs.VarP(types.JSON(&x.%s), builder.Build(%q), %q, %q)
@@ -186,6 +186,6 @@ func (m *Module) genMap(f pgs.Field, name pgs.Name, flag *flags.MapFlag) string
 		)
 
 	}
-	declBuilder.WriteString(m.genMark(flag))
+	declBuilder.WriteString(m.genMark(flag, flag.GetName()))
 	return declBuilder.String()
 }
@@ -4,35 +4,223 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 )
 
-func (m *Module) checkMessage(typ pgs.FieldType, flag *flags.MessageFlag) {
-	if !flag.GetNested() {
+func (m *Module) checkMessage(f pgs.Field, typ pgs.FieldType, flag *annotations.MessageFlag) {
+	if flag.GetInline() && !flag.GetNested() {
+		m.Failf("inline requires nested")
+	}
+	presence := hasPresenceFlag(typ, flag)
+	if !flag.GetNested() && !flag.GetLiteral() && !presence {
 		return
 	}
-	m.mustType(typ, pgs.MessageT, pgs.UnknownWKT)
+	if !presence || !isEmptyMessage(typ) {
+		m.mustType(typ, pgs.MessageT, pgs.UnknownWKT)
+	}
 	if typ, ok := typ.(Repeatable); ok {
 		m.Assert(!typ.IsRepeated(), "message flag does not support repeated fields")
 	}
+	if flag.GetLiteral() && presence {
+		m.Failf("literal and presence_flag cannot be combined, both register a flag named like the prefix")
+	}
+	if flag.GetLiteral() && flag.GetUsage() == "" {
+		m.Failf("usage is required for literal message flag")
+	}
+	if presence && flag.GetUsage() == "" {
+		m.Failf("usage is required for message presence flag")
+	}
+	if typ.IsEmbed() {
+		m.checkNestedMethods(f, typ.Embed(), flag)
+	}
+}
+
+// checkNestedMethods verifies that the nested flags of f register flags, and that the generated
+// methods of its message can be called by the generated code of f.
+func (m *Module) checkNestedMethods(f pgs.Field, emb pgs.Message, flag *annotations.MessageFlag) {
+	if flag.GetNested() {
+		if reason := m.nestedTargetProblem(f, emb); reason != "" {
+			m.reportNested("field '%s' nests message '%s' which registers no flags: %s", f.Name(), emb.FullyQualifiedName()[1:], reason)
+		}
+	}
+	if !hasFlagMethods(emb) || m.isForeign(emb) {
+		return
+	}
+	if m.funcs != "" {
+		m.addImport(m.funcsPath(emb))
+	}
+	addFlags, _ := methodNames(emb)
+	if addFlags != "AddFlags" && m.ctx.ImportPath(emb) != m.ctx.ImportPath(f) {
+		m.Failf("nested message '%s' is marked unexported, its generated methods cannot be called from package %s",
+			emb.Name(), m.ctx.ImportPath(f))
+	}
+}
+
+// nestedTargetProblem returns why the nested flags of f register nothing, or an empty string when
+// its message is generated by this plugin or is a well-known type.
+func (m *Module) nestedTargetProblem(f pgs.Field, emb pgs.Message) string {
+	if emb.WellKnownType() != pgs.UnknownWKT {
+		return ""
+	}
+	var disabled bool
+	_, _ = emb.Extension(annotations.E_Disabled, &disabled)
+	switch {
+	case disabled:
+		return "it is disabled by (flags.disabled)"
+	case !m.hasMessageLevelOptions(emb) && !m.hasFieldLevelOptions(emb):
+		return "it has no flags annotations"
+	case !hasFlagMethods(emb):
+		return "it has no flag fields outside oneofs, see (flags.allow_empty)"
+	}
+	// protoc-gen-go requires the files of a Go package to be generated together, messages of other
+	// packages are assumed to be generated by their own invocation.
+	if !emb.File().BuildTarget() && !m.isForeign(emb) && m.ctx.ImportPath(emb) == m.ctx.ImportPath(f) {
+		return fmt.Sprintf("its file %s was not passed to the plugin", emb.File().Name())
+	}
+	return ""
+}
+
+// reportNested reports a problem of a nested message as configured by the nested_check parameter.
+func (m *Module) reportNested(format string, args ...interface{}) {
+	if m.nestedCheck == nestedCheckWarn {
+		m.Logf("warning: "+format, args...)
+		return
+	}
+	m.Failf(format, args...)
+}
+
+// nestedCall builds the calls to the generated flag methods of a nested message, or to its
+// generated functions in function mode or when it is annotated by the overlay file outside the
+// targets.
+type nestedCall struct {
+	addFlags, setDefaults string
+	funcs                 bool
+}
+
+// nestedCalls returns the calls to the generated flag methods or functions of the message of f,
+// or nil when the message is foreign to this plugin.
+func (m *Module) nestedCalls(f pgs.Field) *nestedCall {
+	if !f.Type().IsEmbed() || !hasFlagMethods(f.Type().Embed()) {
+		return nil
+	}
+	emb := f.Type().Embed()
+	switch {
+	case m.isForeign(emb):
+		addFlags, setDefaults := m.funcNames(emb)
+		return &nestedCall{addFlags: addFlags, setDefaults: setDefaults, funcs: true}
+	case m.funcs != "":
+		addFlags, setDefaults := m.funcNames(emb)
+		if pkg := m.funcsPath(emb); pkg != m.genPkg {
+			// The functions of the messages of other packages are in their own functions package.
+			alias := m.getPackageAlias(pkg)
+			addFlags, setDefaults = alias+"."+addFlags, alias+"."+setDefaults
+		}
+		return &nestedCall{addFlags: addFlags, setDefaults: setDefaults, funcs: true}
+	}
+	addFlags, setDefaults := methodNames(emb)
+	return &nestedCall{addFlags: addFlags, setDefaults: setDefaults}
+}
+
+// AddFlags returns the call registering the flags of the message x with the options opts.
+func (c *nestedCall) AddFlags(x, opts string) string {
+	if c.funcs {
+		return fmt.Sprintf("%s(fs, %s, %s...)", c.addFlags, x, opts)
+	}
+	return fmt.Sprintf("%s.%s(fs, %s...)", x, c.addFlags, opts)
+}
+
+// SetDefaults returns the call setting the defaults of the message x.
+func (c *nestedCall) SetDefaults(x string) string {
+	if c.funcs {
+		return fmt.Sprintf("%s(%s)", c.setDefaults, x)
+	}
+	return fmt.Sprintf("%s.%s()", x, c.setDefaults)
+}
+
+// Flagger returns a flags.Flagger registering the flags of the message x. It is built from the
+// generated method or function, since messages marked unexported do not implement the interface.
+func (c *nestedCall) Flagger(x string) string {
+	if c.funcs {
+		return fmt.Sprintf("flags.FlaggerFunc(func(fs *pflag.FlagSet, opts ...flags.Option) { %s })", c.AddFlags(x, "opts"))
+	}
+	return fmt.Sprintf("flags.FlaggerFunc(%s.%s)", x, c.addFlags)
+}
+
+// nestedOptions returns the options expression the nested flags of a message field are registered
+// with: the options of the parent, with the prefix unless the message is inlined.
+func nestedOptions(flag *annotations.MessageFlag, prefix string) string {
+	if flag.GetInline() {
+		return "opts"
+	}
+	return fmt.Sprintf("append(opts, flags.WithPrefix(%q))", prefix)
+}
+
+// isEmptyMessage reports whether typ is google.protobuf.Empty.
+func isEmptyMessage(typ pgs.FieldType) bool {
+	return typ.IsEmbed() && typ.Embed().WellKnownType() == pgs.EmptyWKT
+}
+
+// hasPresenceFlag reports whether a message field registers a presence flag: when presence_flag
+// is set, and for google.protobuf.Empty fields.
+func hasPresenceFlag(typ pgs.FieldType, flag *annotations.MessageFlag) bool {
+	return flag.GetPresenceFlag() || isEmptyMessage(typ)
+}
+
+// messageFlagPrefix returns the prefix of the nested flags of a message field, which is also the
+// name of its literal flag.
+func messageFlagPrefix(f pgs.Field, flag *annotations.MessageFlag) string {
+	if flag.GetName() != "" {
+		return flag.GetName()
+	}
+	// use field name instead
+	return strings.ToLower(f.Name().String())
 }
 
-func (m *Module) genMessageDefaults(f pgs.Field, name pgs.Name, flag *flags.MessageFlag) string {
+func (m *Module) genMessageDefaults(f pgs.Field, name pgs.Name, flag *annotations.MessageFlag) string {
 	var (
 		declBuilder = &strings.Builder{}
 	)
-	if !flag.GetNested() {
+	presence := hasPresenceFlag(f.Type(), flag)
+	if !flag.GetNested() && !flag.GetLiteral() && !presence {
 		return fmt.Sprint("\n// ", name, ": flags disabled by [(flags.value).message = {nested: false}]")
 	}
-	if flag.GetNested() {
+	call := m.nestedCalls(f)
+	if presence && call != nil {
+		// The message is only set by its presence flag.
+		_, _ = fmt.Fprintf(declBuilder, `
+				if x.%s != nil {
+					%s
+				}
+			`,
+			name, call.SetDefaults("x."+name.String()),
+		)
+		return declBuilder.String()
+	}
+	if presence {
 		_, _ = fmt.Fprintf(declBuilder, `
-				if x.%s == nil {
-					x.%s = new(%s)
+				if v, ok := interface{}(x.%s).(flags.Defaulter); ok && x.%s != nil {
+					v.SetDefaults()
 				}
-        	`,
-			name, name, m.getFieldTypeName(f),
+			`,
+			name, name,
 		)
+		return declBuilder.String()
+	}
+	_, _ = fmt.Fprintf(declBuilder, `
+			if x.%s == nil {
+				x.%s = new(%s)
+			}
+        `,
+		name, name, m.getFieldTypeName(f),
+	)
+	if call != nil {
+		_, _ = fmt.Fprintf(declBuilder, `
+				%s
+			`,
+			call.SetDefaults("x."+name.String()),
+		)
+		return declBuilder.String()
 	}
 	_, _ = fmt.Fprintf(declBuilder, `
 			if v, ok := interface{}(x.%s).(flags.Defaulter); ok {
@@ -44,33 +232,105 @@ func (m *Module) genMessageDefaults(f pgs.Field, name pgs.Name, flag *flags.Mess
 	return declBuilder.String()
 }
 
-func (m *Module) genMessage(f pgs.Field, name pgs.Name, flag *flags.MessageFlag) string {
+func (m *Module) genMessage(f pgs.Field, name pgs.Name, flag *annotations.MessageFlag) string {
 	var (
 		declBuilder = &strings.Builder{}
 	)
-	if !flag.GetNested() {
+	if hasPresenceFlag(f.Type(), flag) {
+		return m.genMessagePresence(f, name, flag)
+	}
+	if !flag.GetNested() && !flag.GetLiteral() {
 		return fmt.Sprint("\n// ", name, ": flags disabled by [(flags.value).message = {nested: false}]")
 	}
-	prefix := flag.GetName()
-	if prefix == "" {
-		// use field name instead
-		prefix = strings.ToLower(f.Name().String())
+	prefix := messageFlagPrefix(f, flag)
+	_, _ = fmt.Fprintf(declBuilder, `
+			if x.%s == nil {
+				x.%s = new(%s)
+			}
+        `,
+		name, name, m.getFieldTypeName(f),
+	)
+	var nested string
+	if call := m.nestedCalls(f); flag.GetNested() && call != nil {
+		nested = call.AddFlags("x."+name.String(), nestedOptions(flag, prefix))
+	} else if flag.GetNested() {
+		nested = fmt.Sprintf(`
+				if v, ok := interface{}(x.%s).(flags.Flagger); ok {
+					v.AddFlags(fs, %s...)
+				}
+			`,
+			name, nestedOptions(flag, prefix),
+		)
 	}
-	if flag.GetNested() {
+	switch {
+	case flag.GetLiteral() && nested != "":
+		// The nested flags are tracked by the literal, which keeps the fields they set.
 		_, _ = fmt.Fprintf(declBuilder, `
-				if x.%s == nil {
-					x.%s = new(%s)
+				{
+					literal := types.Message(x.%s)
+					literal.TrackFlags(fs, func() {
+						%s
+					})
+					fs.VarP(literal, builder.Build(%q), %q, %q)
 				}
-        	`,
-			name, name, m.getFieldTypeName(f),
+			`,
+			name, nested, prefix, flag.GetShort(), flag.GetUsage(),
 		)
+	case flag.GetLiteral():
+		_, _ = fmt.Fprintf(declBuilder, `
+				fs.VarP(types.Message(x.%s), builder.Build(%q), %q, %q)
+			`,
+			name, prefix, flag.GetShort(), flag.GetUsage(),
+		)
+	default:
+		_, _ = fmt.Fprintf(declBuilder, `
+				%s
+			`,
+			nested,
+		)
+	}
+	return declBuilder.String()
+}
+
+// genMessagePresence generates the presence flag of an optional message field. The nested flags
+// are bound to a message initialized with its defaults, which the field is set to when the
+// presence flag or one of the nested flags is set.
+func (m *Module) genMessagePresence(f pgs.Field, name pgs.Name, flag *annotations.MessageFlag) string {
+	var (
+		declBuilder = &strings.Builder{}
+		prefix      = messageFlagPrefix(f, flag)
+		defaults    = "if v, ok := interface{}(msg).(flags.Defaulter); ok {\n v.SetDefaults()\n }"
+		flagger     = "msg"
+	)
+	if call := m.nestedCalls(f); call != nil {
+		defaults = call.SetDefaults("msg")
+		flagger = call.Flagger("msg")
 	}
 	_, _ = fmt.Fprintf(declBuilder, `
-			if v, ok := interface{}(x.%s).(flags.Flagger); ok {
-				v.AddFlags(fs, append(opts, flags.WithPrefix(%q))...)
-			}
-        `,
-		name, prefix,
+			{
+				msg := x.%s
+				if msg == nil {
+					msg = new(%s)
+					%s
+				}
+				fs.VarP(types.Presence(&x.%s, msg), builder.Build(%q), %q, %q)
+		`,
+		name, m.getFieldTypeName(f), defaults, name, prefix, flag.GetShort(), flag.GetUsage(),
 	)
+	if flag.GetNested() {
+		_, _ = fmt.Fprintf(declBuilder, `
+				_ = flags.AddPresenceFlags(fs, builder.Build(%q), %s, %s...)
+			}
+		`,
+			prefix, flagger, nestedOptions(flag, prefix),
+		)
+	} else {
+		_, _ = fmt.Fprintf(declBuilder, `
+				_ = flags.AddPresenceFlags(fs, builder.Build(%q), nil)
+			}
+		`,
+			prefix,
+		)
+	}
 	return declBuilder.String()
 }
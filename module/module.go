@@ -1,23 +1,28 @@
 package module
 
 import (
+	"bytes"
 	"fmt"
+	"go/token"
+	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 	pgsgo "github.com/lyft/protoc-gen-star/v2/lang/go"
 )
 
 func Flags() *Module {
-	return &Module{
-		ModuleBase:      &pgs.ModuleBase{},
-		imports:         make(map[string]struct{}),
-		packageAliases:  make(map[string]string),
-		nameCollisions:  make(map[string]int),
-		normalizedPaths: make(map[string]struct{}),
+	m := &Module{
+		ModuleBase: &pgs.ModuleBase{},
+		overlaid:   make(map[string]struct{}),
+		emitted:    make(map[string]struct{}),
+		funcOwners: make(map[string]string),
 	}
+	m.resetImports()
+	return m
 }
 
 // ImportInfo stores normalized import information
@@ -34,8 +39,23 @@ type Module struct {
 	packageAliases  map[string]string // import path -> alias (if needed)
 	nameCollisions  map[string]int    // package name -> collision count
 	normalizedPaths map[string]struct{}
+	nestedCheck     string
+	overlay         *overlay
+	overlaid        map[string]struct{} // messages annotated by the overlay file
+	genPkg          string              // import path of the file being generated
+	funcs           string              // name of the package generated functions are written to
+	functions       []pgs.Message       // messages generated as functions with the current file
+	emitted         map[string]struct{} // import path and name of the generated foreign messages
+	funcOwners      map[string]string   // import path and name of the generated functions -> message
 }
 
+// Values of the nested_check parameter, configuring how nested messages registering no flags are
+// reported.
+const (
+	nestedCheckError = "error"
+	nestedCheckWarn  = "warn"
+)
+
 func (m *Module) Name() string {
 	return "flags"
 }
@@ -44,36 +64,38 @@ func (m *Module) InitContext(c pgs.BuildContext) {
 	m.ModuleBase.InitContext(c)
 	m.ctx = pgsgo.InitContext(c.Parameters())
 
-	// Initialize standard package names that might collide
-	// Based on example.go enumPackages implementation
-	m.nameCollisions = map[string]int{
-		"pflag":       0,
-		"utils":       0,
-		"types":       0,
-		"flags":       0,
-		"durationpb":  0,
-		"timestamppb": 0,
-		"wrapperspb":  0,
+	m.nestedCheck = c.Parameters().StrDefault("nested_check", nestedCheckError)
+	if m.nestedCheck != nestedCheckError && m.nestedCheck != nestedCheckWarn {
+		m.Failf("invalid nested_check parameter %q, expected %q or %q", m.nestedCheck, nestedCheckError, nestedCheckWarn)
+	}
+	if path := c.Parameters().Str("overlay"); path != "" {
+		o, err := loadOverlay(path)
+		m.CheckErr(err, "unable to load overlay file")
+		m.overlay = o
+	}
+	m.funcs = c.Parameters().Str("funcs")
+	if m.funcs != "" && !token.IsIdentifier(m.funcs) {
+		m.Failf("invalid funcs parameter %q, expected a Go package name", m.funcs)
 	}
 
 	tpl := template.New("fields").Funcs(map[string]interface{}{
-		"package": m.ctx.PackageName,
-		"name":    m.ctx.Name,
-		"methodName": func(m pgs.Message) string {
-			var private bool
-			_, _ = m.Extension(flags.E_Unexported, &private)
-			if private {
-				return "_AddFlags"
+		"package": func(f pgs.File) pgs.Name {
+			if m.funcs != "" {
+				return pgs.Name(m.funcs)
 			}
-			return "AddFlags"
+			return m.ctx.PackageName(f)
 		},
-		"defaultMethodName": func(m pgs.Message) string {
-			var private bool
-			_, _ = m.Extension(flags.E_Unexported, &private)
-			if private {
-				return "_SetDefaults"
-			}
-			return "SetDefaults"
+		"methods": func() bool {
+			return m.funcs == ""
+		},
+		"name": m.ctx.Name,
+		"methodName": func(msg pgs.Message) string {
+			addFlags, _ := methodNames(msg)
+			return addFlags
+		},
+		"defaultMethodName": func(msg pgs.Message) string {
+			_, setDefaults := methodNames(msg)
+			return setDefaults
 		},
 		"comment": func(s string) string {
 			var out string
@@ -89,25 +111,19 @@ func (m *Module) InitContext(c pgs.BuildContext) {
 		"imports": func() string {
 			return m.generateImports()
 		},
-		"enabled": func(msg pgs.Message) bool {
-			var (
-				disabled   bool
-				hasFlag    bool
-				allowEmpty bool
-			)
-			for _, field := range msg.Fields() {
-				var fd flags.FieldFlags
-				ok, err := field.Extension(flags.E_Value, &fd)
-				if err == nil && ok && !field.InRealOneOf() {
-					hasFlag = true
-					break
-				}
-			}
-			_, _ = msg.Extension(flags.E_Disabled, &disabled)
-			_, _ = msg.Extension(flags.E_AllowEmpty, &allowEmpty)
-
-			return !disabled && (hasFlag || allowEmpty)
+		"enabled": hasFlagMethods,
+		"functions": func() []pgs.Message {
+			return m.functions
+		},
+		"addFunc": func(msg pgs.Message) string {
+			addFlags, _ := m.funcNames(msg)
+			return addFlags
+		},
+		"defaultsFunc": func(msg pgs.Message) string {
+			_, setDefaults := m.funcNames(msg)
+			return setDefaults
 		},
+		"typeName": m.messageTypeName,
 		"flags": func(f pgs.Field) string {
 			return m.genFieldFlags(f)
 		},
@@ -119,8 +135,18 @@ func (m *Module) InitContext(c pgs.BuildContext) {
 }
 
 func (m *Module) Execute(targets map[string]pgs.File, _ map[string]pgs.Package) []pgs.Artifact {
-	for _, f := range targets {
-		if m.shouldGenerate(f) {
+	if m.overlay != nil {
+		m.applyOverlay(targets)
+	}
+	// Files are generated in a stable order, the first file of a package nesting a foreign message
+	// holds its functions.
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if f := targets[name]; m.shouldGenerate(f) {
 			m.generate(f)
 		}
 	}
@@ -131,11 +157,46 @@ func (m *Module) generate(f pgs.File) {
 	if len(f.Messages()) == 0 {
 		return
 	}
+	m.genPkg = m.ctx.ImportPath(f).String()
+	m.functions = nil
+	if m.funcs != "" {
+		m.genPkg = m.funcsPath(f)
+	}
+	// The imports and their aliases are those of the file being generated.
+	m.resetImports()
+	if m.funcs != "" {
+		m.addImport(m.ctx.ImportPath(f).String())
+		for _, msg := range f.AllMessages() {
+			if hasFlagMethods(msg) {
+				m.functions = append(m.functions, msg)
+			}
+		}
+	}
 	for _, msg := range f.Messages() {
 		m.Check(msg)
 	}
+	foreign := m.foreignMessages(f)
+	for _, msg := range foreign {
+		m.addImport(m.ctx.ImportPath(msg).String())
+		m.Check(msg)
+	}
+	m.functions = append(m.functions, foreign...)
+	m.checkFuncNames()
+	// The file is rendered right away, the generated code depends on the package being generated.
+	buf := &bytes.Buffer{}
+	m.CheckErr(m.tpl.Execute(buf, f), "unable to render template")
 	name := m.ctx.OutputPath(f).SetExt(".flags.go")
-	m.AddGeneratorTemplateFile(name.String(), m.tpl, f)
+	if m.funcs != "" {
+		name = name.Dir().Push(m.funcs).Push(name.Base())
+	}
+	m.AddGeneratorFile(name.String(), buf.String())
+}
+
+// funcsPath returns the import path of the package the functions of the messages of e are
+// generated to in function mode: the package named by the funcs parameter, under the package of
+// the messages.
+func (m *Module) funcsPath(e pgs.Entity) string {
+	return m.ctx.ImportPath(e).Push(m.funcs).String()
 }
 
 const defaultsTpl = `{{ comment .SyntaxSourceCodeInfo.LeadingComments }}
@@ -154,6 +215,9 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	{{ imports }}
 )
@@ -166,8 +230,12 @@ var (
 	_ = wrapperspb.String
 	_ = (*durationpb.Duration)(nil)
 	_ = (*timestamppb.Timestamp)(nil)
+	_ = (*structpb.Struct)(nil)
+	_ = (*fieldmaskpb.FieldMask)(nil)
+	_ = (*anypb.Any)(nil)
 )
 
+{{ if methods }}
 {{ range .AllMessages }}
 {{ if enabled . }}
 func (x *{{ name . }}) {{ methodName . }}(fs *pflag.FlagSet, opts ...flags.Option) {
@@ -185,8 +253,64 @@ func (x *{{ name . }}) {{ defaultMethodName . }}() {
 }
 {{- end }}
 {{ end }}
+{{ end }}
+
+{{ range functions }}
+// {{ addFunc . }} registers the flags of {{ typeName . }}.
+func {{ addFunc . }}(fs *pflag.FlagSet, x *{{ typeName . }}, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	{{- range .Fields }}
+		{{- flags . }}
+	{{- end }}
+}
+
+// {{ defaultsFunc . }} sets the defaults of {{ typeName . }}.
+func {{ defaultsFunc . }}(x *{{ typeName . }}) {
+	{{- range .Fields }}
+		{{- defaults . }}
+	{{- end }}
+}
+{{ end }}
 `
 
+// resetImports clears the imports of the generated file and their aliases, initializing the
+// names of the standard packages that might collide.
+func (m *Module) resetImports() {
+	m.imports = make(map[string]struct{})
+	m.packageAliases = make(map[string]string)
+	m.normalizedPaths = make(map[string]struct{})
+	// Based on example.go enumPackages implementation
+	m.nameCollisions = map[string]int{
+		"pflag":       0,
+		"utils":       0,
+		"types":       0,
+		"flags":       0,
+		"durationpb":  0,
+		"timestamppb": 0,
+		"wrapperspb":  0,
+		"structpb":    0,
+		"fieldmaskpb": 0,
+		"anypb":       0,
+	}
+}
+
+// addImport adds the package importPath to the imports of the generated file, unless it is the
+// package being generated.
+func (m *Module) addImport(importPath string) {
+	if importPath != m.genPkg {
+		m.imports[importPath] = struct{}{}
+	}
+}
+
+// addBlankImport adds the package importPath to the imports of the generated file for its side
+// effects only.
+func (m *Module) addBlankImport(importPath string) {
+	m.imports[importPath] = struct{}{}
+	m.packageAliases[importPath] = "_"
+	m.normalizedPaths[importPath] = struct{}{}
+}
+
 // normalizeImports processes all imports and assigns aliases to packages with name collisions
 // This is based on the enumPackages implementation in example.go
 func (m *Module) normalizeImports() {
@@ -302,5 +426,81 @@ func (m *Module) resolveTypeReference(typeRef string, field pgs.Field) string {
 // This handles repeated fields, pointer types, and package name collisions
 func (m *Module) getFieldTypeName(f pgs.Field) string {
 	typeName := m.ctx.Type(f).Value().String()
+	if pkg := m.ctx.ImportPath(f).String(); m.genPkg != "" && pkg != m.genPkg {
+		// The types of the package of f are not qualified, the field belongs to a foreign message.
+		typeName = localTypeName.ReplaceAllString(typeName, "${1}"+m.getPackageAlias(pkg)+".${2}")
+	}
 	return m.resolveTypeReference(typeName, f)
 }
+
+// localTypeName matches the unqualified message and enum names of a Go type expression.
+var localTypeName = regexp.MustCompile(`(^|[\[\]*])([A-Z]\w*)`)
+
+// messageTypeName returns the Go type of msg, qualified when it does not belong to the package
+// being generated.
+func (m *Module) messageTypeName(msg pgs.Message) string {
+	pkg := m.ctx.ImportPath(msg).String()
+	if pkg == m.genPkg {
+		return m.ctx.Name(msg).String()
+	}
+	return m.getPackageAlias(pkg) + "." + m.ctx.Name(msg).String()
+}
+
+// hasFlagMethods reports whether the AddFlags and SetDefaults methods of msg are generated: the
+// message is not disabled and has flag fields or allows being empty.
+func hasFlagMethods(msg pgs.Message) bool {
+	var (
+		disabled   bool
+		hasFlag    bool
+		allowEmpty bool
+	)
+	for _, field := range msg.Fields() {
+		var fd annotations.FieldFlags
+		ok, err := field.Extension(annotations.E_Value, &fd)
+		if err == nil && ok && !field.InRealOneOf() {
+			hasFlag = true
+			break
+		}
+	}
+	_, _ = msg.Extension(annotations.E_Disabled, &disabled)
+	_, _ = msg.Extension(annotations.E_AllowEmpty, &allowEmpty)
+
+	return !disabled && (hasFlag || allowEmpty)
+}
+
+// methodNames returns the names of the generated AddFlags and SetDefaults methods of msg, prefixed
+// with an underscore when the message is marked unexported.
+func methodNames(msg pgs.Message) (addFlags, setDefaults string) {
+	var private bool
+	_, _ = msg.Extension(annotations.E_Unexported, &private)
+	if private {
+		return "_AddFlags", "_SetDefaults"
+	}
+	return "AddFlags", "SetDefaults"
+}
+
+// checkFuncNames fails when the functions of two messages generated to the same package have the
+// same name, such as those of messages with the same name in different packages.
+func (m *Module) checkFuncNames() {
+	for _, msg := range m.functions {
+		addFlags, _ := m.funcNames(msg)
+		key := m.genPkg + ":" + addFlags
+		name := strings.TrimPrefix(msg.FullyQualifiedName(), ".")
+		if owner, ok := m.funcOwners[key]; ok && owner != name {
+			m.Failf("the functions generated for %s and %s in package %s have the same name %s", owner, name, m.genPkg, addFlags)
+		}
+		m.funcOwners[key] = name
+	}
+}
+
+// funcNames returns the names of the functions generated for a message in function mode or for a
+// foreign message, which are not exported when the message is marked unexported.
+func (m *Module) funcNames(msg pgs.Message) (addFlags, setDefaults string) {
+	name := m.ctx.Name(msg).String()
+	var private bool
+	_, _ = msg.Extension(annotations.E_Unexported, &private)
+	if private {
+		return "add" + name + "Flags", "set" + name + "Defaults"
+	}
+	return "Add" + name + "Flags", "Set" + name + "Defaults"
+}
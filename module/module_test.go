@@ -0,0 +1,521 @@
+package module
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	tpb "github.com/kunstack/protoc-gen-flags/tests"
+	"github.com/kunstack/protoc-gen-flags/tests/funcs"
+	"github.com/kunstack/protoc-gen-flags/tests/funcs/common"
+	overlaytest "github.com/kunstack/protoc-gen-flags/tests/overlay"
+	typestest "github.com/kunstack/protoc-gen-flags/tests/types"
+	pgs "github.com/lyft/protoc-gen-star/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// runPlugin generates the files of targets with the parameters params, returning the contents
+// of the generated files by name and the debugger recording the failures.
+func runPlugin(t *testing.T, params string, targets ...protoreflect.FileDescriptor) (map[string]string, pgs.MockDebugger) {
+	t.Helper()
+	req := &pluginpb.CodeGeneratorRequest{Parameter: proto.String(params)}
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		file := protodesc.ToFileDescriptorProto(fd)
+		// The linked descriptors have no source info, the template reads the comments of the syntax.
+		file.SourceCodeInfo = &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+			{Path: []int32{12}, Span: []int32{0, 0, 0}},
+		}}
+		req.ProtoFile = append(req.ProtoFile, file)
+	}
+	for _, fd := range targets {
+		add(fd)
+		req.FileToGenerate = append(req.FileToGenerate, fd.Path())
+	}
+
+	d := pgs.InitMockDebugger()
+	graph := pgs.ProcessCodeGeneratorRequest(d, req)
+	m := Flags()
+	m.InitContext(pgs.Context(d, pgs.ParseParameters(params), "."))
+	files := make(map[string]string)
+	for _, artifact := range m.Execute(graph.Targets(), graph.Packages()) {
+		if f, ok := artifact.(pgs.GeneratorFile); ok {
+			files[f.Name] = f.Contents
+		}
+	}
+	return files, d
+}
+
+// assertImportsUsed checks that every package imported by the generated file is referenced.
+func assertImportsUsed(t *testing.T, name, src string) {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, name, src, 0)
+	require.NoError(t, err, name)
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		pkg := path.Base(importPath)
+		if spec.Name != nil {
+			pkg = spec.Name.Name
+		}
+		if pkg == "_" {
+			continue
+		}
+		assert.True(t, used[pkg], "%s imports %s without using it", name, importPath)
+	}
+}
+
+func TestGenerateImportsPerFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		targets []protoreflect.FileDescriptor
+		want    []string
+	}{
+		{
+			name:   "function mode",
+			params: "paths=source_relative,funcs=configflags",
+			targets: []protoreflect.FileDescriptor{
+				common.File_tests_funcs_common_common_proto,
+				funcs.File_tests_funcs_config_proto,
+				typestest.File_tests_types_types_proto,
+			},
+			want: []string{
+				"tests/funcs/common/configflags/common.pb.flags.go",
+				"tests/funcs/configflags/config.pb.flags.go",
+				"tests/types/configflags/types.pb.flags.go",
+			},
+		},
+		{
+			name:   "overlay",
+			params: "paths=source_relative,overlay=../tests/overlay/overlay.yaml",
+			targets: []protoreflect.FileDescriptor{
+				overlaytest.File_tests_overlay_overlay_proto,
+				typestest.File_tests_types_types_proto,
+			},
+			want: []string{
+				"tests/overlay/overlay.pb.flags.go",
+				"tests/types/types.pb.flags.go",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, d := runPlugin(t, tt.params, tt.targets...)
+			require.NoError(t, d.Err())
+			require.False(t, d.Failed(), "generation failed")
+			for _, name := range tt.want {
+				src, ok := files[name]
+				if assert.True(t, ok, "%s not generated", name) {
+					assertImportsUsed(t, name, src)
+				}
+			}
+		})
+	}
+}
+
+// newFiles links the files, which may import deps and the files before them.
+func newFiles(t *testing.T, deps []protoreflect.FileDescriptor, files ...*descriptorpb.FileDescriptorProto) []protoreflect.FileDescriptor {
+	t.Helper()
+	reg := new(protoregistry.Files)
+	var register func(fd protoreflect.FileDescriptor)
+	register = func(fd protoreflect.FileDescriptor) {
+		if _, err := reg.FindFileByPath(fd.Path()); err == nil {
+			return
+		}
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			register(imports.Get(i).FileDescriptor)
+		}
+		require.NoError(t, reg.RegisterFile(fd))
+	}
+	for _, fd := range deps {
+		register(fd)
+	}
+	var out []protoreflect.FileDescriptor
+	for _, file := range files {
+		fd, err := protodesc.NewFile(file, reg)
+		require.NoError(t, err, file.GetName())
+		register(fd)
+		out = append(out, fd)
+	}
+	return out
+}
+
+// configFile returns a file of package pkg declaring a message Config with a name field.
+func configFile(pkg string) *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String(pkg + "/config.proto"),
+		Package: proto.String(pkg),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("example.com/" + pkg)},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Config"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("name"),
+				JsonName: proto.String("name"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			}},
+		}},
+	}
+}
+
+// nestedField returns a field of the message typeName with nested flags.
+func nestedField(name string, number int32, typeName string) *descriptorpb.FieldDescriptorProto {
+	opts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(opts, annotations.E_Value, &annotations.FieldFlags{
+		Type: &annotations.FieldFlags_Message{Message: &annotations.MessageFlag{Nested: true}},
+	})
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+		TypeName: proto.String(typeName),
+		Options:  opts,
+	}
+}
+
+func TestGenerateFuncNameCollision(t *testing.T) {
+	overlayFile := filepath.Join(t.TempDir(), "overlay.yaml")
+	require.NoError(t, os.WriteFile(overlayFile, []byte(`
+fields:
+  a.Config.name:
+    string:
+      name: name
+      usage: Name
+  b.Config.name:
+    string:
+      name: name
+      usage: Name
+`), 0o600))
+	files := newFiles(t, []protoreflect.FileDescriptor{annotations.File_flags_annotations_proto},
+		configFile("a"),
+		configFile("b"),
+		&descriptorpb.FileDescriptorProto{
+			Name:       proto.String("app/app.proto"),
+			Package:    proto.String("app"),
+			Syntax:     proto.String("proto3"),
+			Dependency: []string{"flags/annotations.proto", "a/config.proto", "b/config.proto"},
+			Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/app")},
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("App"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					nestedField("a", 1, ".a.Config"),
+					nestedField("b", 2, ".b.Config"),
+				},
+			}},
+		},
+	)
+	_, d := runPlugin(t, "paths=source_relative,overlay="+overlayFile, files[2])
+	assert.True(t, d.Failed(), "generation succeeded")
+	output, err := io.ReadAll(d.Output())
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "the functions generated for a.Config and b.Config in package example.com/app have the same name AddConfigFlags")
+}
+
+func TestGenerateFieldMaskTarget(t *testing.T) {
+	opts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(opts, annotations.E_Value, &annotations.FieldFlags{
+		Type: &annotations.FieldFlags_FieldMask{FieldMask: &annotations.FieldMaskFlag{
+			Name:   "update-mask",
+			Usage:  "Config fields to update",
+			Target: "a.Config",
+		}},
+	})
+	files := newFiles(t, []protoreflect.FileDescriptor{annotations.File_flags_annotations_proto, fieldmaskpb.File_google_protobuf_field_mask_proto},
+		configFile("a"),
+		&descriptorpb.FileDescriptorProto{
+			Name:       proto.String("app/app.proto"),
+			Package:    proto.String("app"),
+			Syntax:     proto.String("proto3"),
+			Dependency: []string{"flags/annotations.proto", "google/protobuf/field_mask.proto", "a/config.proto"},
+			Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/app")},
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("App"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:     proto.String("update_mask"),
+					JsonName: proto.String("updateMask"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+					TypeName: proto.String(".google.protobuf.FieldMask"),
+					Options:  opts,
+				}},
+			}},
+		},
+	)
+	generated, d := runPlugin(t, "paths=source_relative", files[1])
+	require.NoError(t, d.Err())
+	require.False(t, d.Failed(), "generation failed")
+	src := generated["app/app.pb.flags.go"]
+	assertImportsUsed(t, "app/app.pb.flags.go", src)
+	assert.Contains(t, src, `"example.com/a"`)
+	assert.Contains(t, src, "types.FieldMask(x.UpdateMask, (*a.Config)(nil).ProtoReflect().Descriptor())")
+}
+
+func TestGenerateTimezoneData(t *testing.T) {
+	files, d := runPlugin(t, "paths=source_relative", tpb.File_tests_test_proto, typestest.File_tests_types_types_proto)
+	require.NoError(t, d.Err())
+	require.False(t, d.Failed(), "generation failed")
+	assert.Contains(t, files["tests/test.pb.flags.go"], `_ "time/tzdata"`, "file with a timezone option")
+	assert.NotContains(t, files["tests/types/types.pb.flags.go"], "time/tzdata", "file without a timezone option")
+}
+
+// stringField returns a string field with a string flag.
+func stringField(name string, number int32) *descriptorpb.FieldDescriptorProto {
+	opts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(opts, annotations.E_Value, &annotations.FieldFlags{
+		Type: &annotations.FieldFlags_String_{String_: &annotations.StringFlag{Name: name, Usage: name}},
+	})
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		Options:  opts,
+	}
+}
+
+func TestGenerateNestedCheck(t *testing.T) {
+	disabled := &descriptorpb.MessageOptions{}
+	proto.SetExtension(disabled, annotations.E_Disabled, true)
+	oneofField := stringField("name", 1)
+	oneofField.OneofIndex = proto.Int32(0)
+	files := newFiles(t, []protoreflect.FileDescriptor{annotations.File_flags_annotations_proto},
+		configFile("a"),
+		&descriptorpb.FileDescriptorProto{
+			Name:       proto.String("lib/lib.proto"),
+			Package:    proto.String("lib"),
+			Syntax:     proto.String("proto3"),
+			Dependency: []string{"flags/annotations.proto"},
+			Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/lib")},
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name:    proto.String("Disabled"),
+					Field:   []*descriptorpb.FieldDescriptorProto{stringField("name", 1)},
+					Options: disabled,
+				},
+				{
+					Name:      proto.String("Choice"),
+					Field:     []*descriptorpb.FieldDescriptorProto{oneofField},
+					OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("kind")}},
+				},
+				{
+					Name:  proto.String("Server"),
+					Field: []*descriptorpb.FieldDescriptorProto{stringField("name", 1)},
+				},
+			},
+		},
+		&descriptorpb.FileDescriptorProto{
+			Name:       proto.String("app/other.proto"),
+			Package:    proto.String("app"),
+			Syntax:     proto.String("proto3"),
+			Dependency: []string{"flags/annotations.proto"},
+			Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/app")},
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name:  proto.String("Other"),
+				Field: []*descriptorpb.FieldDescriptorProto{stringField("name", 1)},
+			}},
+		},
+	)
+	// app returns the target file, whose message App nests the message typeName.
+	app := func(typeName string) protoreflect.FileDescriptor {
+		return newFiles(t, files, &descriptorpb.FileDescriptorProto{
+			Name:       proto.String("app/app.proto"),
+			Package:    proto.String("app"),
+			Syntax:     proto.String("proto3"),
+			Dependency: []string{"flags/annotations.proto", "a/config.proto", "lib/lib.proto", "app/other.proto"},
+			Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/app")},
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name:  proto.String("App"),
+				Field: []*descriptorpb.FieldDescriptorProto{nestedField("inner", 1, typeName)},
+			}},
+		})[0]
+	}
+
+	tests := []struct {
+		name     string
+		params   string
+		typeName string
+		failed   bool
+		output   string
+	}{
+		{
+			name:     "disabled",
+			typeName: ".lib.Disabled",
+			failed:   true,
+			output:   "field 'inner' nests message 'lib.Disabled' which registers no flags: it is disabled by (flags.disabled)",
+		},
+		{
+			name:     "no annotations",
+			typeName: ".a.Config",
+			failed:   true,
+			output:   "field 'inner' nests message 'a.Config' which registers no flags: it has no flags annotations",
+		},
+		{
+			name:     "only oneof fields",
+			typeName: ".lib.Choice",
+			failed:   true,
+			output:   "field 'inner' nests message 'lib.Choice' which registers no flags: it has no flag fields outside oneofs, see (flags.allow_empty)",
+		},
+		{
+			name:     "file not passed to the plugin",
+			typeName: ".app.Other",
+			failed:   true,
+			output:   "field 'inner' nests message 'app.Other' which registers no flags: its file app/other.proto was not passed to the plugin",
+		},
+		{
+			name:     "warn",
+			params:   ",nested_check=warn",
+			typeName: ".a.Config",
+			output:   "warning: field 'inner' nests message 'a.Config' which registers no flags: it has no flags annotations",
+		},
+		{
+			name:     "invalid nested_check",
+			params:   ",nested_check=ignore",
+			typeName: ".lib.Server",
+			failed:   true,
+			output:   `invalid nested_check parameter "ignore", expected "error" or "warn"`,
+		},
+		{
+			name:     "generated message",
+			typeName: ".lib.Server",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, d := runPlugin(t, "paths=source_relative"+tt.params, app(tt.typeName))
+			assert.Equal(t, tt.failed, d.Failed(), "failed")
+			output, err := io.ReadAll(d.Output())
+			require.NoError(t, err)
+			if tt.output != "" {
+				assert.Contains(t, string(output), tt.output)
+			} else {
+				assert.NotContains(t, string(output), "registers no flags")
+			}
+		})
+	}
+}
+
+// inlineField returns a field of the message typeName with nested flags inlined into the parent.
+func inlineField(name string, number int32, typeName string) *descriptorpb.FieldDescriptorProto {
+	field := nestedField(name, number, typeName)
+	proto.SetExtension(field.Options, annotations.E_Value, &annotations.FieldFlags{
+		Type: &annotations.FieldFlags_Message{Message: &annotations.MessageFlag{Nested: true, Inline: true}},
+	})
+	return field
+}
+
+func TestGenerateInlineCollision(t *testing.T) {
+	message := func(name string, fields ...*descriptorpb.FieldDescriptorProto) *descriptorpb.DescriptorProto {
+		return &descriptorpb.DescriptorProto{Name: proto.String(name), Field: fields}
+	}
+	server := message("Server", stringField("name", 1))
+	tests := []struct {
+		name     string
+		messages []*descriptorpb.DescriptorProto
+		output   string
+	}{
+		{
+			name: "parent and inlined field",
+			messages: []*descriptorpb.DescriptorProto{
+				server,
+				message("App", stringField("name", 1), inlineField("server", 2, ".app.Server")),
+			},
+			output: "duplicate flag name 'name' found in message 'App': field 'name' and field 'server.name' both use this flag name",
+		},
+		{
+			name: "same message inlined twice",
+			messages: []*descriptorpb.DescriptorProto{
+				server,
+				message("App", inlineField("primary", 1, ".app.Server"), inlineField("backup", 2, ".app.Server")),
+			},
+			output: "duplicate flag name 'name' found in message 'App': field 'primary.name' and field 'backup.name' both use this flag name",
+		},
+		{
+			name: "intermediate inlined message",
+			messages: []*descriptorpb.DescriptorProto{
+				server,
+				message("Listener", inlineField("server", 1, ".app.Server")),
+				message("App", stringField("name", 1), inlineField("listener", 2, ".app.Listener")),
+			},
+			output: "duplicate flag name 'name' found in message 'App': field 'name' and field 'listener.server.name' both use this flag name",
+		},
+		{
+			name: "self inlining",
+			messages: []*descriptorpb.DescriptorProto{
+				message("Node", stringField("name", 1), inlineField("child", 2, ".app.Node")),
+			},
+			output: "field 'child' inlines message 'Node' into itself",
+		},
+		{
+			name: "distinct names",
+			messages: []*descriptorpb.DescriptorProto{
+				server,
+				message("App", stringField("id", 1), inlineField("server", 2, ".app.Server")),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := newFiles(t, []protoreflect.FileDescriptor{annotations.File_flags_annotations_proto},
+				&descriptorpb.FileDescriptorProto{
+					Name:        proto.String("app/app.proto"),
+					Package:     proto.String("app"),
+					Syntax:      proto.String("proto3"),
+					Dependency:  []string{"flags/annotations.proto"},
+					Options:     &descriptorpb.FileOptions{GoPackage: proto.String("example.com/app")},
+					MessageType: tt.messages,
+				},
+			)
+			generated, d := runPlugin(t, "paths=source_relative", files[0])
+			output, err := io.ReadAll(d.Output())
+			require.NoError(t, err)
+			if tt.output == "" {
+				require.False(t, d.Failed(), "generation failed: %s", output)
+				assertImportsUsed(t, "app/app.pb.flags.go", generated["app/app.pb.flags.go"])
+				return
+			}
+			assert.True(t, d.Failed(), "generation succeeded")
+			assert.Contains(t, string(output), tt.output)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package module
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	pgs "github.com/lyft/protoc-gen-star/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoimpl"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"gopkg.in/yaml.v3"
+)
+
+// overlayFile is the file given by the overlay parameter, in YAML or JSON. It annotates the
+// messages and fields of protos that cannot be edited, e.g. vendored or third-party protos, as if
+// their source held the options:
+//
+//	messages:
+//	  google.type.PostalAddress:
+//	    allow_empty: true
+//	fields:
+//	  google.type.PostalAddress.region_code:
+//	    string: { name: region, usage: "CLDR region code" }
+//
+// Fields are keyed by the fully qualified name of their message and their name, their value is the
+// protojson form of flags.FieldFlags.
+type overlayFile struct {
+	Messages map[string]overlayMessage `yaml:"messages"`
+	Fields   map[string]interface{}    `yaml:"fields"`
+}
+
+// overlayMessage holds the message-level options of a message annotated by the overlay file.
+type overlayMessage struct {
+	Disabled   *bool `yaml:"disabled"`
+	Unexported *bool `yaml:"unexported"`
+	AllowEmpty *bool `yaml:"allow_empty"`
+}
+
+// overlay is a parsed overlay file.
+type overlay struct {
+	messages map[string]overlayMessage
+	fields   map[string]*annotations.FieldFlags
+}
+
+// loadOverlay reads and parses the overlay file at path.
+func loadOverlay(path string) (*overlay, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file overlayFile
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	o := &overlay{
+		messages: make(map[string]overlayMessage, len(file.Messages)),
+		fields:   make(map[string]*annotations.FieldFlags, len(file.Fields)),
+	}
+	for name, opts := range file.Messages {
+		o.messages[strings.TrimPrefix(name, ".")] = opts
+	}
+	for name, value := range file.Fields {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: field %s: %w", path, name, err)
+		}
+		fd := new(annotations.FieldFlags)
+		if err := protojson.Unmarshal(raw, fd); err != nil {
+			return nil, fmt.Errorf("%s: field %s: %w", path, name, err)
+		}
+		o.fields[strings.TrimPrefix(name, ".")] = fd
+	}
+	return o, nil
+}
+
+// applyOverlay merges the overlay file into the options of the messages and fields of the targets
+// and the files they import, before they are checked and generated. Options cannot be given both
+// in the source and in the overlay file.
+func (m *Module) applyOverlay(targets map[string]pgs.File) {
+	used := make(map[string]struct{})
+	for _, f := range overlayFiles(targets) {
+		for _, msg := range f.AllMessages() {
+			name := strings.TrimPrefix(msg.FullyQualifiedName(), ".")
+			if opts, ok := m.overlay.messages[name]; ok {
+				used[name] = struct{}{}
+				m.overlaid[name] = struct{}{}
+				m.applyMessageOverlay(msg, opts)
+			}
+			for _, field := range msg.Fields() {
+				fieldName := name + "." + field.Name().String()
+				fd, ok := m.overlay.fields[fieldName]
+				if !ok {
+					continue
+				}
+				used[fieldName] = struct{}{}
+				m.overlaid[name] = struct{}{}
+				desc := field.Descriptor()
+				if desc.Options == nil {
+					desc.Options = &descriptorpb.FieldOptions{}
+				}
+				if proto.HasExtension(desc.Options, annotations.E_Value) {
+					m.Failf("field %s is annotated both in its source and in the overlay file", fieldName)
+				}
+				proto.SetExtension(desc.Options, annotations.E_Value, fd)
+			}
+		}
+	}
+
+	// Tools like buf run the plugin once per directory, entries are not all used by each run.
+	for name := range m.overlay.messages {
+		if _, ok := used[name]; !ok {
+			m.Debugf("overlay message %s not found in the targets or their imports", name)
+		}
+	}
+	for name := range m.overlay.fields {
+		if _, ok := used[name]; !ok {
+			m.Debugf("overlay field %s not found in the targets or their imports", name)
+		}
+	}
+}
+
+// applyMessageOverlay sets the message-level options of msg given by the overlay file.
+func (m *Module) applyMessageOverlay(msg pgs.Message, opts overlayMessage) {
+	desc := msg.Descriptor()
+	if desc.Options == nil {
+		desc.Options = &descriptorpb.MessageOptions{}
+	}
+	for _, opt := range []struct {
+		ext   *protoimpl.ExtensionInfo
+		value *bool
+	}{
+		{annotations.E_Disabled, opts.Disabled},
+		{annotations.E_Unexported, opts.Unexported},
+		{annotations.E_AllowEmpty, opts.AllowEmpty},
+	} {
+		if opt.value == nil {
+			continue
+		}
+		if proto.HasExtension(desc.Options, opt.ext) {
+			m.Failf("message %s has option %s both in its source and in the overlay file",
+				strings.TrimPrefix(msg.FullyQualifiedName(), "."), opt.ext.TypeDescriptor().FullName())
+		}
+		proto.SetExtension(desc.Options, opt.ext, *opt.value)
+	}
+}
+
+// overlayFiles returns the targets and the files they import, transitively, sorted by name.
+func overlayFiles(targets map[string]pgs.File) []pgs.File {
+	seen := make(map[string]pgs.File)
+	var visit func(f pgs.File)
+	visit = func(f pgs.File) {
+		if _, ok := seen[f.Name().String()]; ok {
+			return
+		}
+		seen[f.Name().String()] = f
+		for _, imp := range f.Imports() {
+			visit(imp)
+		}
+	}
+	for _, f := range targets {
+		visit(f)
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	files := make([]pgs.File, 0, len(names))
+	for _, name := range names {
+		files = append(files, seen[name])
+	}
+	return files
+}
+
+// isForeign reports whether msg is annotated by the overlay file outside the targets: it has no
+// generated methods, functions are generated for it in the packages nesting it instead.
+func (m *Module) isForeign(msg pgs.Message) bool {
+	_, ok := m.overlaid[strings.TrimPrefix(msg.FullyQualifiedName(), ".")]
+	return ok && !msg.File().BuildTarget()
+}
+
+// foreignMessages returns the foreign messages nested by the messages of f, directly or through
+// other foreign messages, whose functions are not generated in the package of f yet.
+func (m *Module) foreignMessages(f pgs.File) []pgs.Message {
+	var (
+		out   []pgs.Message
+		visit func(msg pgs.Message)
+	)
+	visit = func(msg pgs.Message) {
+		for _, field := range msg.Fields() {
+			var fd annotations.FieldFlags
+			if ok, err := field.Extension(annotations.E_Value, &fd); err != nil || !ok || fd.GetMessage() == nil {
+				continue
+			}
+			if !field.Type().IsEmbed() {
+				continue
+			}
+			emb := field.Type().Embed()
+			if !m.isForeign(emb) || !hasFlagMethods(emb) {
+				continue
+			}
+			key := m.genPkg + ":" + emb.FullyQualifiedName()
+			if _, ok := m.emitted[key]; ok {
+				continue
+			}
+			m.emitted[key] = struct{}{}
+			out = append(out, emb)
+			visit(emb)
+		}
+	}
+	for _, msg := range f.AllMessages() {
+		if hasFlagMethods(msg) {
+			visit(msg)
+		}
+	}
+	return out
+}
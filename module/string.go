@@ -0,0 +1,201 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/utils"
+	pgs "github.com/lyft/protoc-gen-star/v2"
+)
+
+// stringFlag is implemented by the string flags supporting validated values.
+type stringFlag interface {
+	GetFormat() annotations.StringFormatType
+	GetPath() *annotations.PathOptions
+	GetChoices() []string
+	GetCaseInsensitive() bool
+}
+
+// stringFormat describes a string format: the value type shown in help output, the Go expression
+// of its types.StringCheck and the same check used to validate defaults at generation time.
+type stringFormat struct {
+	typ       string
+	check     string
+	normalize func(string) (string, error)
+}
+
+var pathChecks = map[annotations.PathCheckType]string{
+	annotations.PathCheckType_PATH_CHECK_TYPE_MUST_EXIST:     "utils.PathMustExist",
+	annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_FILE:   "utils.PathMustBeFile",
+	annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_DIR:    "utils.PathMustBeDir",
+	annotations.PathCheckType_PATH_CHECK_TYPE_MUST_NOT_EXIST: "utils.PathMustNotExist",
+}
+
+var stringFormats = map[annotations.StringFormatType]stringFormat{
+	annotations.StringFormatType_STRING_FORMAT_TYPE_IP:        {"ip", "utils.NormalizeIP", utils.NormalizeIP},
+	annotations.StringFormatType_STRING_FORMAT_TYPE_IPV4:      {"ipv4", "utils.NormalizeIPv4", utils.NormalizeIPv4},
+	annotations.StringFormatType_STRING_FORMAT_TYPE_IPV6:      {"ipv6", "utils.NormalizeIPv6", utils.NormalizeIPv6},
+	annotations.StringFormatType_STRING_FORMAT_TYPE_CIDR:      {"cidr", "utils.NormalizeCIDR", utils.NormalizeCIDR},
+	annotations.StringFormatType_STRING_FORMAT_TYPE_HOST_PORT: {"hostPort", "utils.NormalizeHostPort", utils.NormalizeHostPort},
+	annotations.StringFormatType_STRING_FORMAT_TYPE_URL:       {"url", "utils.NormalizeURL", utils.NormalizeURL},
+	annotations.StringFormatType_STRING_FORMAT_TYPE_HOSTNAME:  {"hostname", "utils.NormalizeHostname", utils.NormalizeHostname},
+	annotations.StringFormatType_STRING_FORMAT_TYPE_EMAIL:     {"email", "utils.NormalizeEmail", utils.NormalizeEmail},
+}
+
+// stringChecks returns the value type and the Go expressions of the types.StringCheck functions
+// configured on a string flag. checks is empty for plain strings.
+func (m *Module) stringChecks(flag stringFlag) (typ string, checks []string) {
+	if format, ok := stringFormats[flag.GetFormat()]; ok {
+		typ = format.typ
+		checks = append(checks, format.check)
+	}
+	if path := flag.GetPath(); path != nil {
+		switch path.GetCheck() {
+		case annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_FILE:
+			typ = "file"
+		case annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_DIR:
+			typ = "dir"
+		default:
+			typ = "path"
+		}
+		checks = append(checks, pathLiteral(path)+".Normalize")
+	}
+	if len(flag.GetChoices()) > 0 {
+		checks = append(checks, choicesLiteral(flag)+".Normalize")
+	}
+	return typ, checks
+}
+
+// choicesLiteral returns the Go expression of the utils.Choices configured on a string flag.
+func choicesLiteral(flag stringFlag) string {
+	if flag.GetCaseInsensitive() {
+		return fmt.Sprintf("utils.Choices{Values: %s, CaseInsensitive: true}", stringsLiteral(flag.GetChoices()))
+	}
+	return fmt.Sprintf("utils.Choices{Values: %s}", stringsLiteral(flag.GetChoices()))
+}
+
+// stringsLiteral returns the Go expression of a []string holding values.
+func stringsLiteral(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+// pathLiteral returns the Go expression of the utils.Path configured by path.
+func pathLiteral(path *annotations.PathOptions) string {
+	var fields []string
+	if path.GetAbsolute() {
+		fields = append(fields, "Absolute: true")
+	}
+	if path.GetBase() != "" {
+		fields = append(fields, fmt.Sprintf("Base: %q", path.GetBase()))
+	}
+	if check, ok := pathChecks[path.GetCheck()]; ok {
+		fields = append(fields, "Check: "+check)
+	}
+	return "utils.Path{" + strings.Join(fields, ", ") + "}"
+}
+
+// normalizeString applies the checks configured on a string flag to a default value at generation
+// time and returns the canonical value stored by the generated code.
+func (m *Module) normalizeString(flag stringFlag, value string) (string, error) {
+	if format, ok := stringFormats[flag.GetFormat()]; ok {
+		var err error
+		if value, err = format.normalize(value); err != nil {
+			return "", err
+		}
+	}
+	if len(flag.GetChoices()) > 0 {
+		return utils.Choices{Values: flag.GetChoices(), CaseInsensitive: flag.GetCaseInsensitive()}.Normalize(value)
+	}
+	return value, nil
+}
+
+// checkString validates the options of a string flag and its default values against its checks.
+func (m *Module) checkString(flag stringFlag, defaults ...string) {
+	if flag.GetPath() != nil && flag.GetFormat() != annotations.StringFormatType_STRING_FORMAT_TYPE_UNSPECIFIED {
+		m.Failf("format and path are mutually exclusive")
+	}
+	if flag.GetPath() != nil && len(flag.GetChoices()) > 0 {
+		m.Failf("choices and path are mutually exclusive")
+	}
+	if flag.GetCaseInsensitive() && len(flag.GetChoices()) == 0 {
+		m.Failf("case_insensitive requires choices to be set")
+	}
+	seen := make(map[string]bool, len(flag.GetChoices()))
+	for _, choice := range flag.GetChoices() {
+		key := choice
+		if flag.GetCaseInsensitive() {
+			key = strings.ToLower(choice)
+		}
+		if seen[key] {
+			m.Failf("duplicate choice %q", choice)
+		}
+		seen[key] = true
+	}
+	for _, def := range defaults {
+		if _, err := m.normalizeString(flag, def); err != nil {
+			m.Failf("invalid default value: %v", err)
+		}
+	}
+}
+
+// stringDefaults returns the Go expressions of the default values of a string flag: the quoted
+// canonical value, or a call resolving a path at run time.
+func (m *Module) stringDefaults(flag stringFlag, defaults []string) []string {
+	res := make([]string, 0, len(defaults))
+	for _, def := range defaults {
+		v, err := m.normalizeString(flag, def)
+		if err != nil {
+			m.Failf("invalid default value: %v", err)
+		}
+		if path := flag.GetPath(); path != nil {
+			res = append(res, fmt.Sprintf("%s.Default(%q)", pathLiteral(path), v))
+		} else {
+			res = append(res, fmt.Sprintf("%q", v))
+		}
+	}
+	return res
+}
+
+// genCompletion generates the shell completion annotations of a path or choices flag.
+func (m *Module) genCompletion(flag stringFlag, flagName string) string {
+	if len(flag.GetChoices()) > 0 {
+		return fmt.Sprintf(`
+				_ = fs.SetAnnotation(builder.Build(%q), flags.AnnotationChoices, %s)
+			`, flagName, stringsLiteral(flag.GetChoices()))
+	}
+	path := flag.GetPath()
+	if path == nil {
+		return ""
+	}
+	if path.GetCheck() == annotations.PathCheckType_PATH_CHECK_TYPE_MUST_BE_DIR {
+		return fmt.Sprintf(`
+				_ = fs.SetAnnotation(builder.Build(%q), flags.AnnotationSubdirsInDir, []string{})
+			`, flagName)
+	}
+	exts := make([]string, 0, len(path.GetExtensions()))
+	for _, ext := range path.GetExtensions() {
+		exts = append(exts, strings.TrimPrefix(ext, "."))
+	}
+	return fmt.Sprintf(`
+				_ = fs.SetAnnotation(builder.Build(%q), flags.AnnotationFilenameExt, %s)
+			`, flagName, stringsLiteral(exts))
+}
+
+// genCheckedString generates the flag binding code for a string field with checks.
+func (m *Module) genCheckedString(f pgs.Field, name pgs.Name, flag commonFlag, sf stringFlag, typ string, checks []string, wk pgs.WellKnownType, flagName string) string {
+	var declBuilder = &strings.Builder{}
+	target := m.genScalarTarget(declBuilder, f, name, wk)
+	_, _ = fmt.Fprintf(declBuilder, `
+				fs.VarP(types.CheckedString(%s, %q, %s), builder.Build(%q), %q, %q)
+			`,
+		target, typ, strings.Join(checks, ", "), flagName, flag.GetShort(), m.flagUsage(flag))
+	_, _ = declBuilder.WriteString(m.genCompletion(sf, flagName))
+	_, _ = declBuilder.WriteString(m.genFileRef(flag, flagName))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flagName))
+	return declBuilder.String()
+}
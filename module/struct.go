@@ -0,0 +1,112 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	pgs "github.com/lyft/protoc-gen-star/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// structTypes maps the well-known types supported by StructFlag to their types constructor.
+var structTypes = map[pgs.WellKnownType]string{
+	pgs.StructWKT:    "Struct",
+	pgs.ValueWKT:     "Value",
+	pgs.ListValueWKT: "List",
+}
+
+// newStructMessage returns an empty message of the well-known type wk, used to validate defaults.
+func newStructMessage(wk pgs.WellKnownType) proto.Message {
+	switch wk {
+	case pgs.StructWKT:
+		return &structpb.Struct{}
+	case pgs.ListValueWKT:
+		return &structpb.ListValue{}
+	}
+	return &structpb.Value{}
+}
+
+func (m *Module) checkStruct(typ FieldType, flag *annotations.StructFlag) {
+	if flag == nil {
+		return
+	}
+
+	emb := typ.Embed()
+	if emb == nil || !emb.IsWellKnown() {
+		m.Failf("struct flag should be used for google.protobuf.Struct, Value or ListValue fields")
+		return
+	}
+	if _, ok := structTypes[emb.WellKnownType()]; !ok {
+		m.Failf("struct flag should be used for google.protobuf.Struct, Value or ListValue fields, but got %s", emb.WellKnownType())
+	}
+	if typ, ok := typ.(Repeatable); ok {
+		m.Assert(!typ.IsRepeated(), "struct flag does not support repeated fields")
+	}
+
+	if flag.GetUsage() == "" {
+		m.Failf("usage is required for struct flag")
+	}
+	if flag.GetDeprecated() && flag.GetDeprecatedUsage() == "" {
+		m.Failf("deprecated struct flag must provide deprecated_usage message")
+	}
+
+	if flag.Default != nil {
+		if err := protojson.Unmarshal([]byte(flag.GetDefault()), newStructMessage(emb.WellKnownType())); err != nil {
+			m.Failf("struct default value %q is not valid JSON for %s: %v", flag.GetDefault(), emb.WellKnownType(), err)
+		}
+	}
+}
+
+// genStruct generates the flag binding code for a google.protobuf.Struct, Value or ListValue
+// field. Struct and Value flags are annotated with flags.AnnotationDotted to accept dotted
+// overrides.
+func (m *Module) genStruct(f pgs.Field, name pgs.Name, flag *annotations.StructFlag, wk pgs.WellKnownType) string {
+	var declBuilder = &strings.Builder{}
+
+	if flag.GetDisabled() {
+		return fmt.Sprintf("// %s: flags disabled by disabled=true\n", name)
+	}
+
+	if flag.GetName() == "" {
+		flag.Name = strings.ToLower(name.String())
+	}
+
+	_, _ = fmt.Fprintf(declBuilder, `
+			if x.%s  == nil {
+				x.%s = new(%s)
+			}
+		`,
+		name, name, m.getFieldTypeName(f),
+	)
+
+	_, _ = fmt.Fprintf(declBuilder, `
+			fs.VarP(types.%s(x.%s), builder.Build(%q), %q, %q)
+		`,
+		structTypes[wk], name, flag.GetName(), flag.GetShort(), flag.GetUsage(),
+	)
+
+	if wk != pgs.ListValueWKT {
+		_, _ = fmt.Fprintf(declBuilder, `
+			_ = fs.SetAnnotation(builder.Build(%q), flags.AnnotationDotted, []string{"true"})
+		`,
+			flag.GetName(),
+		)
+	}
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
+	return declBuilder.String()
+}
+
+// genStructDefaults generates the default value assignment code for a google.protobuf.Struct,
+// Value or ListValue field, decoding the JSON default at run time.
+func (m *Module) genStructDefaults(f pgs.Field, name pgs.Name, flag *annotations.StructFlag) string {
+	if flag.GetDisabled() || flag.Default == nil {
+		return ""
+	}
+	return fmt.Sprintf(`
+		if x.%s == nil {
+			x.%s = utils.MustUnmarshalJSON(%q, new(%s))
+		}`, name, name, flag.GetDefault(), m.getFieldTypeName(f))
+}
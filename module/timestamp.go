@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
+	_ "time/tzdata" // validate the timezone option independently of the host zoneinfo
 
-	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
 	"github.com/kunstack/protoc-gen-flags/utils"
 	pgs "github.com/lyft/protoc-gen-star/v2"
 	"github.com/samber/lo"
@@ -21,20 +21,70 @@ func isNowStr(s *string) bool {
 	return nowStr == "now" || nowStr == "now()"
 }
 
-func (m *Module) parseTimestamp(t string, layouts []string) (*timestamppb.Timestamp, error) {
-	for _, format := range layouts {
-		value, err := time.Parse(utils.ParseTimeFormat(format), t)
-		if err == nil {
-			return &timestamppb.Timestamp{
-				Seconds: value.Unix(),
-				Nanos:   int32(value.Nanosecond()),
-			}, nil
-		}
+// parseTimestamp parses a default value with the layouts in the given time zone. Relative
+// expressions are evaluated at generation time, callers emit them as runtime expressions.
+func (m *Module) parseTimestamp(t string, layouts []string, timezone string) (*timestamppb.Timestamp, error) {
+	loc, err := utils.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	value, err := utils.ParseTime(t, layouts, loc)
+	if err != nil && utils.IsRelativeTime(t) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("timestamp '%s' could not be parsed with any of the provided formats: %v", t, layouts)
 	}
-	return nil, fmt.Errorf("timestamp '%s' could not be parsed with any of the provided formats: %v", t, layouts)
+	return timestamppb.New(value), nil
+}
+
+// checkTimezone validates the timezone option of a timestamp flag. The generated file embeds the
+// time zone database when the zone is loaded at run time, so that it does not depend on the
+// zoneinfo of the host.
+func (m *Module) checkTimezone(timezone string) {
+	if _, err := utils.LoadLocation(timezone); err != nil {
+		m.Failf("timestamp timezone '%s' is invalid: %v", timezone, err)
+	}
+	if timezone != "" && timezone != "UTC" && timezone != "Local" {
+		m.addBlankImport("time/tzdata")
+	}
+}
+
+// genTimestampValue returns the expression of a timestamp default value. "now" and the other
+// relative expressions are evaluated when SetDefaults runs.
+func (m *Module) genTimestampValue(value string, layouts []string, timezone string) string {
+	if isNowStr(&value) {
+		return "timestamppb.Now()"
+	}
+	if utils.IsRelativeTime(value) {
+		return fmt.Sprintf("timestamppb.New(utils.MustParseTime(%q, nil, %q))", value, timezone)
+	}
+	t, err := m.parseTimestamp(value, layouts, timezone)
+	if err != nil {
+		m.Failf("timestamp default value '%s' is invalid: %v", value, err)
+		return ""
+	}
+	return fmt.Sprintf("&timestamppb.Timestamp{Seconds: %d, Nanos: %d}", t.Seconds, t.Nanos)
+}
+
+// genTimestampFormats returns the []string literal of the formats of a timestamp flag.
+func (m *Module) genTimestampFormats(formats []string) string {
+	return fmt.Sprint(
+		`[]string{`,
+		strings.Join(
+			lo.Map(
+				formats,
+				func(item string, _ int) string {
+					return strconv.Quote(item)
+				},
+			),
+			",",
+		),
+		`}`,
+	)
 }
 
-func (m *Module) checkTimestamp(ft pgs.FieldType, r *flags.TimestampFlag) {
+func (m *Module) checkTimestamp(ft pgs.FieldType, r *annotations.TimestampFlag) {
 	m.checkCommon(ft, r, pgs.MessageT, pgs.TimestampWKT, false)
 	if len(r.Formats) == 0 {
 		m.Failf("at least one format must be specified for timestamp flag")
@@ -51,15 +101,16 @@ func (m *Module) checkTimestamp(ft pgs.FieldType, r *flags.TimestampFlag) {
 		}
 		formats[format] = struct{}{}
 	}
+	m.checkTimezone(r.GetTimezone())
 	if r.Default != nil && *r.Default != "" && !isNowStr(r.Default) {
-		_, err := m.parseTimestamp(*r.Default, r.Formats)
+		_, err := m.parseTimestamp(*r.Default, r.Formats, r.GetTimezone())
 		if err != nil {
 			m.Failf("timestamp default value '%s' is invalid: %v", *r.Default, err)
 		}
 	}
 }
 
-func (m *Module) checkTimestampSlice(ft FieldType, r *flags.RepeatedTimestampFlag) {
+func (m *Module) checkTimestampSlice(ft FieldType, r *annotations.RepeatedTimestampFlag) {
 	m.checkCommon(ft, r, pgs.MessageT, pgs.TimestampWKT, true)
 	if len(r.Formats) == 0 {
 		m.Failf("at least one format must be specified for timestamp flag")
@@ -77,6 +128,7 @@ func (m *Module) checkTimestampSlice(ft FieldType, r *flags.RepeatedTimestampFla
 		formats[format] = struct{}{}
 	}
 
+	m.checkTimezone(r.GetTimezone())
 	for i, item := range r.Default {
 		if item == "" {
 			m.Failf("timestamp default value at index %d is empty", i)
@@ -84,48 +136,34 @@ func (m *Module) checkTimestampSlice(ft FieldType, r *flags.RepeatedTimestampFla
 		if isNowStr(&item) {
 			continue
 		}
-		_, err := m.parseTimestamp(item, r.Formats)
+		_, err := m.parseTimestamp(item, r.Formats, r.GetTimezone())
 		if err != nil {
 			m.Failf("timestamp default value '%s' at index %d  is invalid: %v", item, i, err)
 		}
 	}
 }
 
-func (m *Module) genTimestampDefaults(f pgs.Field, name pgs.Name, flag *flags.TimestampFlag) string {
-	var (
-		declBuilder = &strings.Builder{}
-		timeBuilder = &strings.Builder{}
-	)
+func (m *Module) genTimestampDefaults(f pgs.Field, name pgs.Name, flag *annotations.TimestampFlag) string {
+	var declBuilder = &strings.Builder{}
 	if flag.GetDisabled() {
 		return fmt.Sprint("\n// ", name, ": flags disabled by disabled=true\n")
 	}
 	if flag.Default == nil || *flag.Default == "" {
 		return ""
 	}
-	if isNowStr(flag.Default) {
-		_, _ = fmt.Fprintf(timeBuilder, "timestamppb.Now()")
-	} else {
-		t, err := m.parseTimestamp(*flag.Default, flag.GetFormats())
-		if err != nil {
-			m.Failf("timestamp default value '%s' is invalid: %v", *flag.Default, err)
-		}
-		_, _ = fmt.Fprintf(timeBuilder, "&timestamppb.Timestamp{Seconds: %d, Nanos: %d}", t.Seconds, t.Nanos)
-	}
 
 	_, _ = fmt.Fprintf(declBuilder, `
 			if x.%s  == nil {
 				x.%s = %s
 			}
 		`,
-		name, name, timeBuilder.String(),
+		name, name, m.genTimestampValue(*flag.Default, flag.GetFormats(), flag.GetTimezone()),
 	)
-
-	_, _ = declBuilder.WriteString(m.genMark(flag))
 	return declBuilder.String()
 }
 
 // genTimestampSliceDefaults generates default value assignment code for repeated timestamp fields
-func (m *Module) genTimestampSliceDefaults(f pgs.Field, name pgs.Name, flag *flags.RepeatedTimestampFlag) string {
+func (m *Module) genTimestampSliceDefaults(f pgs.Field, name pgs.Name, flag *annotations.RepeatedTimestampFlag) string {
 	if flag.Default == nil || len(flag.GetDefault()) == 0 {
 		return ""
 	}
@@ -135,15 +173,7 @@ func (m *Module) genTimestampSliceDefaults(f pgs.Field, name pgs.Name, flag *fla
 	defaultValues := make([]string, len(flag.GetDefault()))
 
 	for i, defaultValue := range flag.Default {
-		if isNowStr(&defaultValue) {
-			defaultValues[i] = "timestamppb.Now()"
-		} else {
-			t, err := m.parseTimestamp(defaultValue, flag.GetFormats())
-			if err != nil {
-				m.Failf("timestamp default value '%s' is invalid: %v", defaultValue, err)
-			}
-			defaultValues[i] = fmt.Sprintf("&timestamppb.Timestamp{Seconds: %d, Nanos: %d}", t.Seconds, t.Nanos)
-		}
+		defaultValues[i] = m.genTimestampValue(defaultValue, flag.GetFormats(), flag.GetTimezone())
 	}
 
 	_, _ = fmt.Fprintf(&code, `
@@ -154,30 +184,14 @@ func (m *Module) genTimestampSliceDefaults(f pgs.Field, name pgs.Name, flag *fla
 	return code.String()
 }
 
-func (m *Module) genTimestamp(f pgs.Field, name pgs.Name, flag *flags.TimestampFlag) string {
-	var (
-		declBuilder    = &strings.Builder{}
-		formatsBuilder = &strings.Builder{}
-	)
+func (m *Module) genTimestamp(f pgs.Field, name pgs.Name, flag *annotations.TimestampFlag) string {
+	var declBuilder = &strings.Builder{}
 	if flag.GetDisabled() {
 		return fmt.Sprint("\n// ", name, ": flags disabled by disabled=true\n")
 	}
 	if flag.GetName() == "" {
 		flag.Name = strings.ToLower(name.String())
 	}
-	_, _ = fmt.Fprint(formatsBuilder,
-		`[]string{`,
-		strings.Join(
-			lo.Map(
-				flag.GetFormats(),
-				func(item string, _ int) string {
-					return strconv.Quote(item)
-				},
-			),
-			",",
-		),
-		`}`,
-	)
 
 	_, _ = fmt.Fprintf(declBuilder, `
 			if x.%s  == nil {
@@ -187,47 +201,47 @@ func (m *Module) genTimestamp(f pgs.Field, name pgs.Name, flag *flags.TimestampF
 		name, name, m.getFieldTypeName(f),
 	)
 
-	_, _ = fmt.Fprintf(declBuilder, `
+	if flag.GetTimezone() != "" {
+		_, _ = fmt.Fprintf(declBuilder, `
+		fs.VarP(types.TimestampIn(x.%s, %s, %q), builder.Build(%q), %q, %q)
+	`,
+			name, m.genTimestampFormats(flag.GetFormats()), flag.GetTimezone(), flag.GetName(), flag.GetShort(), flag.GetUsage(),
+		)
+	} else {
+		_, _ = fmt.Fprintf(declBuilder, `
 		fs.VarP(types.Timestamp(x.%s, %s), builder.Build(%q), %q, %q)
 	`,
-		name, formatsBuilder.String(), flag.GetName(), flag.GetShort(), flag.GetUsage(),
-	)
+			name, m.genTimestampFormats(flag.GetFormats()), flag.GetName(), flag.GetShort(), flag.GetUsage(),
+		)
+	}
 
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
 	return declBuilder.String()
 }
 
-func (m *Module) genTimestampSlice(f pgs.Field, name pgs.Name, flag *flags.RepeatedTimestampFlag) string {
-	var (
-		declBuilder    = &strings.Builder{}
-		formatsBuilder = &strings.Builder{}
-	)
+func (m *Module) genTimestampSlice(f pgs.Field, name pgs.Name, flag *annotations.RepeatedTimestampFlag) string {
+	var declBuilder = &strings.Builder{}
 	if flag.GetDisabled() {
 		return fmt.Sprint("\n// ", name, ": flags disabled by disabled=true\n")
 	}
 	if flag.GetName() == "" {
 		flag.Name = strings.ToLower(name.String())
 	}
-	_, _ = fmt.Fprint(formatsBuilder,
-		`[]string{`,
-		strings.Join(
-			lo.Map(
-				flag.GetFormats(),
-				func(item string, _ int) string {
-					return strconv.Quote(item)
-				},
-			),
-			",",
-		),
-		`}`,
-	)
 
-	_, _ = fmt.Fprintf(declBuilder, `
-		fs.VarP(types.TimestampSlice(&x.%s, %s), builder.Build(%q), %q, %q)
+	if flag.GetTimezone() != "" {
+		_, _ = fmt.Fprintf(declBuilder, `
+		fs.VarP(types.TimestampSliceIn(&x.%s, %s, %q%s), builder.Build(%q), %q, %q)
 	`,
-		name, formatsBuilder.String(), flag.GetName(), flag.GetShort(), flag.GetUsage(),
-	)
+			name, m.genTimestampFormats(flag.GetFormats()), flag.GetTimezone(), m.genSliceOptions(flag), flag.GetName(), flag.GetShort(), flag.GetUsage(),
+		)
+	} else {
+		_, _ = fmt.Fprintf(declBuilder, `
+		fs.VarP(types.TimestampSlice(&x.%s, %s%s), builder.Build(%q), %q, %q)
+	`,
+			name, m.genTimestampFormats(flag.GetFormats()), m.genSliceOptions(flag), flag.GetName(), flag.GetShort(), flag.GetUsage(),
+		)
+	}
 
-	_, _ = declBuilder.WriteString(m.genMark(flag))
+	_, _ = declBuilder.WriteString(m.genMark(flag, flag.GetName()))
 	return declBuilder.String()
 }
@@ -0,0 +1,133 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kunstack/protoc-gen-flags/flags/annotations"
+	"github.com/kunstack/protoc-gen-flags/utils"
+	pgs "github.com/lyft/protoc-gen-star/v2"
+)
+
+// unitFlag is implemented by the scalar numeric flags supporting unit suffixes.
+type unitFlag interface {
+	GetUnit() annotations.UnitType
+	GetUnitDefault() string
+}
+
+// unitNumber is the set of Go types of the numeric fields supporting unit suffixes.
+type unitNumber interface {
+	int32 | int64 | uint32 | uint64 | float32 | float64
+}
+
+var unitNames = map[annotations.UnitType]string{
+	annotations.UnitType_UNIT_TYPE_BYTES:   "UnitBytes",
+	annotations.UnitType_UNIT_TYPE_SI:      "UnitSI",
+	annotations.UnitType_UNIT_TYPE_PERCENT: "UnitPercent",
+}
+
+func unitOf(t annotations.UnitType) utils.Unit {
+	switch t {
+	case annotations.UnitType_UNIT_TYPE_BYTES:
+		return utils.UnitBytes
+	case annotations.UnitType_UNIT_TYPE_SI:
+		return utils.UnitSI
+	case annotations.UnitType_UNIT_TYPE_PERCENT:
+		return utils.UnitPercent
+	}
+	return utils.UnitNone
+}
+
+// checkUnit validates the unit settings of a numeric flag against its default value.
+func checkUnit[T unitNumber](m *Module, flag unitFlag, def *T) {
+	if flag.GetUnit() == annotations.UnitType_UNIT_TYPE_UNSPECIFIED {
+		if flag.GetUnitDefault() != "" {
+			m.Failf("unit_default requires unit to be set")
+		}
+		return
+	}
+	if def != nil && flag.GetUnitDefault() != "" {
+		m.Failf("default and unit_default are mutually exclusive")
+	}
+	var zero T
+	switch any(zero).(type) {
+	case float32, float64:
+	default:
+		if flag.GetUnit() == annotations.UnitType_UNIT_TYPE_PERCENT {
+			m.Failf("UNIT_TYPE_PERCENT is only allowed on float and double fields")
+		}
+	}
+	if def != nil && *def < 0 && flag.GetUnit() == annotations.UnitType_UNIT_TYPE_BYTES {
+		m.Failf("default of a UNIT_TYPE_BYTES flag must not be negative")
+	}
+	unitDefault(m, flag, def)
+}
+
+// unitDefault returns the default value of a numeric flag, parsing unit_default when set.
+func unitDefault[T unitNumber](m *Module, flag unitFlag, def *T) *T {
+	s := flag.GetUnitDefault()
+	if s == "" {
+		return def
+	}
+	var (
+		v    T
+		err  error
+		unit = unitOf(flag.GetUnit())
+	)
+	switch p := any(&v).(type) {
+	case *int32:
+		var n int64
+		n, err = utils.ParseUnitInt(s, unit, 32)
+		*p = int32(n)
+	case *int64:
+		*p, err = utils.ParseUnitInt(s, unit, 64)
+	case *uint32:
+		var n uint64
+		n, err = utils.ParseUnitUint(s, unit, 32)
+		*p = uint32(n)
+	case *uint64:
+		*p, err = utils.ParseUnitUint(s, unit, 64)
+	case *float32:
+		var f float64
+		f, err = utils.ParseUnitFloat(s, unit, 32)
+		*p = float32(f)
+	case *float64:
+		*p, err = utils.ParseUnitFloat(s, unit, 64)
+	}
+	if err != nil {
+		m.Failf("invalid unit_default: %v", err)
+		return nil
+	}
+	return &v
+}
+
+// genUnit generates the flag binding code for a numeric field with unit suffixes.
+func (m *Module) genUnit(f pgs.Field, name pgs.Name, flag commonFlag, unit annotations.UnitType, wk pgs.WellKnownType, flagName string) string {
+	var declBuilder = &strings.Builder{}
+	target := m.genScalarTarget(declBuilder, f, name, wk)
+	_, _ = fmt.Fprintf(declBuilder, `
+				fs.VarP(types.NumberWithUnit(%s, types.%s), builder.Build(%q), %q, %q)
+			`,
+		target, unitNames[unit], flagName, flag.GetShort(), flag.GetUsage())
+	_, _ = declBuilder.WriteString(m.genMark(flag, flagName))
+	return declBuilder.String()
+}
+
+// genScalarTarget returns the expression of a pointer to the scalar value of a native, optional or
+// wrapper field, writing the allocation of optional and wrapper fields to declBuilder.
+func (m *Module) genScalarTarget(declBuilder *strings.Builder, f pgs.Field, name pgs.Name, wk pgs.WellKnownType) string {
+	isWrapper := wk != "" && wk != pgs.UnknownWKT
+	if !isWrapper && !f.HasOptionalKeyword() {
+		return fmt.Sprint("&x.", name)
+	}
+	_, _ = fmt.Fprintf(declBuilder, `
+				if x.%s == nil {
+					x.%s = new(%s)
+				}`,
+		name, name, m.getFieldTypeName(f),
+	)
+	if isWrapper {
+		return fmt.Sprint("&x.", name, ".Value")
+	}
+	return fmt.Sprint("x.", name)
+}
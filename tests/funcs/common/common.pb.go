@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: tests/funcs/common/common.proto
+
+// buf:lint:ignore PACKAGE_VERSION_SUFFIX
+
+package common
+
+import (
+	_ "github.com/kunstack/protoc-gen-flags/flags/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Logging_Level int32
+
+const (
+	Logging_LEVEL_UNSPECIFIED Logging_Level = 0
+	Logging_LEVEL_DEBUG       Logging_Level = 1
+	Logging_LEVEL_INFO        Logging_Level = 2
+)
+
+// Enum value maps for Logging_Level.
+var (
+	Logging_Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "LEVEL_DEBUG",
+		2: "LEVEL_INFO",
+	}
+	Logging_Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"LEVEL_DEBUG":       1,
+		"LEVEL_INFO":        2,
+	}
+)
+
+func (x Logging_Level) Enum() *Logging_Level {
+	p := new(Logging_Level)
+	*p = x
+	return p
+}
+
+func (x Logging_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Logging_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_funcs_common_common_proto_enumTypes[0].Descriptor()
+}
+
+func (Logging_Level) Type() protoreflect.EnumType {
+	return &file_tests_funcs_common_common_proto_enumTypes[0]
+}
+
+func (x Logging_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Logging_Level.Descriptor instead.
+func (Logging_Level) EnumDescriptor() ([]byte, []int) {
+	return file_tests_funcs_common_common_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Logging is nested by the messages of another package
+type Logging struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level Logging_Level `protobuf:"varint,1,opt,name=level,proto3,enum=tests.funcs.common.Logging_Level" json:"level,omitempty"`
+	File  string        `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+}
+
+func (x *Logging) Reset() {
+	*x = Logging{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_funcs_common_common_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Logging) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Logging) ProtoMessage() {}
+
+func (x *Logging) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_funcs_common_common_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Logging.ProtoReflect.Descriptor instead.
+func (*Logging) Descriptor() ([]byte, []int) {
+	return file_tests_funcs_common_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Logging) GetLevel() Logging_Level {
+	if x != nil {
+		return x.Level
+	}
+	return Logging_LEVEL_UNSPECIFIED
+}
+
+func (x *Logging) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+var File_tests_funcs_common_common_proto protoreflect.FileDescriptor
+
+var file_tests_funcs_common_common_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x1a, 0x17, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xca,
+	0x01, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x53, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x1a, 0x9a, 0x49,
+	0x17, 0x82, 0x01, 0x14, 0x12, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x09, 0x4c, 0x6f, 0x67,
+	0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x40, 0x02, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x29, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x9a,
+	0x49, 0x12, 0x72, 0x10, 0x12, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x08, 0x4c, 0x6f, 0x67, 0x20,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x3f, 0x0a, 0x05, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4c,
+	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x42, 0x39, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6e, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_funcs_common_common_proto_rawDescOnce sync.Once
+	file_tests_funcs_common_common_proto_rawDescData = file_tests_funcs_common_common_proto_rawDesc
+)
+
+func file_tests_funcs_common_common_proto_rawDescGZIP() []byte {
+	file_tests_funcs_common_common_proto_rawDescOnce.Do(func() {
+		file_tests_funcs_common_common_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_funcs_common_common_proto_rawDescData)
+	})
+	return file_tests_funcs_common_common_proto_rawDescData
+}
+
+var file_tests_funcs_common_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_funcs_common_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_funcs_common_common_proto_goTypes = []interface{}{
+	(Logging_Level)(0), // 0: tests.funcs.common.Logging.Level
+	(*Logging)(nil),    // 1: tests.funcs.common.Logging
+}
+var file_tests_funcs_common_common_proto_depIdxs = []int32{
+	0, // 0: tests.funcs.common.Logging.level:type_name -> tests.funcs.common.Logging.Level
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_tests_funcs_common_common_proto_init() }
+func file_tests_funcs_common_common_proto_init() {
+	if File_tests_funcs_common_common_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_funcs_common_common_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Logging); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_funcs_common_common_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_funcs_common_common_proto_goTypes,
+		DependencyIndexes: file_tests_funcs_common_common_proto_depIdxs,
+		EnumInfos:         file_tests_funcs_common_common_proto_enumTypes,
+		MessageInfos:      file_tests_funcs_common_common_proto_msgTypes,
+	}.Build()
+	File_tests_funcs_common_common_proto = out.File
+	file_tests_funcs_common_common_proto_rawDesc = nil
+	file_tests_funcs_common_common_proto_goTypes = nil
+	file_tests_funcs_common_common_proto_depIdxs = nil
+}
@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: tests/funcs/config.proto
+
+// buf:lint:ignore PACKAGE_VERSION_SUFFIX
+
+package funcs
+
+import (
+	_ "github.com/kunstack/protoc-gen-flags/flags/annotations"
+	common "github.com/kunstack/protoc-gen-flags/tests/funcs/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Server_Mode int32
+
+const (
+	Server_MODE_UNSPECIFIED Server_Mode = 0
+	Server_MODE_HTTP        Server_Mode = 1
+	Server_MODE_GRPC        Server_Mode = 2
+)
+
+// Enum value maps for Server_Mode.
+var (
+	Server_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_HTTP",
+		2: "MODE_GRPC",
+	}
+	Server_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"MODE_HTTP":        1,
+		"MODE_GRPC":        2,
+	}
+)
+
+func (x Server_Mode) Enum() *Server_Mode {
+	p := new(Server_Mode)
+	*p = x
+	return p
+}
+
+func (x Server_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Server_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_funcs_config_proto_enumTypes[0].Descriptor()
+}
+
+func (Server_Mode) Type() protoreflect.EnumType {
+	return &file_tests_funcs_config_proto_enumTypes[0]
+}
+
+func (x Server_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Server_Mode.Descriptor instead.
+func (Server_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_tests_funcs_config_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// Config is generated as functions of the configflags package
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Test a nested message of the same package
+	Server *Server `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	// Test a nested message of another package
+	Logging *common.Logging `protobuf:"bytes,3,opt,name=logging,proto3" json:"logging,omitempty"`
+	// Test an unexported message behind a presence flag
+	Limits *Limits `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_funcs_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_funcs_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_tests_funcs_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *Config) GetLogging() *common.Logging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+func (x *Config) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type Server struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Mode    *Server_Mode         `protobuf:"varint,3,opt,name=mode,proto3,enum=tests.funcs.Server_Mode,oneof" json:"mode,omitempty"`
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_funcs_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_funcs_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_tests_funcs_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Server) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Server) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Server) GetMode() Server_Mode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return Server_MODE_UNSPECIFIED
+}
+
+type Limits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rps uint32 `protobuf:"varint,1,opt,name=rps,proto3" json:"rps,omitempty"`
+}
+
+func (x *Limits) Reset() {
+	*x = Limits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_funcs_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limits) ProtoMessage() {}
+
+func (x *Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_funcs_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
+func (*Limits) Descriptor() ([]byte, []int) {
+	return file_tests_funcs_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Limits) GetRps() uint32 {
+	if x != nil {
+		return x.Rps
+	}
+	return 0
+}
+
+var File_tests_funcs_config_proto protoreflect.FileDescriptor
+
+var file_tests_funcs_config_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x1a, 0x17, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x88, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x9a, 0x49, 0x1b, 0x72,
+	0x19, 0x12, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x03, 0x61, 0x70, 0x69, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x08, 0x9a, 0x49, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52,
+	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x42, 0x0d, 0x9a, 0x49, 0x0a, 0xaa, 0x01, 0x07, 0x08, 0x01, 0x12,
+	0x03, 0x6c, 0x6f, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x4d, 0x0a,
+	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x42, 0x20, 0x9a, 0x49, 0x1d, 0xaa, 0x01, 0x1a, 0x08, 0x01, 0x22, 0x14, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x20, 0x72, 0x61, 0x74, 0x65, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x30, 0x01, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xb9, 0x02, 0x0a,
+	0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x9a, 0x49, 0x22, 0x72, 0x20, 0x12,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x05, 0x3a, 0x38, 0x30, 0x38, 0x30, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5a, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x25, 0x9a, 0x49, 0x22, 0x9a, 0x01, 0x1f, 0x12, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x03, 0x33, 0x30, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x4d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x73,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x1a, 0x9a, 0x49,
+	0x17, 0x82, 0x01, 0x14, 0x12, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x0c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x6e, 0x67, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x88, 0x01, 0x01, 0x22, 0x3a, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x52, 0x50, 0x43, 0x10, 0x02, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x42, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x72, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x21, 0x9a, 0x49, 0x1e, 0x2a, 0x1c, 0x12, 0x03, 0x72, 0x70, 0x73, 0x22, 0x13, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x70, 0x65, 0x72, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x40, 0x64, 0x52, 0x03, 0x72, 0x70, 0x73, 0x3a, 0x03, 0xa0, 0x49, 0x01, 0x42, 0x32, 0x5a, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6e, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x66, 0x75, 0x6e, 0x63, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_funcs_config_proto_rawDescOnce sync.Once
+	file_tests_funcs_config_proto_rawDescData = file_tests_funcs_config_proto_rawDesc
+)
+
+func file_tests_funcs_config_proto_rawDescGZIP() []byte {
+	file_tests_funcs_config_proto_rawDescOnce.Do(func() {
+		file_tests_funcs_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_funcs_config_proto_rawDescData)
+	})
+	return file_tests_funcs_config_proto_rawDescData
+}
+
+var file_tests_funcs_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_funcs_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_tests_funcs_config_proto_goTypes = []interface{}{
+	(Server_Mode)(0),            // 0: tests.funcs.Server.Mode
+	(*Config)(nil),              // 1: tests.funcs.Config
+	(*Server)(nil),              // 2: tests.funcs.Server
+	(*Limits)(nil),              // 3: tests.funcs.Limits
+	(*common.Logging)(nil),      // 4: tests.funcs.common.Logging
+	(*durationpb.Duration)(nil), // 5: google.protobuf.Duration
+}
+var file_tests_funcs_config_proto_depIdxs = []int32{
+	2, // 0: tests.funcs.Config.server:type_name -> tests.funcs.Server
+	4, // 1: tests.funcs.Config.logging:type_name -> tests.funcs.common.Logging
+	3, // 2: tests.funcs.Config.limits:type_name -> tests.funcs.Limits
+	5, // 3: tests.funcs.Server.timeout:type_name -> google.protobuf.Duration
+	0, // 4: tests.funcs.Server.mode:type_name -> tests.funcs.Server.Mode
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_tests_funcs_config_proto_init() }
+func file_tests_funcs_config_proto_init() {
+	if File_tests_funcs_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_funcs_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_funcs_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Server); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_funcs_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_funcs_config_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_funcs_config_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_funcs_config_proto_goTypes,
+		DependencyIndexes: file_tests_funcs_config_proto_depIdxs,
+		EnumInfos:         file_tests_funcs_config_proto_enumTypes,
+		MessageInfos:      file_tests_funcs_config_proto_msgTypes,
+	}.Build()
+	File_tests_funcs_config_proto = out.File
+	file_tests_funcs_config_proto_rawDesc = nil
+	file_tests_funcs_config_proto_goTypes = nil
+	file_tests_funcs_config_proto_depIdxs = nil
+}
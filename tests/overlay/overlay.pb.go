@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: tests/overlay/overlay.proto
+
+// buf:lint:ignore PACKAGE_VERSION_SUFFIX
+
+package overlay
+
+import (
+	_ "github.com/kunstack/protoc-gen-flags/flags/annotations"
+	expr "google.golang.org/genproto/googleapis/type/expr"
+	postaladdress "google.golang.org/genproto/googleapis/type/postaladdress"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Contact nests google.type messages annotated by overlay.yaml
+type Contact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Test a foreign message annotated by the overlay file
+	Address *postaladdress.PostalAddress `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Test a foreign message marked unexported by the overlay file, behind a presence flag
+	Filter *expr.Expr `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_overlay_overlay_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_overlay_overlay_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_tests_overlay_overlay_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetAddress() *postaladdress.PostalAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Contact) GetFilter() *expr.Expr {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+var File_tests_overlay_overlay_proto protoreflect.FileDescriptor
+
+var file_tests_overlay_overlay_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2f,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x1a, 0x17, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x61,
+	0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xe0, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x9a, 0x49, 0x16, 0x72, 0x14,
+	0x12, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x20,
+	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x08, 0x9a, 0x49, 0x05, 0xaa, 0x01, 0x02, 0x08,
+	0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x66, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x42, 0x3b, 0x9a,
+	0x49, 0x38, 0xaa, 0x01, 0x35, 0x08, 0x01, 0x22, 0x2f, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x30, 0x01, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6b, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_overlay_overlay_proto_rawDescOnce sync.Once
+	file_tests_overlay_overlay_proto_rawDescData = file_tests_overlay_overlay_proto_rawDesc
+)
+
+func file_tests_overlay_overlay_proto_rawDescGZIP() []byte {
+	file_tests_overlay_overlay_proto_rawDescOnce.Do(func() {
+		file_tests_overlay_overlay_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_overlay_overlay_proto_rawDescData)
+	})
+	return file_tests_overlay_overlay_proto_rawDescData
+}
+
+var file_tests_overlay_overlay_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_overlay_overlay_proto_goTypes = []interface{}{
+	(*Contact)(nil),                     // 0: tests.overlay.Contact
+	(*postaladdress.PostalAddress)(nil), // 1: google.type.PostalAddress
+	(*expr.Expr)(nil),                   // 2: google.type.Expr
+}
+var file_tests_overlay_overlay_proto_depIdxs = []int32{
+	1, // 0: tests.overlay.Contact.address:type_name -> google.type.PostalAddress
+	2, // 1: tests.overlay.Contact.filter:type_name -> google.type.Expr
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tests_overlay_overlay_proto_init() }
+func file_tests_overlay_overlay_proto_init() {
+	if File_tests_overlay_overlay_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_overlay_overlay_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_overlay_overlay_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_overlay_overlay_proto_goTypes,
+		DependencyIndexes: file_tests_overlay_overlay_proto_depIdxs,
+		MessageInfos:      file_tests_overlay_overlay_proto_msgTypes,
+	}.Build()
+	File_tests_overlay_overlay_proto = out.File
+	file_tests_overlay_overlay_proto_rawDesc = nil
+	file_tests_overlay_overlay_proto_goTypes = nil
+	file_tests_overlay_overlay_proto_depIdxs = nil
+}
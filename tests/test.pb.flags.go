@@ -7,7 +7,10 @@ import (
 	"github.com/kunstack/protoc-gen-flags/types"
 	"github.com/kunstack/protoc-gen-flags/utils"
 	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -15,6 +18,13 @@ import (
 	utils1 "github.com/kunstack/protoc-gen-flags/tests/utils"
 	utils2 "github.com/kunstack/protoc-gen-flags/tests/utils/utils"
 	wrapperspb1 "github.com/kunstack/protoc-gen-flags/tests/wrapperspb"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/types/known/emptypb"
+	_ "time/tzdata"
 )
 
 var (
@@ -25,6 +35,9 @@ var (
 	_ = wrapperspb.String
 	_ = (*durationpb.Duration)(nil)
 	_ = (*timestamppb.Timestamp)(nil)
+	_ = (*structpb.Struct)(nil)
+	_ = (*fieldmaskpb.FieldMask)(nil)
+	_ = (*anypb.Any)(nil)
 )
 
 func (x *TestForMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
@@ -34,27 +47,23 @@ func (x *TestForMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
 		x.CustomWrapper = new(wrapperspb1.CustomWrapper)
 	}
 
-	if v, ok := interface{}(x.CustomWrapper).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("custom_wrapper"))...)
-	}
+	x.CustomWrapper.AddFlags(fs, append(opts, flags.WithPrefix("custom_wrapper"))...)
 
 	if x.SimpleMessage == nil {
 		x.SimpleMessage = new(utils1.SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.SimpleMessage).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("simple-messagex1"))...)
-	}
+	x.SimpleMessage.AddFlags(fs, append(opts, flags.WithPrefix("simple-messagex1"))...)
 
 	fs.Float32VarP(&x.Hello, builder.Build("hello"), "h", x.Hello, "Hello world '\"' flag")
 
-	fs.MarkHidden("hello")
+	_ = fs.MarkHidden(builder.Build("hello"))
 
-	fs.MarkDeprecated("hello", "This flag is deprecated, use --greeting instead")
+	_ = fs.MarkDeprecated(builder.Build("hello"), "This flag is deprecated, use --greeting instead")
 
 	fs.StringVarP(&x.World, builder.Build("world"), "", x.World, "World flags (can be specified multiple times)")
 
-	fs.MarkDeprecated("world", "This flag is deprecated, use --target instead")
+	_ = fs.MarkDeprecated(builder.Build("world"), "This flag is deprecated, use --target instead")
 
 	fs.StringVarP(&x.Greeting, builder.Build("greeting"), "g", x.Greeting, "Greeting message to display")
 
@@ -88,7 +97,7 @@ func (x *TestForMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
 
 	fs.BytesHexVarP(&x.SecretKey, builder.Build("secret-key"), "sk", x.SecretKey, "Secret key in hex format")
 
-	fs.MarkHidden("secret-key")
+	_ = fs.MarkHidden(builder.Build("secret-key"))
 
 	fs.VarP(types.BytesSlice(&x.FileChunks), builder.Build("file-chunks"), "fc", "File chunks in base64 format")
 
@@ -110,9 +119,7 @@ func (x *TestForMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
 		x.SimpleField = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.SimpleField).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("simple-field"))...)
-	}
+	x.SimpleField.AddFlags(fs, append(opts, flags.WithPrefix("simple-field"))...)
 
 	fs.StringToStringVarP(&x.Labels, builder.Build("labels"), "l", x.Labels, "Key-value labels (JSON format)")
 
@@ -134,7 +141,7 @@ func (x *TestForMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
 
 	fs.VarP(types.JSON(&x.JsonMap), builder.Build("json-map"), "j", "Generic JSON map format")
 
-	fs.StringSliceVarP(&x.RepeatedStrings, builder.Build("repeated-strings"), "rs", x.RepeatedStrings, "Repeated strings for comparison")
+	fs.VarP(types.NativeStringSlice(&x.RepeatedStrings), builder.Build("repeated-strings"), "rs", "Repeated strings for comparison")
 
 	fs.VarP(types.DurationSlice(&x.Delays), builder.Build("delays"), "d", "Delay durations (e.g., 1s, 2m, 3h)")
 
@@ -146,17 +153,13 @@ func (x *TestForMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
 		x.NestedTest = new(utils2.NestedMessage)
 	}
 
-	if v, ok := interface{}(x.NestedTest).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("nested-test"))...)
-	}
+	x.NestedTest.AddFlags(fs, append(opts, flags.WithPrefix("nested-test"))...)
 
 	if x.CustomType == nil {
 		x.CustomType = new(types1.CustomType)
 	}
 
-	if v, ok := interface{}(x.CustomType).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("custom-type"))...)
-	}
+	x.CustomType.AddFlags(fs, append(opts, flags.WithPrefix("custom-type"))...)
 
 }
 
@@ -165,17 +168,13 @@ func (x *TestForMessage) SetDefaults() {
 		x.CustomWrapper = new(wrapperspb1.CustomWrapper)
 	}
 
-	if v, ok := interface{}(x.CustomWrapper).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.CustomWrapper.SetDefaults()
 
 	if x.SimpleMessage == nil {
 		x.SimpleMessage = new(utils1.SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.SimpleMessage).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.SimpleMessage.SetDefaults()
 
 	if len(x.Byte) == 0 {
 		x.Byte = utils.MustDecodeHex("0000546573742048656c6c6f2054657874")
@@ -200,25 +199,19 @@ func (x *TestForMessage) SetDefaults() {
 		x.SimpleField = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.SimpleField).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.SimpleField.SetDefaults()
 
 	if x.NestedTest == nil {
 		x.NestedTest = new(utils2.NestedMessage)
 	}
 
-	if v, ok := interface{}(x.NestedTest).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.NestedTest.SetDefaults()
 
 	if x.CustomType == nil {
 		x.CustomType = new(types1.CustomType)
 	}
 
-	if v, ok := interface{}(x.CustomType).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.CustomType.SetDefaults()
 
 }
 
@@ -323,16 +316,16 @@ func (x *BytesSliceTestMessage) SetDefaults() {
 func (x *FloatSliceTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
 	builder := flags.NewNameBuilder(opts...)
 	_ = builder
-	fs.Float32SliceVarP(&x.Measurements, builder.Build("measurements"), "m", x.Measurements, "Scientific measurements (e.g., 3.14, 2.71, 1.41)")
+	fs.VarP(types.NativeFloatSlice(&x.Measurements), builder.Build("measurements"), "m", "Scientific measurements (e.g., 3.14, 2.71, 1.41)")
 
 	if x.Coordinates2 == nil {
 		x.Coordinates2 = new(float32)
 	}
 	fs.Float32VarP(x.Coordinates2, builder.Build("coordinates"), "c", *(x.Coordinates2), "GPS coordinates in float format")
 
-	fs.Float32SliceVarP(&x.Temperatures, builder.Build("temperatures"), "t", x.Temperatures, "Temperature readings in Celsius")
+	fs.VarP(types.NativeFloatSlice(&x.Temperatures), builder.Build("temperatures"), "t", "Temperature readings in Celsius")
 
-	fs.Float32SliceVarP(&x.Percentages, builder.Build("percentages"), "p", x.Percentages, "Percentage values (0.0 to 100.0)")
+	fs.VarP(types.NativeFloatSlice(&x.Percentages), builder.Build("percentages"), "p", "Percentage values (0.0 to 100.0)")
 
 }
 
@@ -416,7 +409,7 @@ func (x *WrapperMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
 	}
 	fs.VarP(types.Float(x.Value), builder.Build("value"), "", "hello")
 
-	fs.StringSliceVarP(&x.Value2, builder.Build("value2"), "", x.Value2, "This should not appear in help")
+	fs.VarP(types.NativeStringSlice(&x.Value2), builder.Build("value2"), "", "This should not appear in help")
 
 }
 
@@ -428,7 +421,7 @@ func (x *UnexportedMessageTest) _AddFlags(fs *pflag.FlagSet, opts ...flags.Optio
 	_ = builder
 	fs.StringVarP(&x.SecretKey, builder.Build("secret-key"), "", x.SecretKey, "Secret configuration key")
 
-	fs.MarkHidden("secret-key")
+	_ = fs.MarkHidden(builder.Build("secret-key"))
 
 	fs.Int32VarP(&x.Timeout, builder.Build("timeout"), "", x.Timeout, "Connection timeout in seconds")
 
@@ -588,7 +581,7 @@ func (x *IntegerValueTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Opti
 
 	fs.VarP(types.FloatSlice(&x.Float64Values), builder.Build("int64-valuesx"), "i64s", "Multiple FloatValue wrapper instances")
 
-	fs.Float64SliceVarP(&x.DoubleValues, builder.Build("double-valuesx"), "i64sx", x.DoubleValues, "Multiple double value instances")
+	fs.VarP(types.NativeDoubleSlice(&x.DoubleValues), builder.Build("double-valuesx"), "i64sx", "Multiple double value instances")
 
 }
 
@@ -671,21 +664,21 @@ func (x *ComprehensiveFlagTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags
 
 	fs.StringVarP(&x.Password, builder.Build("password"), "p", x.Password, "Password for authentication")
 
-	fs.MarkHidden("password")
+	_ = fs.MarkHidden(builder.Build("password"))
 
 	fs.StringVarP(&x.LegacyToken, builder.Build("legacy-token"), "lt", x.LegacyToken, "Legacy authentication token")
 
-	fs.MarkDeprecated("legacy-token", "Use --api-key instead")
+	_ = fs.MarkDeprecated(builder.Build("legacy-token"), "Use --api-key instead")
 
 	fs.Int32VarP(&x.ConnectionCount, builder.Build("connection-count"), "cc", x.ConnectionCount, "Number of concurrent connections")
 
 	fs.Int32VarP(&x.MaxThreads, builder.Build("max-threads"), "mt", x.MaxThreads, "Maximum number of threads")
 
-	fs.MarkDeprecated("max-threads", "Use --worker-count instead")
+	_ = fs.MarkDeprecated(builder.Build("max-threads"), "Use --worker-count instead")
 
 	fs.BoolVarP(&x.ExperimentalMode, builder.Build("experimental-mode"), "exp", x.ExperimentalMode, "Enable experimental features")
 
-	fs.MarkHidden("experimental-mode")
+	_ = fs.MarkHidden(builder.Build("experimental-mode"))
 
 }
 
@@ -715,33 +708,25 @@ func (x *NestedMessageTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Opt
 		x.ServerConfig = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.ServerConfig).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("server"))...)
-	}
+	x.ServerConfig.AddFlags(fs, append(opts, flags.WithPrefix("server"))...)
 
 	if x.ClientConfig == nil {
 		x.ClientConfig = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.ClientConfig).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("client_config"))...)
-	}
+	x.ClientConfig.AddFlags(fs, append(opts, flags.WithPrefix("client_config"))...)
 
 	if x.DatabaseConfig == nil {
 		x.DatabaseConfig = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.DatabaseConfig).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("db"))...)
-	}
+	x.DatabaseConfig.AddFlags(fs, append(opts, flags.WithPrefix("db"))...)
 
 	if x.DeepConfig == nil {
 		x.DeepConfig = new(NestedLevel2Message)
 	}
 
-	if v, ok := interface{}(x.DeepConfig).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("app"))...)
-	}
+	x.DeepConfig.AddFlags(fs, append(opts, flags.WithPrefix("app"))...)
 
 }
 
@@ -750,33 +735,25 @@ func (x *NestedMessageTestMessage) SetDefaults() {
 		x.ServerConfig = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.ServerConfig).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.ServerConfig.SetDefaults()
 
 	if x.ClientConfig == nil {
 		x.ClientConfig = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.ClientConfig).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.ClientConfig.SetDefaults()
 
 	if x.DatabaseConfig == nil {
 		x.DatabaseConfig = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.DatabaseConfig).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.DatabaseConfig.SetDefaults()
 
 	if x.DeepConfig == nil {
 		x.DeepConfig = new(NestedLevel2Message)
 	}
 
-	if v, ok := interface{}(x.DeepConfig).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.DeepConfig.SetDefaults()
 
 }
 
@@ -789,9 +766,7 @@ func (x *NestedLevel2Message) AddFlags(fs *pflag.FlagSet, opts ...flags.Option)
 		x.NestedSimple = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.NestedSimple).(flags.Flagger); ok {
-		v.AddFlags(fs, append(opts, flags.WithPrefix("nested"))...)
-	}
+	x.NestedSimple.AddFlags(fs, append(opts, flags.WithPrefix("nested"))...)
 
 }
 
@@ -800,9 +775,7 @@ func (x *NestedLevel2Message) SetDefaults() {
 		x.NestedSimple = new(SimpleMessage)
 	}
 
-	if v, ok := interface{}(x.NestedSimple).(flags.Defaulter); ok {
-		v.SetDefaults()
-	}
+	x.NestedSimple.SetDefaults()
 
 }
 
@@ -817,11 +790,11 @@ func (x *ComprehensiveMapTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.
 
 	fs.VarP(types.JSON(&x.LegacyConfig), builder.Build("legacy-config"), "lc", "Legacy configuration map")
 
-	fs.MarkDeprecated("legacy-config", "Use --new-config instead")
+	_ = fs.MarkDeprecated(builder.Build("legacy-config"), "Use --new-config instead")
 
 	fs.VarP(types.JSON(&x.SecretConfig), builder.Build("secret-config"), "sc", "Secret configuration map")
 
-	fs.MarkHidden("secret-config")
+	_ = fs.MarkHidden(builder.Build("secret-config"))
 
 }
 
@@ -883,3 +856,822 @@ func (x *RepeatedBytesTestMessage) SetDefaults() {
 		x.SpecialB64 = [][]byte{utils.MustDecodeBase64("w6TDtsO8w4Q="), utils.MustDecodeBase64("8J+YgA=="), utils.MustDecodeBase64("w4PDoMOgw6E=")}
 	}
 }
+
+func (x *RepeatedSplitTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.VarP(types.NativeStringSlice(&x.CsvTags, types.WithSplitter(types.SplitCSV)), builder.Build("csv-tags"), "", "Tags in CSV format (e.g., a,\"b,c\")")
+
+	fs.VarP(types.NativeStringSlice(&x.ArrayLabels, types.WithSplitter(types.SplitNone)), builder.Build("array-labels"), "", "Labels, one per argument")
+
+	fs.VarP(types.NativeStringSlice(&x.SearchPaths, types.WithSplitter(types.SplitSeparator(":"))), builder.Build("search-paths"), "", "Search paths separated by ':'")
+
+	fs.VarP(types.NativeInt32Slice(&x.JsonPorts, types.WithSplitter(types.SplitJSON)), builder.Build("json-ports"), "", "Ports as a JSON array (e.g., [80,443])")
+
+	fs.VarP(types.NativeUInt32Slice(&x.Ids), builder.Build("ids"), "", "Unsigned identifiers")
+
+	fs.VarP(types.NativeUInt64Slice(&x.Checksums, types.WithSplitter(types.SplitSeparator(";"))), builder.Build("checksums"), "", "Fixed64 checksums separated by ';'")
+
+	fs.VarP(types.UInt64Slice(&x.Sizes, types.WithSplitter(types.SplitSeparator(" "))), builder.Build("sizes"), "", "Sizes separated by ' '")
+
+	fs.VarP(types.DurationSlice(&x.Backoffs, types.WithSplitter(types.SplitNone)), builder.Build("backoffs"), "", "Backoff durations, one per argument")
+
+	fs.VarP(types.BytesHexSlice(&x.Keys, types.WithSplitter(types.SplitCSV)), builder.Build("keys"), "", "Hex encoded keys in CSV format")
+
+	fs.VarP(types.EnumSlice(&x.Modes, types.WithSplitter(types.SplitJSON)), builder.Build("modes"), "", "Modes as a JSON array")
+
+	fs.VarP(types.TimestampSlice(&x.Windows, []string{"RFC3339"}, types.WithSplitter(types.SplitSeparator("|"))), builder.Build("windows"), "", "Window start times separated by '|'")
+
+}
+
+func (x *RepeatedSplitTestMessage) SetDefaults() {
+	if len(x.Ids) == 0 {
+		x.Ids = []uint32{1, 2}
+	}
+
+}
+
+func (x *RepeatedMergeTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.VarP(types.NativeStringSlice(&x.Tags, types.WithMerge(types.MergeAppend), types.WithUnique()), builder.Build("tags"), "", "Tags added to the defaults (pass --tags= to clear)")
+
+	fs.VarP(types.NativeStringSlice(&x.IncludeDirs, types.WithMerge(types.MergePrepend)), builder.Build("include-dirs"), "", "Include directories searched before the defaults")
+
+	fs.VarP(types.Int64Slice(&x.Priorities, types.WithMerge(types.MergeAppend), types.WithUnique(), types.WithSorted()), builder.Build("priorities"), "", "Priorities, sorted and deduplicated")
+
+	fs.VarP(types.DurationSlice(&x.Retries), builder.Build("retries"), "", "Retry delays replacing the defaults")
+
+}
+
+func (x *RepeatedMergeTestMessage) SetDefaults() {
+	if len(x.Tags) == 0 {
+		x.Tags = []string{"base"}
+	}
+
+	if len(x.IncludeDirs) == 0 {
+		x.IncludeDirs = []string{"/usr/include"}
+	}
+
+	if len(x.Priorities) == 0 {
+		x.Priorities = []*wrapperspb.Int64Value{{Value: 10}, {Value: 20}}
+	}
+
+	if len(x.Retries) == 0 {
+		x.Retries = []*durationpb.Duration{{Seconds: 1, Nanos: 0}, {Seconds: 2, Nanos: 0}}
+	}
+}
+
+func (x *RepeatedRangesTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.VarP(types.NativeInt32Slice(&x.Cpus, types.WithRanges(0)), builder.Build("cpus"), "", "CPU set (e.g., 0-3,8)")
+
+	fs.VarP(types.NativeUInt32Slice(&x.Ports, types.WithRanges(1024)), builder.Build("ports"), "", "Ports (e.g., 8000-8100:10)")
+
+	fs.VarP(types.Int64Slice(&x.Shards, types.WithUnique(), types.WithSorted(), types.WithRanges(0)), builder.Build("shards"), "", "Shard IDs (e.g., 1-5,8,10-12)")
+
+	fs.VarP(types.NativeInt64Slice(&x.Offsets, types.WithRanges(0)), builder.Build("offsets"), "", "Offsets (e.g., -5--1)")
+
+	fs.VarP(types.NativeUInt32Slice(&x.Slots, types.WithRanges(0)), builder.Build("slots"), "", "Slots (e.g., 0-7)")
+
+}
+
+func (x *RepeatedRangesTestMessage) SetDefaults() {
+	if len(x.Cpus) == 0 {
+		x.Cpus = []int32{0, 1, 2, 3}
+	}
+
+}
+
+func (x *UnitTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.VarP(types.NumberWithUnit(&x.MaxMemory, types.UnitBytes), builder.Build("max-memory"), "", "Memory limit (e.g., 512MiB, 2GB)")
+
+	fs.VarP(types.NumberWithUnit(&x.Rate, types.UnitSI), builder.Build("rate"), "", "Requests per second (e.g., 10k)")
+
+	fs.VarP(types.NumberWithUnit(&x.Threshold, types.UnitPercent), builder.Build("threshold"), "", "Alert threshold (e.g., 80%)")
+
+	if x.BufferSize == nil {
+		x.BufferSize = new(wrapperspb.Int64Value)
+	}
+	fs.VarP(types.NumberWithUnit(&x.BufferSize.Value, types.UnitBytes), builder.Build("buffer-size"), "", "Buffer size")
+
+	if x.DiskQuota == nil {
+		x.DiskQuota = new(uint64)
+	}
+	fs.VarP(types.NumberWithUnit(x.DiskQuota, types.UnitBytes), builder.Build("disk-quota"), "", "Disk quota")
+
+	fs.VarP(types.NumberWithUnit(&x.Interval, types.UnitSI), builder.Build("interval"), "", "Sampling interval in seconds (e.g., 250m)")
+
+}
+
+func (x *UnitTestMessage) SetDefaults() {
+	if x.MaxMemory == 0 {
+		x.MaxMemory = 1073741824
+	}
+
+	if x.Rate == 0 {
+		x.Rate = 1000
+	}
+
+	if x.Threshold == 0 {
+		x.Threshold = 0.75
+	}
+
+	if x.BufferSize == nil {
+		x.BufferSize = &wrapperspb.Int64Value{Value: 65536}
+	}
+
+	if x.DiskQuota == nil {
+		v := uint64(10000000000)
+		x.DiskQuota = &v
+	}
+
+}
+
+func (x *IntegerDurationTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.VarP(types.DurationAs(&x.TimeoutMs, types.Milliseconds), builder.Build("timeout"), "", "Request timeout")
+
+	fs.VarP(types.DurationAs(&x.TtlSeconds, types.Seconds), builder.Build("ttl"), "", "Cache TTL")
+
+	if x.IntervalMinutes == nil {
+		x.IntervalMinutes = new(wrapperspb.Int32Value)
+	}
+	fs.VarP(types.DurationAs(&x.IntervalMinutes.Value, types.Minutes), builder.Build("interval"), "", "Sync interval")
+
+	if x.GraceNs == nil {
+		x.GraceNs = new(int64)
+	}
+	fs.VarP(types.DurationAs(x.GraceNs, types.Nanoseconds), builder.Build("grace"), "", "Grace period")
+
+}
+
+func (x *IntegerDurationTestMessage) SetDefaults() {
+	if x.TimeoutMs == 0 {
+		x.TimeoutMs = 30000
+	}
+
+	if x.TtlSeconds == 0 {
+		x.TtlSeconds = 3600
+	}
+
+	if x.IntervalMinutes == nil {
+		x.IntervalMinutes = &wrapperspb.Int32Value{Value: 150}
+	}
+
+}
+
+func (x *ExtendedDurationTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	if x.Retention == nil {
+		x.Retention = new(durationpb.Duration)
+	}
+
+	fs.VarP(types.Duration(x.Retention), builder.Build("retention"), "", "Retention period (e.g., 30d, 2w)")
+
+	fs.VarP(types.DurationSlice(&x.Schedule), builder.Build("schedule"), "", "Schedule offsets (e.g., 1d12h, P1DT2H)")
+
+	fs.VarP(types.DurationAs(&x.RetentionHours, types.Hours), builder.Build("retention-hours"), "", "Retention period stored in hours")
+
+}
+
+func (x *ExtendedDurationTestMessage) SetDefaults() {
+	if x.Retention == nil {
+		x.Retention = &durationpb.Duration{Seconds: 2592000, Nanos: 0}
+	}
+	if len(x.Schedule) == 0 {
+		x.Schedule = []*durationpb.Duration{{Seconds: 604800, Nanos: 0}, {Seconds: 93600, Nanos: 0}}
+	}
+	if x.RetentionHours == 0 {
+		x.RetentionHours = 336
+	}
+
+}
+
+func (x *RelativeTimestampTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	if x.Since == nil {
+		x.Since = new(timestamppb.Timestamp)
+	}
+
+	fs.VarP(types.Timestamp(x.Since, []string{"RFC3339"}), builder.Build("since"), "", "Start of the time window")
+
+	if x.Maintenance == nil {
+		x.Maintenance = new(timestamppb.Timestamp)
+	}
+
+	fs.VarP(types.TimestampIn(x.Maintenance, []string{"DateTime", "RFC3339"}, "Europe/Berlin"), builder.Build("maintenance"), "", "Maintenance window start in Berlin time")
+
+	fs.VarP(types.TimestampSliceIn(&x.Checkpoints, []string{"DateOnly", "RFC3339"}, "Asia/Tokyo"), builder.Build("checkpoints"), "", "Checkpoints in Tokyo time")
+
+}
+
+func (x *RelativeTimestampTestMessage) SetDefaults() {
+	if x.Since == nil {
+		x.Since = timestamppb.New(utils.MustParseTime("now-1h", nil, ""))
+	}
+
+	if x.Maintenance == nil {
+		x.Maintenance = &timestamppb.Timestamp{Seconds: 1704070800, Nanos: 0}
+	}
+
+	if len(x.Checkpoints) == 0 {
+		x.Checkpoints = []*timestamppb.Timestamp{timestamppb.New(utils.MustParseTime("today", nil, "Asia/Tokyo")), timestamppb.New(utils.MustParseTime("yesterday+6h", nil, "Asia/Tokyo")), &timestamppb.Timestamp{Seconds: 1717167600, Nanos: 0}}
+	}
+
+}
+
+func (x *StringFormatTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.VarP(types.CheckedString(&x.BindIp, "ip", utils.NormalizeIP), builder.Build("bind-ip"), "", "Address to bind to")
+
+	fs.VarP(types.CheckedString(&x.Subnet, "cidr", utils.NormalizeCIDR), builder.Build("subnet"), "", "Allowed subnet")
+
+	if x.Upstream == nil {
+		x.Upstream = new(wrapperspb.StringValue)
+	}
+	fs.VarP(types.CheckedString(&x.Upstream.Value, "hostPort", utils.NormalizeHostPort), builder.Build("upstream"), "", "Upstream address")
+
+	if x.Endpoint == nil {
+		x.Endpoint = new(string)
+	}
+	fs.VarP(types.CheckedString(x.Endpoint, "url", utils.NormalizeURL), builder.Build("endpoint"), "", "Service endpoint")
+
+	fs.VarP(types.NativeStringSlice(&x.Trusted, types.WithCheck(utils.NormalizeCIDR)), builder.Build("trusted"), "", "Trusted networks")
+
+	fs.VarP(types.StringSlice(&x.Admins, types.WithCheck(utils.NormalizeEmail)), builder.Build("admins"), "", "Administrator emails")
+
+}
+
+func (x *StringFormatTestMessage) SetDefaults() {
+	if x.Subnet == "" {
+		x.Subnet = "10.0.0.0/8"
+	}
+
+	if x.Upstream == nil {
+		x.Upstream = &wrapperspb.StringValue{Value: "localhost:8080"}
+	}
+
+	if len(x.Trusted) == 0 {
+		x.Trusted = []string{"127.0.0.0/8", "::1/128"}
+	}
+
+}
+
+func (x *PathTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.VarP(types.CheckedString(&x.Config, "file", utils.Path{Absolute: true, Check: utils.PathMustBeFile}.Normalize), builder.Build("config"), "", "Configuration file")
+
+	_ = fs.SetAnnotation(builder.Build("config"), flags.AnnotationFilenameExt, []string{"yaml", "yml"})
+
+	if x.DataDir == nil {
+		x.DataDir = new(wrapperspb.StringValue)
+	}
+	fs.VarP(types.CheckedString(&x.DataDir.Value, "dir", utils.Path{Base: "$HOME/.local/share", Check: utils.PathMustBeDir}.Normalize), builder.Build("data-dir"), "", "Data directory")
+
+	_ = fs.SetAnnotation(builder.Build("data-dir"), flags.AnnotationSubdirsInDir, []string{})
+
+	if x.Output == nil {
+		x.Output = new(string)
+	}
+	fs.VarP(types.CheckedString(x.Output, "path", utils.Path{Check: utils.PathMustNotExist}.Normalize), builder.Build("output"), "", "Output file")
+
+	_ = fs.SetAnnotation(builder.Build("output"), flags.AnnotationFilenameExt, []string{})
+
+	fs.VarP(types.NativeStringSlice(&x.Includes, types.WithCheck(utils.Path{Absolute: true, Check: utils.PathMustExist}.Normalize)), builder.Build("includes"), "", "Include directories")
+
+	_ = fs.SetAnnotation(builder.Build("includes"), flags.AnnotationFilenameExt, []string{})
+
+}
+
+func (x *PathTestMessage) SetDefaults() {
+	if x.Config == "" {
+		x.Config = utils.Path{Absolute: true, Check: utils.PathMustBeFile}.Default("~/.config/app/config.yaml")
+	}
+
+	if len(x.Includes) == 0 {
+		x.Includes = []string{utils.Path{Absolute: true, Check: utils.PathMustExist}.Default("$HOME/include"), utils.Path{Absolute: true, Check: utils.PathMustExist}.Default("/usr/include")}
+	}
+
+}
+
+func (x *StringChoicesTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.VarP(types.CheckedString(&x.Compression, "", utils.Choices{Values: []string{"gzip", "zstd", "none"}}.Normalize), builder.Build("compression"), "", "Compression algorithm (one of: gzip, zstd, none)")
+
+	_ = fs.SetAnnotation(builder.Build("compression"), flags.AnnotationChoices, []string{"gzip", "zstd", "none"})
+
+	if x.Level == nil {
+		x.Level = new(wrapperspb.StringValue)
+	}
+	fs.VarP(types.CheckedString(&x.Level.Value, "", utils.Choices{Values: []string{"DEBUG", "INFO", "WARN", "ERROR"}, CaseInsensitive: true}.Normalize), builder.Build("level"), "", "Log level (one of: DEBUG, INFO, WARN, ERROR)")
+
+	_ = fs.SetAnnotation(builder.Build("level"), flags.AnnotationChoices, []string{"DEBUG", "INFO", "WARN", "ERROR"})
+
+	fs.VarP(types.NativeStringSlice(&x.Features, types.WithCheck(utils.Choices{Values: []string{"metrics", "tracing", "profiling"}, CaseInsensitive: true}.Normalize)), builder.Build("features"), "", "Enabled features (one of: metrics, tracing, profiling)")
+
+	_ = fs.SetAnnotation(builder.Build("features"), flags.AnnotationChoices, []string{"metrics", "tracing", "profiling"})
+
+}
+
+func (x *StringChoicesTestMessage) SetDefaults() {
+	if x.Compression == "" {
+		x.Compression = "none"
+	}
+
+	if x.Level == nil {
+		x.Level = &wrapperspb.StringValue{Value: "INFO"}
+	}
+
+	if len(x.Features) == 0 {
+		x.Features = []string{"metrics"}
+	}
+
+}
+
+func (x *FileRefTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.StringVarP(&x.Password, builder.Build("password"), "", x.Password, "Database password (@file or - reads the value from a file or stdin)")
+
+	types.AllowFileRef(fs, builder.Build("password"))
+
+	if x.Endpoint == nil {
+		x.Endpoint = new(wrapperspb.StringValue)
+	}
+	fs.VarP(types.CheckedString(&x.Endpoint.Value, "url", utils.NormalizeURL), builder.Build("endpoint"), "", "Service endpoint (@file or - reads the value from a file or stdin)")
+
+	types.AllowFileRef(fs, builder.Build("endpoint"))
+
+	fs.BytesHexVarP(&x.Key, builder.Build("key"), "", x.Key, "Encryption key (@file or - reads the value from a file or stdin)")
+
+	types.AllowFileRef(fs, builder.Build("key"))
+
+	if x.Cert == nil {
+		x.Cert = new(wrapperspb.BytesValue)
+	}
+
+	fs.VarP(types.Bytes(x.Cert), builder.Build("cert"), "", "Client certificate (@file or - reads the value from a file or stdin)")
+
+	types.AllowFileRef(fs, builder.Build("cert"))
+
+	fs.VarP(types.NativeStringSlice(&x.Tokens, types.WithFileRef()), builder.Build("tokens"), "", "API tokens (@file or - reads the values from a file or stdin, one per line)")
+
+	fs.VarP(types.BytesSlice(&x.Salts, types.WithFileRef()), builder.Build("salts"), "", "Password salts (@file or - reads the values from a file or stdin, one per line)")
+
+}
+
+func (x *FileRefTestMessage) SetDefaults() {
+}
+
+func (x *SensitiveTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.StringVarP(&x.ApiKey, builder.Build("apikey"), "", x.ApiKey, "API key")
+
+	_ = flags.MarkSensitive(fs, builder.Build("apikey"))
+
+	if x.Secret == nil {
+		x.Secret = new(wrapperspb.BytesValue)
+	}
+
+	fs.VarP(types.Bytes(x.Secret), builder.Build("secret"), "", "Signing secret (@file or - reads the value from a file or stdin)")
+
+	types.AllowFileRef(fs, builder.Build("secret"))
+
+	_ = flags.MarkSensitive(fs, builder.Build("secret"))
+
+	fs.VarP(types.NativeStringSlice(&x.Tokens), builder.Build("tokens"), "", "API tokens")
+
+	_ = fs.MarkHidden(builder.Build("tokens"))
+
+	_ = flags.MarkSensitive(fs, builder.Build("tokens"))
+
+	fs.VarP(types.JSON(&x.Headers), builder.Build("headers"), "", "Request headers")
+
+	_ = flags.MarkSensitive(fs, builder.Build("headers"))
+
+}
+
+func (x *SensitiveTestMessage) SetDefaults() {
+	if x.ApiKey == "" {
+		x.ApiKey = "dev-key"
+	}
+
+}
+
+func (x *BytesEncodingTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.VarP(types.EncodedBytes(&x.Token, types.EncodingBase64URL), builder.Build("token"), "", "Session token")
+
+	if x.Nonce == nil {
+		x.Nonce = new(wrapperspb.BytesValue)
+	}
+
+	fs.VarP(types.EncodedBytes(&x.Nonce.Value, types.EncodingBase64Raw), builder.Build("nonce"), "", "Request nonce")
+
+	fs.VarP(types.EncodedBytes(&x.JwtPayload, types.EncodingBase64RawURL), builder.Build("jwt-payload"), "", "JWT payload segment")
+
+	fs.VarP(types.EncodedBytes(&x.Greeting, types.EncodingUTF8), builder.Build("greeting"), "", "Greeting text")
+
+	if x.Cert == nil {
+		x.Cert = new(wrapperspb.BytesValue)
+	}
+
+	fs.VarP(types.EncodedBytes(&x.Cert.Value, types.EncodingFile), builder.Build("cert"), "", "Client certificate file")
+
+	fs.VarP(types.EncodedBytesSlice(&x.Labels, types.EncodingUTF8), builder.Build("labels"), "", "Binary labels")
+
+	fs.VarP(types.EncodedBytesSlice(&x.CaCerts, types.EncodingFile), builder.Build("ca-certs"), "", "CA certificate files")
+
+	fs.VarP(types.EncodedBytesSlice(&x.Keys, types.EncodingBase64RawURL), builder.Build("keys"), "", "Signing keys")
+
+}
+
+func (x *BytesEncodingTestMessage) SetDefaults() {
+	if len(x.Token) == 0 {
+		x.Token = utils.MustDecodeBytes("-_8=", utils.EncodingBase64URL)
+	}
+	if x.Nonce == nil {
+		x.Nonce = &wrapperspb.BytesValue{Value: utils.MustDecodeBytes("AAECAw", utils.EncodingBase64Raw)}
+	}
+	if len(x.Greeting) == 0 {
+		x.Greeting = utils.MustDecodeBytes("hello", utils.EncodingUTF8)
+	}
+	if len(x.Labels) == 0 {
+		x.Labels = [][]byte{utils.MustDecodeBytes("a", utils.EncodingUTF8), utils.MustDecodeBytes("b", utils.EncodingUTF8)}
+	}
+	if len(x.Keys) == 0 {
+		x.Keys = []*wrapperspb.BytesValue{{Value: utils.MustDecodeBytes("-_8", utils.EncodingBase64RawURL)}, {Value: utils.MustDecodeBytes("AAE", utils.EncodingBase64RawURL)}}
+	}
+}
+
+func (x *StructTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	if x.Extra == nil {
+		x.Extra = new(structpb.Struct)
+	}
+
+	fs.VarP(types.Struct(x.Extra), builder.Build("extra"), "", "Extra plugin settings")
+
+	_ = fs.SetAnnotation(builder.Build("extra"), flags.AnnotationDotted, []string{"true"})
+
+	if x.Metadata == nil {
+		x.Metadata = new(structpb.Value)
+	}
+
+	fs.VarP(types.Value(x.Metadata), builder.Build("metadata"), "", "Free-form metadata")
+
+	_ = fs.SetAnnotation(builder.Build("metadata"), flags.AnnotationDotted, []string{"true"})
+
+	if x.Args == nil {
+		x.Args = new(structpb.ListValue)
+	}
+
+	fs.VarP(types.List(x.Args), builder.Build("args"), "", "Plugin arguments")
+
+	if x.Credentials == nil {
+		x.Credentials = new(structpb.Struct)
+	}
+
+	fs.VarP(types.Struct(x.Credentials), builder.Build("credentials"), "", "Backend credentials")
+
+	_ = fs.SetAnnotation(builder.Build("credentials"), flags.AnnotationDotted, []string{"true"})
+
+	_ = flags.MarkSensitive(fs, builder.Build("credentials"))
+
+}
+
+func (x *StructTestMessage) SetDefaults() {
+	if x.Extra == nil {
+		x.Extra = utils.MustUnmarshalJSON("{\"timeout\": 30, \"mode\": \"safe\"}", new(structpb.Struct))
+	}
+	if x.Metadata == nil {
+		x.Metadata = utils.MustUnmarshalJSON("\"none\"", new(structpb.Value))
+	}
+	if x.Args == nil {
+		x.Args = utils.MustUnmarshalJSON("[1, \"two\"]", new(structpb.ListValue))
+	}
+}
+
+func (x *FieldMaskTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	if x.UpdateMask == nil {
+		x.UpdateMask = new(fieldmaskpb.FieldMask)
+	}
+
+	fs.VarP(types.FieldMask(x.UpdateMask, (*Profile)(nil).ProtoReflect().Descriptor()), builder.Build("update-mask"), "", "Profile fields to update")
+
+	if x.AddressMask == nil {
+		x.AddressMask = new(fieldmaskpb.FieldMask)
+	}
+
+	fs.VarP(types.FieldMask(x.AddressMask, (*Profile_Address)(nil).ProtoReflect().Descriptor()), builder.Build("address-mask"), "", "Address fields to update")
+
+}
+
+func (x *FieldMaskTestMessage) SetDefaults() {
+	if x.UpdateMask == nil {
+		x.UpdateMask = &fieldmaskpb.FieldMask{Paths: []string{"display_name", "address.zip_code"}}
+	}
+}
+
+func (x *AnyTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	if x.Provider == nil {
+		x.Provider = new(anypb.Any)
+	}
+
+	fs.VarP(types.Any(x.Provider, builder.Resolver(), "tests.S3Config", "tests.GCSConfig"), builder.Build("provider"), "", "Storage provider configuration")
+
+	if x.Extension == nil {
+		x.Extension = new(anypb.Any)
+	}
+
+	fs.VarP(types.Any(x.Extension, builder.Resolver()), builder.Build("extension"), "", "Extension configuration")
+
+}
+
+func (x *AnyTestMessage) SetDefaults() {
+	if x.Provider == nil {
+		x.Provider = utils.MustUnmarshalJSON("{\"@type\": \"type.googleapis.com/tests.S3Config\", \"bucket\": \"logs\", \"region\": \"us-east-1\"}", new(anypb.Any))
+	}
+}
+
+func (x *GoogleTypeTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	if x.StartDate == nil {
+		x.StartDate = new(date.Date)
+	}
+
+	fs.VarP(types.Date(x.StartDate), builder.Build("start-date"), "", "First day of the billing period")
+
+	if x.OpeningTime == nil {
+		x.OpeningTime = new(timeofday.TimeOfDay)
+	}
+
+	fs.VarP(types.TimeOfDay(x.OpeningTime), builder.Build("opening-time"), "", "Daily opening time")
+
+	if x.Price == nil {
+		x.Price = new(money.Money)
+	}
+
+	fs.VarP(types.Money(x.Price), builder.Build("price"), "", "Unit price")
+
+	if x.Location == nil {
+		x.Location = new(latlng.LatLng)
+	}
+
+	fs.VarP(types.LatLng(x.Location), builder.Build("location"), "l", "Store location")
+
+	if x.TaxRate == nil {
+		x.TaxRate = new(decimal.Decimal)
+	}
+
+	fs.VarP(types.Decimal(x.TaxRate), builder.Build("tax-rate"), "", "Tax rate")
+
+}
+
+func (x *GoogleTypeTestMessage) SetDefaults() {
+	if x.StartDate == nil {
+		x.StartDate = utils.MustParseGoogleType("2026-10-16", new(date.Date))
+	}
+	if x.OpeningTime == nil {
+		x.OpeningTime = utils.MustParseGoogleType("08:30", new(timeofday.TimeOfDay))
+	}
+	if x.Price == nil {
+		x.Price = utils.MustParseGoogleType("12.50 USD", new(money.Money))
+	}
+	if x.TaxRate == nil {
+		x.TaxRate = utils.MustParseGoogleType("0.19", new(decimal.Decimal))
+	}
+}
+
+func (x *TLSConfig) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.StringVarP(&x.CertFile, builder.Build("cert-file"), "", x.CertFile, "Certificate file")
+
+	fs.StringVarP(&x.KeyFile, builder.Build("key-file"), "", x.KeyFile, "Private key file")
+
+	fs.VarP(types.NativeStringSlice(&x.CipherSuites), builder.Build("cipher-suites"), "", "Allowed cipher suites")
+
+}
+
+func (x *TLSConfig) SetDefaults() {
+	if x.CertFile == "" {
+		x.CertFile = "server.crt"
+	}
+
+	if x.KeyFile == "" {
+		x.KeyFile = "server.key"
+	}
+
+}
+
+func (x *LiteralTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	if x.Tls == nil {
+		x.Tls = new(TLSConfig)
+	}
+
+	{
+		literal := types.Message(x.Tls)
+		literal.TrackFlags(fs, func() {
+			x.Tls.AddFlags(fs, append(opts, flags.WithPrefix("tls"))...)
+		})
+		fs.VarP(literal, builder.Build("tls"), "", "TLS configuration as JSON or text")
+	}
+
+	if x.Owner == nil {
+		x.Owner = new(Profile)
+	}
+
+	fs.VarP(types.Message(x.Owner), builder.Build("owner"), "o", "Owner profile as JSON or text")
+
+}
+
+func (x *LiteralTestMessage) SetDefaults() {
+	if x.Tls == nil {
+		x.Tls = new(TLSConfig)
+	}
+
+	x.Tls.SetDefaults()
+
+	if x.Owner == nil {
+		x.Owner = new(Profile)
+	}
+
+	if v, ok := interface{}(x.Owner).(flags.Defaulter); ok {
+		v.SetDefaults()
+	}
+
+}
+
+func (x *PresenceTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	{
+		msg := x.Tls
+		if msg == nil {
+			msg = new(TLSConfig)
+			msg.SetDefaults()
+		}
+		fs.VarP(types.Presence(&x.Tls, msg), builder.Build("tls"), "", "Enable TLS")
+
+		_ = flags.AddPresenceFlags(fs, builder.Build("tls"), flags.FlaggerFunc(msg.AddFlags), append(opts, flags.WithPrefix("tls"))...)
+	}
+
+	{
+		msg := x.Owner
+		if msg == nil {
+			msg = new(Profile)
+			if v, ok := interface{}(msg).(flags.Defaulter); ok {
+				v.SetDefaults()
+			}
+		}
+		fs.VarP(types.Presence(&x.Owner, msg), builder.Build("owner"), "", "Set an empty owner")
+
+		_ = flags.AddPresenceFlags(fs, builder.Build("owner"), nil)
+	}
+
+	{
+		msg := x.DryRun
+		if msg == nil {
+			msg = new(emptypb.Empty)
+			if v, ok := interface{}(msg).(flags.Defaulter); ok {
+				v.SetDefaults()
+			}
+		}
+		fs.VarP(types.Presence(&x.DryRun, msg), builder.Build("dry-run"), "", "Only print the changes")
+
+		_ = flags.AddPresenceFlags(fs, builder.Build("dry-run"), nil)
+	}
+
+}
+
+func (x *PresenceTestMessage) SetDefaults() {
+	if x.Tls != nil {
+		x.Tls.SetDefaults()
+	}
+
+	if v, ok := interface{}(x.Owner).(flags.Defaulter); ok && x.Owner != nil {
+		v.SetDefaults()
+	}
+
+	if v, ok := interface{}(x.DryRun).(flags.Defaulter); ok && x.DryRun != nil {
+		v.SetDefaults()
+	}
+
+}
+
+func (x *CommonOptions) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.BoolVarP(&x.Verbose, builder.Build("verbose"), "v", x.Verbose, "Verbose output")
+
+	fs.StringVarP(&x.LogLevel, builder.Build("log-level"), "", x.LogLevel, "Log level")
+
+}
+
+func (x *CommonOptions) SetDefaults() {
+	if x.LogLevel == "" {
+		x.LogLevel = "info"
+	}
+
+}
+
+func (x *InlineTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	if x.Common == nil {
+		x.Common = new(CommonOptions)
+	}
+
+	x.Common.AddFlags(fs, opts...)
+
+	fs.StringVarP(&x.Name, builder.Build("name"), "", x.Name, "Service name")
+
+}
+
+func (x *InlineTestMessage) SetDefaults() {
+	if x.Common == nil {
+		x.Common = new(CommonOptions)
+	}
+
+	x.Common.SetDefaults()
+
+}
+
+func (x *RetryPolicy) _AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	fs.Uint32VarP(&x.Attempts, builder.Build("attempts"), "", x.Attempts, "Maximum number of attempts")
+
+	if x.Backoff == nil {
+		x.Backoff = new(durationpb.Duration)
+	}
+
+	fs.VarP(types.Duration(x.Backoff), builder.Build("backoff"), "", "Delay between attempts")
+
+}
+
+func (x *RetryPolicy) _SetDefaults() {
+	if x.Attempts == 0 {
+		x.Attempts = 3
+	}
+
+	if x.Backoff == nil {
+		x.Backoff = &durationpb.Duration{Seconds: 1, Nanos: 0}
+	}
+}
+
+func (x *UnexportedNestedTestMessage) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
+	builder := flags.NewNameBuilder(opts...)
+	_ = builder
+	if x.Retry == nil {
+		x.Retry = new(RetryPolicy)
+	}
+
+	x.Retry._AddFlags(fs, append(opts, flags.WithPrefix("retry"))...)
+
+	{
+		msg := x.FallbackRetry
+		if msg == nil {
+			msg = new(RetryPolicy)
+			msg._SetDefaults()
+		}
+		fs.VarP(types.Presence(&x.FallbackRetry, msg), builder.Build("fallback-retry"), "", "Retry failed requests on the fallback endpoint")
+
+		_ = flags.AddPresenceFlags(fs, builder.Build("fallback-retry"), flags.FlaggerFunc(msg._AddFlags), append(opts, flags.WithPrefix("fallback-retry"))...)
+	}
+
+}
+
+func (x *UnexportedNestedTestMessage) SetDefaults() {
+	if x.Retry == nil {
+		x.Retry = new(RetryPolicy)
+	}
+
+	x.Retry._SetDefaults()
+
+	if x.FallbackRetry != nil {
+		x.FallbackRetry._SetDefaults()
+	}
+
+}
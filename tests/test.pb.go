@@ -7,14 +7,23 @@
 package tests
 
 import (
-	_ "github.com/kunstack/protoc-gen-flags/flags"
+	_ "github.com/kunstack/protoc-gen-flags/flags/annotations"
 	types "github.com/kunstack/protoc-gen-flags/tests/types"
 	utils "github.com/kunstack/protoc-gen-flags/tests/utils"
 	utils1 "github.com/kunstack/protoc-gen-flags/tests/utils/utils"
 	wrapperspb "github.com/kunstack/protoc-gen-flags/tests/wrapperspb"
+	date "google.golang.org/genproto/googleapis/type/date"
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	latlng "google.golang.org/genproto/googleapis/type/latlng"
+	money "google.golang.org/genproto/googleapis/type/money"
+	timeofday "google.golang.org/genproto/googleapis/type/timeofday"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb1 "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -2207,1151 +2216,3807 @@ func (x *RepeatedBytesTestMessage) GetSpecialB64() [][]byte {
 	return nil
 }
 
+// Dedicated message for testing the split setting of repeated flags
+type RepeatedSplitTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test native repeated strings parsed as one CSV record per argument
+	CsvTags []string `protobuf:"bytes,1,rep,name=csv_tags,json=csvTags,proto3" json:"csv_tags,omitempty"`
+	// Test native repeated strings where each argument is a single item
+	ArrayLabels []string `protobuf:"bytes,2,rep,name=array_labels,json=arrayLabels,proto3" json:"array_labels,omitempty"`
+	// Test PATH-like values split on a custom separator
+	SearchPaths []string `protobuf:"bytes,3,rep,name=search_paths,json=searchPaths,proto3" json:"search_paths,omitempty"`
+	// Test numeric values given as a JSON array
+	JsonPorts []int32 `protobuf:"varint,4,rep,packed,name=json_ports,json=jsonPorts,proto3" json:"json_ports,omitempty"`
+	// Test native uint32 and uint64 values, which pflag has no binding for
+	Ids       []uint32 `protobuf:"varint,5,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Checksums []uint64 `protobuf:"fixed64,6,rep,packed,name=checksums,proto3" json:"checksums,omitempty"`
+	// Test wrapper values with a separator
+	Sizes []*wrapperspb1.UInt64Value `protobuf:"bytes,7,rep,name=sizes,proto3" json:"sizes,omitempty"`
+	// Test repeated durations where each argument is a single item
+	Backoffs []*durationpb.Duration `protobuf:"bytes,8,rep,name=backoffs,proto3" json:"backoffs,omitempty"`
+	// Test repeated hex bytes given as CSV
+	Keys [][]byte `protobuf:"bytes,9,rep,name=keys,proto3" json:"keys,omitempty"`
+	// Test repeated enums given as a JSON array
+	Modes []TestEnum1 `protobuf:"varint,10,rep,packed,name=modes,proto3,enum=tests.TestEnum1" json:"modes,omitempty"`
+	// Test repeated timestamps split on '|'
+	Windows []*timestamppb.Timestamp `protobuf:"bytes,11,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *RepeatedSplitTestMessage) Reset() {
+	*x = RepeatedSplitTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedSplitTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedSplitTestMessage) ProtoMessage() {}
+
+func (x *RepeatedSplitTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedSplitTestMessage.ProtoReflect.Descriptor instead.
+func (*RepeatedSplitTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RepeatedSplitTestMessage) GetCsvTags() []string {
+	if x != nil {
+		return x.CsvTags
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetArrayLabels() []string {
+	if x != nil {
+		return x.ArrayLabels
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetSearchPaths() []string {
+	if x != nil {
+		return x.SearchPaths
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetJsonPorts() []int32 {
+	if x != nil {
+		return x.JsonPorts
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetChecksums() []uint64 {
+	if x != nil {
+		return x.Checksums
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetSizes() []*wrapperspb1.UInt64Value {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetBackoffs() []*durationpb.Duration {
+	if x != nil {
+		return x.Backoffs
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetKeys() [][]byte {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetModes() []TestEnum1 {
+	if x != nil {
+		return x.Modes
+	}
+	return nil
+}
+
+func (x *RepeatedSplitTestMessage) GetWindows() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+// Dedicated message for testing the merge, unique and sorted settings of repeated flags
+type RepeatedMergeTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test command-line items appended after the defaults
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Test command-line items inserted before the defaults
+	IncludeDirs []string `protobuf:"bytes,2,rep,name=include_dirs,json=includeDirs,proto3" json:"include_dirs,omitempty"`
+	// Test sorted and deduplicated numeric values
+	Priorities []*wrapperspb1.Int64Value `protobuf:"bytes,3,rep,name=priorities,proto3" json:"priorities,omitempty"`
+	// Test the explicit replace mode
+	Retries []*durationpb.Duration `protobuf:"bytes,4,rep,name=retries,proto3" json:"retries,omitempty"`
+}
+
+func (x *RepeatedMergeTestMessage) Reset() {
+	*x = RepeatedMergeTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedMergeTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedMergeTestMessage) ProtoMessage() {}
+
+func (x *RepeatedMergeTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedMergeTestMessage.ProtoReflect.Descriptor instead.
+func (*RepeatedMergeTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RepeatedMergeTestMessage) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RepeatedMergeTestMessage) GetIncludeDirs() []string {
+	if x != nil {
+		return x.IncludeDirs
+	}
+	return nil
+}
+
+func (x *RepeatedMergeTestMessage) GetPriorities() []*wrapperspb1.Int64Value {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *RepeatedMergeTestMessage) GetRetries() []*durationpb.Duration {
+	if x != nil {
+		return x.Retries
+	}
+	return nil
+}
+
+// Dedicated message for testing range expansion of repeated integer flags
+type RepeatedRangesTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test native int32 values with ranges (e.g., --cpus=0-3,8)
+	Cpus []int32 `protobuf:"varint,1,rep,packed,name=cpus,proto3" json:"cpus,omitempty"`
+	// Test stepped ranges with a size cap
+	Ports []uint32 `protobuf:"varint,2,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+	// Test wrapper values with ranges
+	Shards []*wrapperspb1.Int64Value `protobuf:"bytes,3,rep,name=shards,proto3" json:"shards,omitempty"`
+	// Test sint and fixed variants
+	Offsets []int64  `protobuf:"zigzag64,4,rep,packed,name=offsets,proto3" json:"offsets,omitempty"`
+	Slots   []uint32 `protobuf:"fixed32,5,rep,packed,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *RepeatedRangesTestMessage) Reset() {
+	*x = RepeatedRangesTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedRangesTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedRangesTestMessage) ProtoMessage() {}
+
+func (x *RepeatedRangesTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedRangesTestMessage.ProtoReflect.Descriptor instead.
+func (*RepeatedRangesTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RepeatedRangesTestMessage) GetCpus() []int32 {
+	if x != nil {
+		return x.Cpus
+	}
+	return nil
+}
+
+func (x *RepeatedRangesTestMessage) GetPorts() []uint32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *RepeatedRangesTestMessage) GetShards() []*wrapperspb1.Int64Value {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+func (x *RepeatedRangesTestMessage) GetOffsets() []int64 {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+func (x *RepeatedRangesTestMessage) GetSlots() []uint32 {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// Dedicated message for testing unit-aware numeric flags
+type UnitTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test byte sizes on a native int64 (e.g., --max-memory=512MiB)
+	MaxMemory int64 `protobuf:"varint,1,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	// Test SI multipliers on a native uint32
+	Rate uint32 `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Test percentages on a float
+	Threshold float32 `protobuf:"fixed32,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Test byte sizes on a wrapper type
+	BufferSize *wrapperspb1.Int64Value `protobuf:"bytes,4,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	// Test byte sizes on an optional field
+	DiskQuota *uint64 `protobuf:"varint,5,opt,name=disk_quota,json=diskQuota,proto3,oneof" json:"disk_quota,omitempty"`
+	// Test fractional SI values on a double
+	Interval float64 `protobuf:"fixed64,6,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *UnitTestMessage) Reset() {
+	*x = UnitTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnitTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitTestMessage) ProtoMessage() {}
+
+func (x *UnitTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitTestMessage.ProtoReflect.Descriptor instead.
+func (*UnitTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UnitTestMessage) GetMaxMemory() int64 {
+	if x != nil {
+		return x.MaxMemory
+	}
+	return 0
+}
+
+func (x *UnitTestMessage) GetRate() uint32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *UnitTestMessage) GetThreshold() float32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *UnitTestMessage) GetBufferSize() *wrapperspb1.Int64Value {
+	if x != nil {
+		return x.BufferSize
+	}
+	return nil
+}
+
+func (x *UnitTestMessage) GetDiskQuota() uint64 {
+	if x != nil && x.DiskQuota != nil {
+		return *x.DiskQuota
+	}
+	return 0
+}
+
+func (x *UnitTestMessage) GetInterval() float64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+// Dedicated message for testing duration flags on integer fields
+type IntegerDurationTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test milliseconds stored in a native int64 (e.g., --timeout=1m30s)
+	TimeoutMs int64 `protobuf:"varint,1,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Test seconds stored in a native uint32
+	TtlSeconds uint32 `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// Test minutes stored in a wrapper type
+	IntervalMinutes *wrapperspb1.Int32Value `protobuf:"bytes,3,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"`
+	// Test nanoseconds stored in an optional sfixed64
+	GraceNs *int64 `protobuf:"fixed64,4,opt,name=grace_ns,json=graceNs,proto3,oneof" json:"grace_ns,omitempty"`
+}
+
+func (x *IntegerDurationTestMessage) Reset() {
+	*x = IntegerDurationTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntegerDurationTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegerDurationTestMessage) ProtoMessage() {}
+
+func (x *IntegerDurationTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegerDurationTestMessage.ProtoReflect.Descriptor instead.
+func (*IntegerDurationTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *IntegerDurationTestMessage) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *IntegerDurationTestMessage) GetTtlSeconds() uint32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *IntegerDurationTestMessage) GetIntervalMinutes() *wrapperspb1.Int32Value {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return nil
+}
+
+func (x *IntegerDurationTestMessage) GetGraceNs() int64 {
+	if x != nil && x.GraceNs != nil {
+		return *x.GraceNs
+	}
+	return 0
+}
+
+// Dedicated message for testing the extended duration grammar
+type ExtendedDurationTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test days in the default (e.g., --retention=2w)
+	Retention *durationpb.Duration `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	// Test ISO-8601 defaults on repeated durations
+	Schedule []*durationpb.Duration `protobuf:"bytes,2,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	// Test weeks stored in an integer field
+	RetentionHours int64 `protobuf:"varint,3,opt,name=retention_hours,json=retentionHours,proto3" json:"retention_hours,omitempty"`
+}
+
+func (x *ExtendedDurationTestMessage) Reset() {
+	*x = ExtendedDurationTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendedDurationTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendedDurationTestMessage) ProtoMessage() {}
+
+func (x *ExtendedDurationTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendedDurationTestMessage.ProtoReflect.Descriptor instead.
+func (*ExtendedDurationTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExtendedDurationTestMessage) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *ExtendedDurationTestMessage) GetSchedule() []*durationpb.Duration {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ExtendedDurationTestMessage) GetRetentionHours() int64 {
+	if x != nil {
+		return x.RetentionHours
+	}
+	return 0
+}
+
+// Dedicated message for testing relative and time-zone-aware timestamps
+type RelativeTimestampTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test relative defaults (e.g., --since=now-15m or --since=yesterday+6h)
+	Since *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	// Test a timezone for layouts without an offset
+	Maintenance *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	// Test repeated timestamps with a timezone and relative defaults
+	Checkpoints []*timestamppb.Timestamp `protobuf:"bytes,3,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (x *RelativeTimestampTestMessage) Reset() {
+	*x = RelativeTimestampTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelativeTimestampTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelativeTimestampTestMessage) ProtoMessage() {}
+
+func (x *RelativeTimestampTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelativeTimestampTestMessage.ProtoReflect.Descriptor instead.
+func (*RelativeTimestampTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RelativeTimestampTestMessage) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *RelativeTimestampTestMessage) GetMaintenance() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+func (x *RelativeTimestampTestMessage) GetCheckpoints() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return nil
+}
+
+type StringFormatTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test an IP address (e.g., --bind-ip=2001:DB8::1 stores "2001:db8::1")
+	BindIp string `protobuf:"bytes,1,opt,name=bind_ip,json=bindIp,proto3" json:"bind_ip,omitempty"`
+	// Test a CIDR default stored with the host bits cleared
+	Subnet string `protobuf:"bytes,2,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// Test a host:port wrapper field
+	Upstream *wrapperspb1.StringValue `protobuf:"bytes,3,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	// Test an optional URL
+	Endpoint *string `protobuf:"bytes,4,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
+	// Test repeated CIDRs with defaults
+	Trusted []string `protobuf:"bytes,5,rep,name=trusted,proto3" json:"trusted,omitempty"`
+	// Test repeated email wrappers
+	Admins []*wrapperspb1.StringValue `protobuf:"bytes,6,rep,name=admins,proto3" json:"admins,omitempty"`
+}
+
+func (x *StringFormatTestMessage) Reset() {
+	*x = StringFormatTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringFormatTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringFormatTestMessage) ProtoMessage() {}
+
+func (x *StringFormatTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringFormatTestMessage.ProtoReflect.Descriptor instead.
+func (*StringFormatTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StringFormatTestMessage) GetBindIp() string {
+	if x != nil {
+		return x.BindIp
+	}
+	return ""
+}
+
+func (x *StringFormatTestMessage) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *StringFormatTestMessage) GetUpstream() *wrapperspb1.StringValue {
+	if x != nil {
+		return x.Upstream
+	}
+	return nil
+}
+
+func (x *StringFormatTestMessage) GetEndpoint() string {
+	if x != nil && x.Endpoint != nil {
+		return *x.Endpoint
+	}
+	return ""
+}
+
+func (x *StringFormatTestMessage) GetTrusted() []string {
+	if x != nil {
+		return x.Trusted
+	}
+	return nil
+}
+
+func (x *StringFormatTestMessage) GetAdmins() []*wrapperspb1.StringValue {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+type PathTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test a configuration file with extension completion (e.g., --config=~/app.yaml)
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// Test a directory resolved against a base
+	DataDir *wrapperspb1.StringValue `protobuf:"bytes,2,opt,name=data_dir,json=dataDir,proto3" json:"data_dir,omitempty"`
+	// Test an optional output path that must not exist
+	Output *string `protobuf:"bytes,3,opt,name=output,proto3,oneof" json:"output,omitempty"`
+	// Test repeated include directories with defaults
+	Includes []string `protobuf:"bytes,4,rep,name=includes,proto3" json:"includes,omitempty"`
+}
+
+func (x *PathTestMessage) Reset() {
+	*x = PathTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathTestMessage) ProtoMessage() {}
+
+func (x *PathTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathTestMessage.ProtoReflect.Descriptor instead.
+func (*PathTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PathTestMessage) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *PathTestMessage) GetDataDir() *wrapperspb1.StringValue {
+	if x != nil {
+		return x.DataDir
+	}
+	return nil
+}
+
+func (x *PathTestMessage) GetOutput() string {
+	if x != nil && x.Output != nil {
+		return *x.Output
+	}
+	return ""
+}
+
+func (x *PathTestMessage) GetIncludes() []string {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+type StringChoicesTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test choices with a default (e.g., --compression=zstd)
+	Compression string `protobuf:"bytes,1,opt,name=compression,proto3" json:"compression,omitempty"`
+	// Test case-insensitive choices on a wrapper, the default is stored as declared
+	Level *wrapperspb1.StringValue `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// Test repeated choices
+	Features []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *StringChoicesTestMessage) Reset() {
+	*x = StringChoicesTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringChoicesTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringChoicesTestMessage) ProtoMessage() {}
+
+func (x *StringChoicesTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringChoicesTestMessage.ProtoReflect.Descriptor instead.
+func (*StringChoicesTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StringChoicesTestMessage) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *StringChoicesTestMessage) GetLevel() *wrapperspb1.StringValue {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
+func (x *StringChoicesTestMessage) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type FileRefTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test a secret read from a file (e.g., --password=@/run/secrets/db)
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// Test a wrapper string with a format
+	Endpoint *wrapperspb1.StringValue `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Test hex bytes read from stdin (e.g., --key=- < key.hex)
+	Key []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Test a base64 bytes wrapper
+	Cert *wrapperspb1.BytesValue `protobuf:"bytes,4,opt,name=cert,proto3" json:"cert,omitempty"`
+	// Test repeated strings, one item per line
+	Tokens []string `protobuf:"bytes,5,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// Test repeated bytes, one item per line
+	Salts [][]byte `protobuf:"bytes,6,rep,name=salts,proto3" json:"salts,omitempty"`
+}
+
+func (x *FileRefTestMessage) Reset() {
+	*x = FileRefTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileRefTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileRefTestMessage) ProtoMessage() {}
+
+func (x *FileRefTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileRefTestMessage.ProtoReflect.Descriptor instead.
+func (*FileRefTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FileRefTestMessage) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *FileRefTestMessage) GetEndpoint() *wrapperspb1.StringValue {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *FileRefTestMessage) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *FileRefTestMessage) GetCert() *wrapperspb1.BytesValue {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *FileRefTestMessage) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *FileRefTestMessage) GetSalts() [][]byte {
+	if x != nil {
+		return x.Salts
+	}
+	return nil
+}
+
+type SensitiveTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test a sensitive string with a default
+	ApiKey string `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Test a sensitive bytes wrapper read from a file
+	Secret *wrapperspb1.BytesValue `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Test a sensitive, hidden repeated string
+	Tokens []string `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// Test a sensitive map
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SensitiveTestMessage) Reset() {
+	*x = SensitiveTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SensitiveTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitiveTestMessage) ProtoMessage() {}
+
+func (x *SensitiveTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitiveTestMessage.ProtoReflect.Descriptor instead.
+func (*SensitiveTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SensitiveTestMessage) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *SensitiveTestMessage) GetSecret() *wrapperspb1.BytesValue {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *SensitiveTestMessage) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *SensitiveTestMessage) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type BytesEncodingTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test URL-safe base64 bytes with a default
+	Token []byte `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Test an unpadded base64 bytes wrapper
+	Nonce *wrapperspb1.BytesValue `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Test unpadded URL-safe base64 bytes, as found in JWTs
+	JwtPayload []byte `protobuf:"bytes,3,opt,name=jwt_payload,json=jwtPayload,proto3,oneof" json:"jwt_payload,omitempty"`
+	// Test plain text bytes
+	Greeting []byte `protobuf:"bytes,4,opt,name=greeting,proto3" json:"greeting,omitempty"`
+	// Test bytes read from a file path
+	Cert *wrapperspb1.BytesValue `protobuf:"bytes,5,opt,name=cert,proto3" json:"cert,omitempty"`
+	// Test repeated plain text bytes with defaults
+	Labels [][]byte `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty"`
+	// Test repeated bytes wrappers read from files
+	CaCerts []*wrapperspb1.BytesValue `protobuf:"bytes,7,rep,name=ca_certs,json=caCerts,proto3" json:"ca_certs,omitempty"`
+	// Test repeated URL-safe base64 bytes wrappers with defaults
+	Keys []*wrapperspb1.BytesValue `protobuf:"bytes,8,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *BytesEncodingTestMessage) Reset() {
+	*x = BytesEncodingTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BytesEncodingTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BytesEncodingTestMessage) ProtoMessage() {}
+
+func (x *BytesEncodingTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BytesEncodingTestMessage.ProtoReflect.Descriptor instead.
+func (*BytesEncodingTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BytesEncodingTestMessage) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *BytesEncodingTestMessage) GetNonce() *wrapperspb1.BytesValue {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+func (x *BytesEncodingTestMessage) GetJwtPayload() []byte {
+	if x != nil {
+		return x.JwtPayload
+	}
+	return nil
+}
+
+func (x *BytesEncodingTestMessage) GetGreeting() []byte {
+	if x != nil {
+		return x.Greeting
+	}
+	return nil
+}
+
+func (x *BytesEncodingTestMessage) GetCert() *wrapperspb1.BytesValue {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *BytesEncodingTestMessage) GetLabels() [][]byte {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *BytesEncodingTestMessage) GetCaCerts() []*wrapperspb1.BytesValue {
+	if x != nil {
+		return x.CaCerts
+	}
+	return nil
+}
+
+func (x *BytesEncodingTestMessage) GetKeys() []*wrapperspb1.BytesValue {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type StructTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test a Struct with a JSON default and dotted overrides (e.g., --extra.timeout=5)
+	Extra *structpb.Struct `protobuf:"bytes,1,opt,name=extra,proto3" json:"extra,omitempty"`
+	// Test a Value holding any JSON value
+	Metadata *structpb.Value `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Test a ListValue
+	Args *structpb.ListValue `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	// Test a sensitive Struct without a default
+	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *StructTestMessage) Reset() {
+	*x = StructTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructTestMessage) ProtoMessage() {}
+
+func (x *StructTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructTestMessage.ProtoReflect.Descriptor instead.
+func (*StructTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StructTestMessage) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *StructTestMessage) GetMetadata() *structpb.Value {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StructTestMessage) GetArgs() *structpb.ListValue {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *StructTestMessage) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// Profile is the target message of FieldMaskTestMessage.
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayName string           `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Address     *Profile_Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Tags        []string         `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetAddress() *Profile_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Profile) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type FieldMaskTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test a field mask with a target relative to the package and defaults in JSON names
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,1,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Test a field mask with a fully qualified nested target
+	AddressMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=address_mask,json=addressMask,proto3" json:"address_mask,omitempty"`
+}
+
+func (x *FieldMaskTestMessage) Reset() {
+	*x = FieldMaskTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldMaskTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldMaskTestMessage) ProtoMessage() {}
+
+func (x *FieldMaskTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldMaskTestMessage.ProtoReflect.Descriptor instead.
+func (*FieldMaskTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FieldMaskTestMessage) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *FieldMaskTestMessage) GetAddressMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.AddressMask
+	}
+	return nil
+}
+
+// S3Config and GCSConfig are provider configurations packed in AnyTestMessage.
+type S3Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *S3Config) Reset() {
+	*x = S3Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S3Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S3Config) ProtoMessage() {}
+
+func (x *S3Config) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S3Config.ProtoReflect.Descriptor instead.
+func (*S3Config) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *S3Config) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *S3Config) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type GCSConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *GCSConfig) Reset() {
+	*x = GCSConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GCSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCSConfig) ProtoMessage() {}
+
+func (x *GCSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCSConfig.ProtoReflect.Descriptor instead.
+func (*GCSConfig) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GCSConfig) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type AnyTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test an Any restricted to the provider configurations, with a default
+	Provider *anypb.Any `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Test an Any accepting any registered message
+	Extension *anypb.Any `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+}
+
+func (x *AnyTestMessage) Reset() {
+	*x = AnyTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnyTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnyTestMessage) ProtoMessage() {}
+
+func (x *AnyTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnyTestMessage.ProtoReflect.Descriptor instead.
+func (*AnyTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AnyTestMessage) GetProvider() *anypb.Any {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *AnyTestMessage) GetExtension() *anypb.Any {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+// GoogleTypeTestMessage tests the flags of the google.type common messages
+type GoogleTypeTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test a date with a default
+	StartDate *date.Date `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Test a time of day with a default
+	OpeningTime *timeofday.TimeOfDay `protobuf:"bytes,2,opt,name=opening_time,json=openingTime,proto3" json:"opening_time,omitempty"`
+	// Test an amount of money with a default
+	Price *money.Money `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Test a location without default
+	Location *latlng.LatLng `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// Test a decimal with a default
+	TaxRate *decimal.Decimal `protobuf:"bytes,5,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+}
+
+func (x *GoogleTypeTestMessage) Reset() {
+	*x = GoogleTypeTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoogleTypeTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleTypeTestMessage) ProtoMessage() {}
+
+func (x *GoogleTypeTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleTypeTestMessage.ProtoReflect.Descriptor instead.
+func (*GoogleTypeTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GoogleTypeTestMessage) GetStartDate() *date.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GoogleTypeTestMessage) GetOpeningTime() *timeofday.TimeOfDay {
+	if x != nil {
+		return x.OpeningTime
+	}
+	return nil
+}
+
+func (x *GoogleTypeTestMessage) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *GoogleTypeTestMessage) GetLocation() *latlng.LatLng {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GoogleTypeTestMessage) GetTaxRate() *decimal.Decimal {
+	if x != nil {
+		return x.TaxRate
+	}
+	return nil
+}
+
+// TLSConfig is set with per-field flags and as a whole in LiteralTestMessage
+type TLSConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CertFile     string   `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile      string   `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	CipherSuites []string `protobuf:"bytes,3,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
+}
+
+func (x *TLSConfig) Reset() {
+	*x = TLSConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSConfig) ProtoMessage() {}
+
+func (x *TLSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSConfig.ProtoReflect.Descriptor instead.
+func (*TLSConfig) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TLSConfig) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *TLSConfig) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *TLSConfig) GetCipherSuites() []string {
+	if x != nil {
+		return x.CipherSuites
+	}
+	return nil
+}
+
+type LiteralTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test a literal combined with the per-field flags
+	Tls *TLSConfig `protobuf:"bytes,1,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Test a literal without per-field flags
+	Owner *Profile `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *LiteralTestMessage) Reset() {
+	*x = LiteralTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiteralTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiteralTestMessage) ProtoMessage() {}
+
+func (x *LiteralTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiteralTestMessage.ProtoReflect.Descriptor instead.
+func (*LiteralTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LiteralTestMessage) GetTls() *TLSConfig {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *LiteralTestMessage) GetOwner() *Profile {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+type PresenceTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test an optional message enabled by --tls or any --tls.* flag
+	Tls *TLSConfig `protobuf:"bytes,1,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Test an optional message without nested flags
+	Owner *Profile `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Test the presence flag implied for google.protobuf.Empty
+	DryRun *emptypb.Empty `protobuf:"bytes,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *PresenceTestMessage) Reset() {
+	*x = PresenceTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresenceTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceTestMessage) ProtoMessage() {}
+
+func (x *PresenceTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceTestMessage.ProtoReflect.Descriptor instead.
+func (*PresenceTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PresenceTestMessage) GetTls() *TLSConfig {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *PresenceTestMessage) GetOwner() *Profile {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *PresenceTestMessage) GetDryRun() *emptypb.Empty {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+// CommonOptions is shared by several configurations and inlined into them
+type CommonOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verbose  bool   `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	LogLevel string `protobuf:"bytes,2,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+}
+
+func (x *CommonOptions) Reset() {
+	*x = CommonOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommonOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommonOptions) ProtoMessage() {}
+
+func (x *CommonOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommonOptions.ProtoReflect.Descriptor instead.
+func (*CommonOptions) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CommonOptions) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *CommonOptions) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+type InlineTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test a message whose flags are registered without prefix
+	Common *CommonOptions `protobuf:"bytes,1,opt,name=common,proto3" json:"common,omitempty"`
+	Name   string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *InlineTestMessage) Reset() {
+	*x = InlineTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InlineTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InlineTestMessage) ProtoMessage() {}
+
+func (x *InlineTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InlineTestMessage.ProtoReflect.Descriptor instead.
+func (*InlineTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InlineTestMessage) GetCommon() *CommonOptions {
+	if x != nil {
+		return x.Common
+	}
+	return nil
+}
+
+func (x *InlineTestMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// RetryPolicy only exposes its flags through the messages embedding it
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempts uint32               `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Backoff  *durationpb.Duration `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RetryPolicy) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
+type UnexportedNestedTestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Test an unexported message used as nested flags
+	Retry *RetryPolicy `protobuf:"bytes,1,opt,name=retry,proto3" json:"retry,omitempty"`
+	// Test an unexported message behind a presence flag
+	FallbackRetry *RetryPolicy `protobuf:"bytes,2,opt,name=fallback_retry,json=fallbackRetry,proto3" json:"fallback_retry,omitempty"`
+}
+
+func (x *UnexportedNestedTestMessage) Reset() {
+	*x = UnexportedNestedTestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnexportedNestedTestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnexportedNestedTestMessage) ProtoMessage() {}
+
+func (x *UnexportedNestedTestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnexportedNestedTestMessage.ProtoReflect.Descriptor instead.
+func (*UnexportedNestedTestMessage) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UnexportedNestedTestMessage) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *UnexportedNestedTestMessage) GetFallbackRetry() *RetryPolicy {
+	if x != nil {
+		return x.FallbackRetry
+	}
+	return nil
+}
+
+type Profile_Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City    string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	ZipCode string `protobuf:"bytes,2,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+}
+
+func (x *Profile_Address) Reset() {
+	*x = Profile_Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_test_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile_Address) ProtoMessage() {}
+
+func (x *Profile_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_test_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile_Address.ProtoReflect.Descriptor instead.
+func (*Profile_Address) Descriptor() ([]byte, []int) {
+	return file_tests_test_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *Profile_Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Profile_Address) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
 var File_tests_test_proto protoreflect.FileDescriptor
 
 var file_tests_test_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x17, 0x66, 0x6c, 0x61, 0x67, 0x73,
 	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73,
-	0x2f, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x65,
-	0x73, 0x74, 0x73, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f,
-	0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x65, 0x73,
-	0x74, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x77, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70,
-	0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x27, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74,
-	0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x57, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x42, 0x08, 0x9a, 0x49, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0d, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x0e,
-	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x3c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x75, 0x74, 0x69,
-	0x6c, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x42, 0x1a, 0x9a, 0x49, 0x17, 0xaa, 0x01, 0x14, 0x08, 0x01, 0x12, 0x10, 0x73, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x2d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x78, 0x31, 0x52, 0x0d, 0x73, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x70, 0x0a, 0x05, 0x68,
-	0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x42, 0x5a, 0x9a, 0x49, 0x57, 0x0a,
-	0x55, 0x12, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x1a, 0x01, 0x68, 0x22, 0x14, 0x48, 0x65, 0x6c,
-	0x6c, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x20, 0x27, 0x22, 0x27, 0x20, 0x66, 0x6c, 0x61,
-	0x67, 0x28, 0x01, 0x30, 0x01, 0x3a, 0x2f, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x6c, 0x61, 0x67,
-	0x20, 0x69, 0x73, 0x20, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x2c, 0x20,
-	0x75, 0x73, 0x65, 0x20, 0x2d, 0x2d, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x69,
-	0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x82, 0x01,
-	0x0a, 0x05, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6c, 0x9a,
-	0x49, 0x69, 0x72, 0x67, 0x12, 0x05, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x22, 0x2d, 0x57, 0x6f, 0x72,
-	0x6c, 0x64, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x28, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65,
-	0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x70, 0x6c, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x29, 0x30, 0x01, 0x3a, 0x2d, 0x54, 0x68,
-	0x69, 0x73, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x20, 0x69, 0x73, 0x20, 0x64, 0x65, 0x70, 0x72, 0x65,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x20, 0x2d, 0x2d, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72,
-	0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x9a, 0x49, 0x2c, 0x72, 0x2a, 0x12, 0x08, 0x67, 0x72, 0x65,
-	0x65, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x01, 0x67, 0x22, 0x1b, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x4c, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x36,
-	0x9a, 0x49, 0x33, 0x1a, 0x31, 0x12, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x01, 0x63, 0x22,
-	0x25, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28,
-	0x9a, 0x49, 0x25, 0x6a, 0x23, 0x12, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x1a, 0x01,
-	0x76, 0x22, 0x15, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
-	0x65, 0x20, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
-	0x65, 0x12, 0x53, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x32, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x10, 0x42, 0x37, 0x9a, 0x49, 0x34, 0x62, 0x32, 0x12, 0x08, 0x76, 0x65, 0x72, 0x62,
-	0x6f, 0x73, 0x65, 0x32, 0x1a, 0x01, 0x56, 0x22, 0x23, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20,
-	0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x20, 0x77,
-	0x69, 0x74, 0x68, 0x20, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x08, 0x76, 0x65,
-	0x72, 0x62, 0x6f, 0x73, 0x65, 0x32, 0x12, 0x33, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1a, 0x9a, 0x49, 0x17, 0x22, 0x15, 0x12, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x2d, 0x69, 0x64, 0x1a, 0x01, 0x75, 0x22, 0x07, 0x55, 0x73, 0x65, 0x72,
-	0x20, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1b, 0x9a, 0x49, 0x18, 0x2a, 0x16,
-	0x12, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x01, 0x70, 0x22, 0x0b, 0x50, 0x6f, 0x72, 0x74, 0x20,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0x9a, 0x49, 0x1a, 0x32,
-	0x18, 0x12, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x1a, 0x01, 0x73, 0x22, 0x0d, 0x53, 0x69, 0x7a, 0x65,
-	0x20, 0x69, 0x6e, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
-	0x4a, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x62,
-	0x20, 0x01, 0x28, 0x11, 0x42, 0x28, 0x9a, 0x49, 0x25, 0x3a, 0x23, 0x12, 0x0b, 0x74, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x01, 0x74, 0x22, 0x11, 0x54, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b,
-	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x12, 0x42, 0x24,
-	0x9a, 0x49, 0x21, 0x42, 0x1f, 0x12, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x1a, 0x01, 0x54, 0x22, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x20, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x41, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x07,
-	0x42, 0x27, 0x9a, 0x49, 0x24, 0x4a, 0x22, 0x12, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x22, 0x17, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x69, 0x6c,
-	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x4c, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x06, 0x42, 0x2e, 0x9a, 0x49, 0x2b, 0x52, 0x29, 0x12, 0x09, 0x62, 0x61,
-	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x22, 0x1c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x69, 0x74, 0x73, 0x20, 0x70, 0x65, 0x72, 0x20, 0x73,
-	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x12, 0x33, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0f,
-	0x42, 0x1b, 0x9a, 0x49, 0x18, 0x5a, 0x16, 0x12, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
-	0x0c, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x01, 0x42, 0x1c, 0x9a, 0x49, 0x19, 0x12, 0x17, 0x12, 0x05, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x1a, 0x01, 0x72, 0x22, 0x0b, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x20, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x6b, 0x0a, 0x04, 0x62, 0x79, 0x74,
-	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x52, 0x9a, 0x49, 0x4f, 0x7a, 0x4d, 0x12, 0x04,
-	0x62, 0x79, 0x74, 0x65, 0x1a, 0x01, 0x62, 0x22, 0x1c, 0x42, 0x79, 0x74, 0x65, 0x20, 0x64, 0x61,
-	0x74, 0x61, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x65, 0x6e, 0x63,
-	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x40, 0x02, 0x4a, 0x22, 0x30, 0x30, 0x30, 0x30, 0x35, 0x34, 0x36,
-	0x35, 0x37, 0x33, 0x37, 0x34, 0x32, 0x30, 0x34, 0x38, 0x36, 0x35, 0x36, 0x63, 0x36, 0x63, 0x36,
-	0x66, 0x32, 0x30, 0x35, 0x34, 0x36, 0x35, 0x37, 0x38, 0x37, 0x34, 0x48, 0x00, 0x52, 0x04, 0x62,
-	0x79, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x75, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x4f, 0x9a, 0x49, 0x4c,
-	0x7a, 0x4a, 0x12, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x1a,
-	0x02, 0x63, 0x64, 0x22, 0x23, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36,
-	0x34, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x01, 0x4a, 0x10, 0x61, 0x47, 0x56, 0x73,
-	0x62, 0x47, 0x38, 0x67, 0x64, 0x32, 0x39, 0x79, 0x62, 0x47, 0x51, 0x3d, 0x48, 0x01, 0x52, 0x0a,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a,
-	0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x22, 0x20, 0x01, 0x28,
-	0x0c, 0x42, 0x33, 0x9a, 0x49, 0x30, 0x7a, 0x2e, 0x12, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x2d, 0x6b, 0x65, 0x79, 0x1a, 0x02, 0x73, 0x6b, 0x22, 0x18, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x20, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x65, 0x78, 0x20, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x28, 0x01, 0x40, 0x02, 0x48, 0x02, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x5a, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x23, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x39, 0x9a, 0x49, 0x36,
-	0x8a, 0x01, 0x33, 0x7a, 0x31, 0x12, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x2d, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x73, 0x1a, 0x02, 0x66, 0x63, 0x22, 0x1c, 0x46, 0x69, 0x6c, 0x65, 0x20, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x40, 0x01, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e,
-	0x6b, 0x73, 0x12, 0x54, 0x0a, 0x0a, 0x68, 0x65, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73,
-	0x18, 0x24, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x35, 0x9a, 0x49, 0x32, 0x8a, 0x01, 0x2f, 0x7a, 0x2d,
-	0x12, 0x0a, 0x68, 0x65, 0x78, 0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x1a, 0x02, 0x68, 0x63,
-	0x22, 0x19, 0x44, 0x61, 0x74, 0x61, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x20, 0x69, 0x6e,
-	0x20, 0x68, 0x65, 0x78, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x02, 0x52, 0x09, 0x68,
-	0x65, 0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0xba, 0x01, 0x0a, 0x0f, 0x62, 0x61, 0x73,
-	0x65, 0x36, 0x34, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x28, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x74, 0x9a, 0x49, 0x71, 0x8a, 0x01, 0x6e, 0x7a, 0x6c, 0x12, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x36,
-	0x34, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x02, 0x62, 0x64, 0x22, 0x1d,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x65,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x40, 0x01, 0x4a,
-	0x10, 0x61, 0x47, 0x56, 0x73, 0x62, 0x47, 0x38, 0x67, 0x64, 0x32, 0x39, 0x79, 0x62, 0x47, 0x51,
-	0x3d, 0x4a, 0x0c, 0x64, 0x47, 0x56, 0x7a, 0x64, 0x43, 0x42, 0x6b, 0x59, 0x58, 0x52, 0x68, 0x4a,
-	0x14, 0x63, 0x32, 0x46, 0x74, 0x63, 0x47, 0x78, 0x6c, 0x49, 0x47, 0x4e, 0x76, 0x62, 0x6e, 0x52,
-	0x6c, 0x62, 0x6e, 0x51, 0x3d, 0x52, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x0c, 0x68, 0x65, 0x78, 0x5f, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x82, 0x01, 0x9a,
-	0x49, 0x7f, 0x8a, 0x01, 0x7c, 0x7a, 0x7a, 0x12, 0x0c, 0x68, 0x65, 0x78, 0x2d, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x02, 0x68, 0x64, 0x22, 0x1a, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x20, 0x68, 0x65, 0x78, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x40, 0x02, 0x4a, 0x16, 0x34, 0x38, 0x36, 0x35, 0x36, 0x63, 0x36,
-	0x63, 0x36, 0x66, 0x32, 0x30, 0x35, 0x37, 0x36, 0x66, 0x37, 0x32, 0x36, 0x63, 0x36, 0x34, 0x4a,
-	0x12, 0x35, 0x34, 0x36, 0x35, 0x37, 0x33, 0x37, 0x34, 0x32, 0x30, 0x34, 0x34, 0x36, 0x31, 0x37,
-	0x34, 0x36, 0x31, 0x4a, 0x1c, 0x35, 0x33, 0x36, 0x31, 0x36, 0x64, 0x37, 0x30, 0x36, 0x63, 0x36,
-	0x35, 0x32, 0x30, 0x34, 0x33, 0x36, 0x66, 0x36, 0x65, 0x37, 0x34, 0x36, 0x35, 0x36, 0x65, 0x37,
-	0x34, 0x52, 0x0b, 0x68, 0x65, 0x78, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x5b,
-	0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x11, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x6e,
-	0x75, 0x6d, 0x31, 0x42, 0x2c, 0x9a, 0x49, 0x29, 0x8a, 0x01, 0x26, 0x82, 0x01, 0x23, 0x12, 0x09,
-	0x74, 0x65, 0x73, 0x74, 0x2d, 0x65, 0x6e, 0x75, 0x6d, 0x1a, 0x01, 0x65, 0x22, 0x0f, 0x54, 0x65,
-	0x73, 0x74, 0x20, 0x65, 0x6e, 0x75, 0x6d, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x02, 0x01,
-	0x03, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x8c, 0x01, 0x0a, 0x10,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x46, 0x9a, 0x49, 0x43, 0x9a, 0x01, 0x40, 0x12, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x2d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x01, 0x64, 0x22, 0x24,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x33, 0x30, 0x73, 0x2c, 0x20, 0x35, 0x6d, 0x2c,
-	0x20, 0x31, 0x68, 0x29, 0x42, 0x03, 0x33, 0x30, 0x73, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0c, 0x73, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x16, 0x9a, 0x49, 0x13, 0xaa, 0x01, 0x10, 0x08, 0x01,
-	0x12, 0x0c, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b,
-	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x6e, 0x0a, 0x06, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x33,
-	0x9a, 0x49, 0x30, 0x92, 0x01, 0x2d, 0x12, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x01,
-	0x6c, 0x22, 0x1e, 0x4b, 0x65, 0x79, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x20, 0x28, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x29, 0x48, 0x02, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x7b, 0x0a, 0x08, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x3a, 0x9a, 0x49, 0x37, 0x92, 0x01, 0x34, 0x12, 0x08, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x65, 0x72, 0x73, 0x22, 0x28, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d,
-	0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
-	0x20, 0x28, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x29, 0x52, 0x08,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x0a, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x42, 0x9a, 0x49, 0x3f, 0x92, 0x01, 0x3c, 0x12, 0x0a, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x02, 0x73, 0x6d, 0x22, 0x28, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x61,
-	0x70, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x02, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d,
-	0x61, 0x70, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6d, 0x61, 0x70,
-	0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x41, 0x9a, 0x49, 0x3e,
-	0x92, 0x01, 0x3b, 0x12, 0x09, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x03,
-	0x69, 0x33, 0x32, 0x22, 0x27, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52, 0x08,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0x41, 0x9a, 0x49, 0x3e, 0x92, 0x01, 0x3b, 0x12, 0x09, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x03, 0x69, 0x36, 0x34, 0x22, 0x27, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x75,
-	0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x48, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x12, 0x88,
-	0x01, 0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x1b, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74,
-	0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x43, 0x9a, 0x49, 0x40, 0x92, 0x01,
-	0x3d, 0x12, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x03, 0x75,
-	0x33, 0x32, 0x22, 0x28, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x75, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52, 0x09,
-	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x2f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6c, 0x61, 0x74, 0x6c,
+	0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x66, 0x64, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x68, 0x65, 0x6c, 0x6c,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x75,
+	0x74, 0x69, 0x6c, 0x73, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2f, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x21, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70,
+	0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd0, 0x27, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x08,
+	0x9a, 0x49, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x0e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x1a, 0x9a, 0x49, 0x17,
+	0xaa, 0x01, 0x14, 0x08, 0x01, 0x12, 0x10, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2d, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x78, 0x31, 0x52, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x70, 0x0a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x42, 0x5a, 0x9a, 0x49, 0x57, 0x0a, 0x55, 0x12, 0x05, 0x68, 0x65,
+	0x6c, 0x6c, 0x6f, 0x1a, 0x01, 0x68, 0x22, 0x14, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x20, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x20, 0x27, 0x22, 0x27, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x28, 0x01, 0x30, 0x01,
+	0x3a, 0x2f, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x20, 0x69, 0x73, 0x20, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x20, 0x2d,
+	0x2d, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61,
+	0x64, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x82, 0x01, 0x0a, 0x05, 0x77, 0x6f, 0x72,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6c, 0x9a, 0x49, 0x69, 0x72, 0x67, 0x12,
+	0x05, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x22, 0x2d, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x20, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x20, 0x28, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x29, 0x30, 0x01, 0x3a, 0x2d, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x6c,
+	0x61, 0x67, 0x20, 0x69, 0x73, 0x20, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x2c, 0x20, 0x75, 0x73, 0x65, 0x20, 0x2d, 0x2d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x20, 0x69,
+	0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x12, 0x4b, 0x0a,
+	0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2f, 0x9a, 0x49, 0x2c, 0x72, 0x2a, 0x12, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x1a, 0x01, 0x67, 0x22, 0x1b, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x52, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4c, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x36, 0x9a, 0x49, 0x33, 0x1a, 0x31,
+	0x12, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x01, 0x63, 0x22, 0x25, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x72,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62,
+	0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x9a, 0x49, 0x25, 0x6a, 0x23,
+	0x12, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x1a, 0x01, 0x76, 0x22, 0x15, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x20, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x20, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x10, 0x42, 0x37,
+	0x9a, 0x49, 0x34, 0x62, 0x32, 0x12, 0x08, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x32, 0x1a,
+	0x01, 0x56, 0x22, 0x23, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x76, 0x65, 0x72, 0x62, 0x6f,
+	0x73, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x52, 0x08, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65,
+	0x32, 0x12, 0x33, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x1a, 0x9a, 0x49, 0x17, 0x22, 0x15, 0x12, 0x07, 0x75, 0x73, 0x65, 0x72, 0x2d,
+	0x69, 0x64, 0x1a, 0x01, 0x75, 0x22, 0x07, 0x55, 0x73, 0x65, 0x72, 0x20, 0x49, 0x44, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x1b, 0x9a, 0x49, 0x18, 0x2a, 0x16, 0x12, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x1a, 0x01, 0x70, 0x22, 0x0b, 0x50, 0x6f, 0x72, 0x74, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0x9a, 0x49, 0x1a, 0x32, 0x18, 0x12, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x1a, 0x01, 0x73, 0x22, 0x0d, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x62, 0x20, 0x01, 0x28, 0x11, 0x42,
+	0x28, 0x9a, 0x49, 0x25, 0x3a, 0x23, 0x12, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x1a, 0x01, 0x74, 0x22, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x12, 0x42, 0x24, 0x9a, 0x49, 0x21, 0x42, 0x1f,
+	0x12, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x01, 0x54, 0x22, 0x0f,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x07, 0x42, 0x27, 0x9a, 0x49, 0x24,
+	0x4a, 0x22, 0x12, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x17, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x4c, 0x0a,
+	0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x06,
+	0x42, 0x2e, 0x9a, 0x49, 0x2b, 0x52, 0x29, 0x12, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x22, 0x1c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x20, 0x69, 0x6e,
+	0x20, 0x62, 0x69, 0x74, 0x73, 0x20, 0x70, 0x65, 0x72, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x52, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x1b, 0x9a, 0x49, 0x18,
+	0x5a, 0x16, 0x12, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x0c, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x32, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x1c, 0x9a, 0x49, 0x19, 0x12, 0x17, 0x12, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x1a, 0x01, 0x72,
+	0x22, 0x0b, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x6b, 0x0a, 0x04, 0x62, 0x79, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x52, 0x9a, 0x49, 0x4f, 0x7a, 0x4d, 0x12, 0x04, 0x62, 0x79, 0x74, 0x65, 0x1a,
+	0x01, 0x62, 0x22, 0x1c, 0x42, 0x79, 0x74, 0x65, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x69, 0x6e,
+	0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x40, 0x02, 0x4a, 0x22, 0x30, 0x30, 0x30, 0x30, 0x35, 0x34, 0x36, 0x35, 0x37, 0x33, 0x37, 0x34,
+	0x32, 0x30, 0x34, 0x38, 0x36, 0x35, 0x36, 0x63, 0x36, 0x63, 0x36, 0x66, 0x32, 0x30, 0x35, 0x34,
+	0x36, 0x35, 0x37, 0x38, 0x37, 0x34, 0x48, 0x00, 0x52, 0x04, 0x62, 0x79, 0x74, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x75, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x21, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x4f, 0x9a, 0x49, 0x4c, 0x7a, 0x4a, 0x12, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x02, 0x63, 0x64, 0x22, 0x23,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x61,
+	0x74, 0x61, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x40, 0x01, 0x4a, 0x10, 0x61, 0x47, 0x56, 0x73, 0x62, 0x47, 0x38, 0x67, 0x64,
+	0x32, 0x39, 0x79, 0x62, 0x47, 0x51, 0x3d, 0x48, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x33, 0x9a, 0x49,
+	0x30, 0x7a, 0x2e, 0x12, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2d, 0x6b, 0x65, 0x79, 0x1a,
+	0x02, 0x73, 0x6b, 0x22, 0x18, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x6b, 0x65, 0x79, 0x20,
+	0x69, 0x6e, 0x20, 0x68, 0x65, 0x78, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x28, 0x01, 0x40,
+	0x02, 0x48, 0x02, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x5a, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73,
+	0x18, 0x23, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x39, 0x9a, 0x49, 0x36, 0x8a, 0x01, 0x33, 0x7a, 0x31,
+	0x12, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x1a, 0x02, 0x66,
+	0x63, 0x22, 0x1c, 0x46, 0x69, 0x6c, 0x65, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x20, 0x69,
+	0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40,
+	0x01, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x54, 0x0a,
+	0x0a, 0x68, 0x65, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x24, 0x20, 0x03, 0x28,
+	0x0c, 0x42, 0x35, 0x9a, 0x49, 0x32, 0x8a, 0x01, 0x2f, 0x7a, 0x2d, 0x12, 0x0a, 0x68, 0x65, 0x78,
+	0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x1a, 0x02, 0x68, 0x63, 0x22, 0x19, 0x44, 0x61, 0x74,
+	0x61, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x65, 0x78, 0x20,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x02, 0x52, 0x09, 0x68, 0x65, 0x78, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x73, 0x12, 0xba, 0x01, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x74, 0x9a, 0x49, 0x71, 0x8a,
+	0x01, 0x6e, 0x7a, 0x6c, 0x12, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x2d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x02, 0x62, 0x64, 0x22, 0x1d, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x40, 0x01, 0x4a, 0x10, 0x61, 0x47, 0x56, 0x73,
+	0x62, 0x47, 0x38, 0x67, 0x64, 0x32, 0x39, 0x79, 0x62, 0x47, 0x51, 0x3d, 0x4a, 0x0c, 0x64, 0x47,
+	0x56, 0x7a, 0x64, 0x43, 0x42, 0x6b, 0x59, 0x58, 0x52, 0x68, 0x4a, 0x14, 0x63, 0x32, 0x46, 0x74,
+	0x63, 0x47, 0x78, 0x6c, 0x49, 0x47, 0x4e, 0x76, 0x62, 0x6e, 0x52, 0x6c, 0x62, 0x6e, 0x51, 0x3d,
+	0x52, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0xa6, 0x01, 0x0a, 0x0c, 0x68, 0x65, 0x78, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x82, 0x01, 0x9a, 0x49, 0x7f, 0x8a, 0x01, 0x7c,
+	0x7a, 0x7a, 0x12, 0x0c, 0x68, 0x65, 0x78, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x1a, 0x02, 0x68, 0x64, 0x22, 0x1a, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x68, 0x65,
+	0x78, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x40, 0x02, 0x4a, 0x16, 0x34, 0x38, 0x36, 0x35, 0x36, 0x63, 0x36, 0x63, 0x36, 0x66, 0x32, 0x30,
+	0x35, 0x37, 0x36, 0x66, 0x37, 0x32, 0x36, 0x63, 0x36, 0x34, 0x4a, 0x12, 0x35, 0x34, 0x36, 0x35,
+	0x37, 0x33, 0x37, 0x34, 0x32, 0x30, 0x34, 0x34, 0x36, 0x31, 0x37, 0x34, 0x36, 0x31, 0x4a, 0x1c,
+	0x35, 0x33, 0x36, 0x31, 0x36, 0x64, 0x37, 0x30, 0x36, 0x63, 0x36, 0x35, 0x32, 0x30, 0x34, 0x33,
+	0x36, 0x66, 0x36, 0x65, 0x37, 0x34, 0x36, 0x35, 0x36, 0x65, 0x37, 0x34, 0x52, 0x0b, 0x68, 0x65,
+	0x78, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x2c,
+	0x9a, 0x49, 0x29, 0x8a, 0x01, 0x26, 0x82, 0x01, 0x23, 0x12, 0x09, 0x74, 0x65, 0x73, 0x74, 0x2d,
+	0x65, 0x6e, 0x75, 0x6d, 0x1a, 0x01, 0x65, 0x22, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x20, 0x65, 0x6e,
+	0x75, 0x6d, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x02, 0x01, 0x03, 0x52, 0x08, 0x74, 0x65,
+	0x73, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x8c, 0x01, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x46, 0x9a, 0x49,
+	0x43, 0x9a, 0x01, 0x40, 0x12, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x2d, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x01, 0x64, 0x22, 0x24, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x28, 0x65, 0x2e, 0x67,
+	0x2e, 0x2c, 0x20, 0x33, 0x30, 0x73, 0x2c, 0x20, 0x35, 0x6d, 0x2c, 0x20, 0x31, 0x68, 0x29, 0x42,
+	0x03, 0x33, 0x30, 0x73, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0c, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x16, 0x9a, 0x49, 0x13, 0xaa, 0x01, 0x10, 0x08, 0x01, 0x12, 0x0c, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x2d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x6e, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x33, 0x9a, 0x49, 0x30, 0x92, 0x01,
+	0x2d, 0x12, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x01, 0x6c, 0x22, 0x1e, 0x4b, 0x65,
+	0x79, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x28,
+	0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x29, 0x48, 0x02, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x7b, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x3a, 0x9a,
+	0x49, 0x37, 0x92, 0x01, 0x34, 0x12, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0x28, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x69, 0x6e, 0x74, 0x65, 0x67,
+	0x65, 0x72, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x20, 0x28, 0x4a, 0x53, 0x4f,
+	0x4e, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x29, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x61, 0x70, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x42,
+	0x9a, 0x49, 0x3f, 0x92, 0x01, 0x3c, 0x12, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x6d,
+	0x61, 0x70, 0x1a, 0x02, 0x73, 0x6d, 0x22, 0x28, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74,
+	0x6f, 0x2d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x75, 0x73, 0x69,
+	0x6e, 0x67, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x48, 0x02, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x12, 0x83, 0x01,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x19, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x41, 0x9a, 0x49, 0x3e, 0x92, 0x01, 0x3b, 0x12, 0x09,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x03, 0x69, 0x33, 0x32, 0x22, 0x27,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x20,
+	0x6d, 0x61, 0x70, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x4d, 0x61, 0x70, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6d, 0x61,
+	0x70, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x41, 0x9a, 0x49,
+	0x3e, 0x92, 0x01, 0x3b, 0x12, 0x09, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x2d, 0x6d, 0x61, 0x70, 0x1a,
+	0x03, 0x69, 0x36, 0x34, 0x22, 0x27, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x75, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
 	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x45,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x42, 0x43, 0x9a, 0x49, 0x40, 0x92, 0x01, 0x3d, 0x12, 0x0a, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x03, 0x75, 0x36, 0x34, 0x22, 0x28, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x20,
+	0x6e, 0x74, 0x33, 0x32, 0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x03, 0x75, 0x33, 0x32, 0x22, 0x28, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x20,
 	0x6d, 0x61, 0x70, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x4d, 0x61, 0x70, 0x12, 0x93, 0x01, 0x0a, 0x0c, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x48, 0x9a, 0x49, 0x45, 0x92, 0x01, 0x42, 0x12, 0x0c, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x04, 0x73, 0x66, 0x33, 0x32, 0x22,
-	0x2a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52, 0x0b, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x12, 0x93, 0x01, 0x0a, 0x0c, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x1e, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x48, 0x9a, 0x49, 0x45, 0x92, 0x01,
-	0x42, 0x12, 0x0c, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x2d, 0x6d, 0x61, 0x70, 0x1a,
-	0x04, 0x73, 0x66, 0x36, 0x34, 0x22, 0x2a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f,
-	0x2d, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x75, 0x73,
-	0x69, 0x6e, 0x67, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x48, 0x03, 0x52, 0x0b, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4d, 0x61, 0x70,
-	0x12, 0x6d, 0x0a, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x1f, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46,
-	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x4d, 0x61,
-	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x2e, 0x9a, 0x49, 0x2b, 0x92, 0x01, 0x28, 0x12, 0x08,
-	0x6a, 0x73, 0x6f, 0x6e, 0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x01, 0x6a, 0x22, 0x17, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x69, 0x63, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x48, 0x01, 0x52, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x12,
-	0x6a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x09, 0x42, 0x3f, 0x9a, 0x49, 0x3c, 0x8a, 0x01,
-	0x39, 0x72, 0x37, 0x12, 0x10, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x02, 0x72, 0x73, 0x22, 0x1f, 0x52, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x6b, 0x0a, 0x06, 0x64,
-	0x65, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x25, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x38, 0x9a, 0x49, 0x35, 0x8a, 0x01, 0x32, 0x8a, 0x01,
-	0x2f, 0x12, 0x06, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x1a, 0x01, 0x64, 0x22, 0x22, 0x44, 0x65,
-	0x6c, 0x61, 0x79, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x28, 0x65,
-	0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x31, 0x73, 0x2c, 0x20, 0x32, 0x6d, 0x2c, 0x20, 0x33, 0x68, 0x29,
-	0x52, 0x06, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x6f, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x26, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x36, 0x9a, 0x49, 0x33, 0x8a, 0x01, 0x30, 0x8a, 0x01,
-	0x2d, 0x12, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x1a, 0x01, 0x69, 0x22,
-	0x1d, 0x54, 0x69, 0x6d, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x20,
-	0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x09,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x6f, 0x0a, 0x08, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x27, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x38, 0x9a, 0x49, 0x35, 0x8a, 0x01, 0x32, 0x8a, 0x01,
-	0x2f, 0x12, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x1a, 0x01, 0x74, 0x22, 0x20,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x0b, 0x6e, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x75, 0x74,
-	0x69, 0x6c, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x42, 0x15, 0x9a, 0x49, 0x12, 0xaa, 0x01, 0x0f, 0x08, 0x01, 0x12, 0x0b, 0x6e, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64,
-	0x54, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x73, 0x74,
-	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79,
-	0x70, 0x65, 0x42, 0x15, 0x9a, 0x49, 0x12, 0xaa, 0x01, 0x0f, 0x08, 0x01, 0x12, 0x0b, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a,
-	0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x4d, 0x61, 0x70, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x6d, 0x61, 0x70, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x43, 0x9a, 0x49, 0x40, 0x92, 0x01, 0x3d, 0x12, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x2d,
+	0x6d, 0x61, 0x70, 0x1a, 0x03, 0x75, 0x36, 0x34, 0x22, 0x28, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x2d, 0x74, 0x6f, 0x2d, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x75,
+	0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x48, 0x03, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x12,
+	0x93, 0x01, 0x0a, 0x0c, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6d, 0x61, 0x70,
+	0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x48,
+	0x9a, 0x49, 0x45, 0x92, 0x01, 0x42, 0x12, 0x0c, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x04, 0x73, 0x66, 0x33, 0x32, 0x22, 0x2a, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x20, 0x6d,
+	0x61, 0x70, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52, 0x0b, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x4d, 0x61, 0x70, 0x12, 0x93, 0x01, 0x0a, 0x0c, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x48, 0x9a, 0x49, 0x45, 0x92, 0x01, 0x42, 0x12, 0x0c, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x2d, 0x6d, 0x61, 0x70, 0x1a, 0x04, 0x73, 0x66, 0x36, 0x34,
+	0x22, 0x2a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x6f, 0x2d, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x03, 0x52, 0x0b,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x12, 0x6d, 0x0a, 0x08, 0x6a,
+	0x73, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x2e, 0x9a, 0x49, 0x2b, 0x92, 0x01, 0x28, 0x12, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x2d,
+	0x6d, 0x61, 0x70, 0x1a, 0x01, 0x6a, 0x22, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x20,
+	0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x6d, 0x61, 0x70, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48,
+	0x01, 0x52, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x12, 0x6a, 0x0a, 0x10, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x20,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x3f, 0x9a, 0x49, 0x3c, 0x8a, 0x01, 0x39, 0x72, 0x37, 0x12, 0x10,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x1a, 0x02, 0x72, 0x73, 0x22, 0x1f, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x6b, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73,
+	0x18, 0x25, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x38, 0x9a, 0x49, 0x35, 0x8a, 0x01, 0x32, 0x8a, 0x01, 0x2f, 0x12, 0x06, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x73, 0x1a, 0x01, 0x64, 0x22, 0x22, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x20, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20,
+	0x31, 0x73, 0x2c, 0x20, 0x32, 0x6d, 0x2c, 0x20, 0x33, 0x68, 0x29, 0x52, 0x06, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x73, 0x12, 0x6f, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73,
+	0x18, 0x26, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x36, 0x9a, 0x49, 0x33, 0x8a, 0x01, 0x30, 0x8a, 0x01, 0x2d, 0x12, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x1a, 0x01, 0x69, 0x22, 0x1d, 0x54, 0x69, 0x6d, 0x65,
+	0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65,
+	0x65, 0x6e, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x73, 0x12, 0x6f, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73,
+	0x18, 0x27, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x38, 0x9a, 0x49, 0x35, 0x8a, 0x01, 0x32, 0x8a, 0x01, 0x2f, 0x12, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x1a, 0x01, 0x74, 0x22, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x0b, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x4e,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x15, 0x9a, 0x49,
+	0x12, 0xaa, 0x01, 0x0f, 0x08, 0x01, 0x12, 0x0b, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x2d, 0x74,
+	0x65, 0x73, 0x74, 0x52, 0x0a, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x12,
+	0x4f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x33,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x42, 0x15, 0x9a,
+	0x49, 0x12, 0xaa, 0x01, 0x0f, 0x08, 0x01, 0x12, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
 	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
 	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
 	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d,
 	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4d, 0x61, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4d, 0x61, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x10, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x4a, 0x73, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x03, 0xa8,
-	0x49, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xcc, 0x01, 0x0a, 0x0d, 0x53,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x9a, 0x49, 0x18, 0x72,
-	0x16, 0x12, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x89, 0x01,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x4e,
-	0x9a, 0x49, 0x4b, 0xa2, 0x01, 0x48, 0x12, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2d,
-	0x61, 0x74, 0x22, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x42,
-	0x07, 0x49, 0x53, 0x4f, 0x38, 0x36, 0x30, 0x31, 0x4a, 0x14, 0x32, 0x30, 0x32, 0x33, 0x2d, 0x30,
-	0x31, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd5, 0x07, 0x0a, 0x13, 0x57, 0x72,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x4e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1e, 0x9a, 0x49, 0x1b,
-	0x8a, 0x01, 0x18, 0x6a, 0x16, 0x12, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0e, 0x4e, 0x61, 0x6d,
-	0x65, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x73, 0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x2d, 0x9a, 0x49, 0x2a, 0x12, 0x28, 0x12, 0x0c, 0x64, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x02, 0x64, 0x76, 0x22, 0x14,
-	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x77, 0x0a, 0x0d, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3c, 0x0a, 0x0e, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10,
+	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10,
+	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x10, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x4a, 0x73, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xcc, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x9a, 0x49, 0x18, 0x72, 0x16, 0x12, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x34, 0x9a, 0x49, 0x31,
-	0x8a, 0x01, 0x2e, 0x12, 0x2c, 0x12, 0x0d, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x2d, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x03, 0x64, 0x76, 0x73, 0x22, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x70, 0x6c, 0x65, 0x20, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
-	0x93, 0x01, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x42, 0x50, 0x9a, 0x49, 0x4d, 0x7a, 0x4b, 0x12, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x02, 0x62, 0x76, 0x22, 0x24, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20,
-	0x28, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x29,
-	0x40, 0x01, 0x4a, 0x10, 0x61, 0x47, 0x56, 0x73, 0x62, 0x47, 0x38, 0x67, 0x64, 0x32, 0x39, 0x79,
-	0x62, 0x47, 0x51, 0x3d, 0x48, 0x01, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x94, 0x01, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x54, 0x9a, 0x49, 0x51, 0x7a, 0x4f,
-	0x12, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x03,
-	0x62, 0x76, 0x73, 0x22, 0x26, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x28, 0x62, 0x61, 0x73, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x4e, 0x9a, 0x49, 0x4b, 0xa2, 0x01,
+	0x48, 0x12, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x61, 0x74, 0x22, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x42, 0x07, 0x49, 0x53, 0x4f, 0x38,
+	0x36, 0x30, 0x31, 0x4a, 0x14, 0x32, 0x30, 0x32, 0x33, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x54,
+	0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xd5, 0x07, 0x0a, 0x13, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4e, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1e, 0x9a, 0x49, 0x1b, 0x8a, 0x01, 0x18, 0x6a, 0x16,
+	0x12, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x73, 0x0a, 0x0c,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x2d, 0x9a, 0x49, 0x2a, 0x12, 0x28, 0x12, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x2d,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x02, 0x64, 0x76, 0x22, 0x14, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x77, 0x0a, 0x0d, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x34, 0x9a, 0x49, 0x31, 0x8a, 0x01, 0x2e, 0x12, 0x2c,
+	0x12, 0x0d, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a,
+	0x03, 0x64, 0x76, 0x73, 0x22, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x0c, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x0b, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x50, 0x9a,
+	0x49, 0x4d, 0x7a, 0x4b, 0x12, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2d, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x1a, 0x02, 0x62, 0x76, 0x22, 0x24, 0x42, 0x79, 0x74, 0x65, 0x73, 0x20, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x28, 0x62, 0x61, 0x73, 0x65,
 	0x36, 0x34, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x29, 0x40, 0x01, 0x4a, 0x10, 0x61,
-	0x47, 0x56, 0x73, 0x62, 0x47, 0x38, 0x67, 0x64, 0x32, 0x39, 0x79, 0x62, 0x47, 0x51, 0x3d, 0x52,
-	0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x90, 0x01, 0x0a,
-	0x10, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x68, 0x65, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x47, 0x56, 0x73, 0x62, 0x47, 0x38, 0x67, 0x64, 0x32, 0x39, 0x79, 0x62, 0x47, 0x51, 0x3d, 0x48,
+	0x01, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x94, 0x01, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x49, 0x9a, 0x49, 0x46, 0x8a, 0x01, 0x43, 0x7a, 0x41, 0x12, 0x13,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x2d, 0x68, 0x65, 0x78, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x36, 0x36, 0x36, 0x1a, 0x03, 0x62, 0x68, 0x78, 0x22, 0x23, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x65, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
-	0x28, 0x68, 0x65, 0x78, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x29, 0x40, 0x02, 0x52,
-	0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x48, 0x65, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
-	0x9e, 0x01, 0x0a, 0x11, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x68, 0x65, 0x78, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x55, 0x9a, 0x49, 0x52, 0x7a, 0x50, 0x12,
-	0x10, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2d, 0x68, 0x65, 0x78, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x1a, 0x03, 0x62, 0x68, 0x78, 0x22, 0x23, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
-	0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x28, 0x68,
-	0x65, 0x78, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x29, 0x40, 0x01, 0x4a, 0x10, 0x61,
-	0x47, 0x56, 0x73, 0x62, 0x47, 0x38, 0x67, 0x64, 0x32, 0x39, 0x79, 0x62, 0x47, 0x51, 0x3d, 0x52,
-	0x0f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x48, 0x65, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x78,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0xea, 0x04, 0x0a, 0x16, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x6c, 0x69, 0x63,
-	0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x96, 0x01, 0x0a,
-	0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x42, 0x54, 0x9a, 0x49, 0x51, 0x8a, 0x01, 0x4e, 0x12, 0x4c, 0x12, 0x0c, 0x6d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x01, 0x6d, 0x22, 0x39, 0x53, 0x63,
-	0x69, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x20, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x33, 0x2e, 0x31,
-	0x34, 0x31, 0x35, 0x39, 0x2c, 0x20, 0x32, 0x2e, 0x37, 0x31, 0x38, 0x32, 0x38, 0x2c, 0x20, 0x31,
-	0x2e, 0x34, 0x31, 0x34, 0x32, 0x31, 0x29, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x11, 0x73, 0x63, 0x69, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x54, 0x9a, 0x49, 0x51, 0x7a, 0x4f, 0x12, 0x0c, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x03, 0x62, 0x76, 0x73, 0x22, 0x26,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x20, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x28, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x29, 0x40, 0x01, 0x4a, 0x10, 0x61, 0x47, 0x56, 0x73, 0x62, 0x47,
+	0x38, 0x67, 0x64, 0x32, 0x39, 0x79, 0x62, 0x47, 0x51, 0x3d, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x90, 0x01, 0x0a, 0x10, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x68, 0x65, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x49, 0x9a, 0x49, 0x46, 0x8a, 0x01, 0x43, 0x7a, 0x41, 0x12, 0x13, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x2d, 0x68, 0x65, 0x78, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x36, 0x36, 0x36, 0x1a, 0x03,
+	0x62, 0x68, 0x78, 0x22, 0x23, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x28, 0x68, 0x65, 0x78, 0x20,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x29, 0x40, 0x02, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x48, 0x65, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x9e, 0x01, 0x0a, 0x11, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x68, 0x65, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x78,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x55, 0x9a, 0x49, 0x52, 0x7a, 0x50, 0x12, 0x10, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x2d, 0x68, 0x65, 0x78, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x03, 0x62, 0x68,
+	0x78, 0x22, 0x23, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x28, 0x68, 0x65, 0x78, 0x20, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x29, 0x40, 0x01, 0x4a, 0x10, 0x61, 0x47, 0x56, 0x73, 0x62, 0x47,
+	0x38, 0x67, 0x64, 0x32, 0x39, 0x79, 0x62, 0x47, 0x51, 0x3d, 0x52, 0x0f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x48, 0x65, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x78, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xea, 0x04, 0x0a,
+	0x16, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x0c, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x54, 0x9a, 0x49,
+	0x51, 0x8a, 0x01, 0x4e, 0x12, 0x4c, 0x12, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x1a, 0x01, 0x6d, 0x22, 0x39, 0x53, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x20, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x33, 0x2e, 0x31, 0x34, 0x31, 0x35, 0x39, 0x2c,
+	0x20, 0x32, 0x2e, 0x37, 0x31, 0x38, 0x32, 0x38, 0x2c, 0x20, 0x31, 0x2e, 0x34, 0x31, 0x34, 0x32,
+	0x31, 0x29, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x9f, 0x01, 0x0a, 0x11, 0x73, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x54, 0x9a, 0x49, 0x51, 0x8a,
+	0x01, 0x4e, 0x12, 0x4c, 0x12, 0x11, 0x73, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x02, 0x73, 0x76, 0x22, 0x33, 0x53, 0x63, 0x69,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x20, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x31,
+	0x2e, 0x32, 0x33, 0x65, 0x2d, 0x34, 0x2c, 0x20, 0x35, 0x2e, 0x36, 0x37, 0x65, 0x2b, 0x38, 0x29,
+	0x52, 0x10, 0x73, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x54, 0x9a, 0x49, 0x51, 0x8a, 0x01, 0x4e, 0x12, 0x4c, 0x12, 0x11, 0x73, 0x63, 0x69, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x02, 0x73, 0x76,
-	0x22, 0x33, 0x53, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x20, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x28, 0x65, 0x2e,
-	0x67, 0x2e, 0x2c, 0x20, 0x31, 0x2e, 0x32, 0x33, 0x65, 0x2d, 0x34, 0x2c, 0x20, 0x35, 0x2e, 0x36,
-	0x37, 0x65, 0x2b, 0x38, 0x29, 0x52, 0x10, 0x73, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x42, 0x9a, 0x49, 0x3f, 0x8a, 0x01, 0x3c, 0x12, 0x3a, 0x12, 0x14,
-	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2d, 0x72, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x73, 0x1a, 0x01, 0x74, 0x22, 0x1f, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x69, 0x6e,
-	0x20, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x52, 0x13, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x7a, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x3a, 0x9a, 0x49, 0x37, 0x8a, 0x01, 0x34, 0x12, 0x32, 0x12, 0x0b, 0x63, 0x6f, 0x6f, 0x72,
-	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x01, 0x63, 0x22, 0x20, 0x47, 0x50, 0x53, 0x20,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x20, 0x28, 0x6c, 0x61, 0x74,
-	0x2c, 0x20, 0x6c, 0x6f, 0x6e, 0x20, 0x70, 0x61, 0x69, 0x72, 0x73, 0x29, 0x52, 0x0b, 0x63, 0x6f,
-	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x8b,
-	0x04, 0x0a, 0x15, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x77, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x42, 0x9a, 0x49, 0x3f, 0x8a, 0x01, 0x3c, 0x12, 0x3a, 0x12, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2d, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a,
+	0x01, 0x74, 0x22, 0x1f, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x20,
+	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x43, 0x65, 0x6c, 0x73,
+	0x69, 0x75, 0x73, 0x52, 0x13, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x7a, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x39, 0x9a, 0x49, 0x36, 0x8a,
-	0x01, 0x33, 0x7a, 0x31, 0x12, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x73, 0x1a, 0x02, 0x64, 0x63, 0x22, 0x1c, 0x44, 0x61, 0x74, 0x61, 0x20, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x40, 0x01, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x73, 0x12, 0x7f, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x3a, 0x9a, 0x49, 0x37,
+	0x8a, 0x01, 0x34, 0x12, 0x32, 0x12, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x73, 0x1a, 0x01, 0x63, 0x22, 0x20, 0x47, 0x50, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x20, 0x28, 0x6c, 0x61, 0x74, 0x2c, 0x20, 0x6c, 0x6f, 0x6e,
+	0x20, 0x70, 0x61, 0x69, 0x72, 0x73, 0x29, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x8b, 0x04, 0x0a, 0x15, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x77, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x3d, 0x9a, 0x49, 0x3a, 0x8a, 0x01, 0x37, 0x7a, 0x35, 0x12,
-	0x0d, 0x66, 0x69, 0x6c, 0x65, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x02,
-	0x66, 0x63, 0x22, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x73, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x40, 0x01, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x6c, 0x0a, 0x08, 0x68, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x42, 0x34, 0x9a, 0x49, 0x31, 0x8a, 0x01, 0x2e, 0x7a, 0x2c, 0x12, 0x08, 0x68, 0x65, 0x78,
-	0x2d, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x02, 0x68, 0x64, 0x22, 0x1a, 0x44, 0x61, 0x74, 0x61, 0x20,
-	0x69, 0x6e, 0x20, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x20, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x02, 0x52, 0x07, 0x68, 0x65, 0x78, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x84, 0x01, 0x0a, 0x0f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x3e, 0x9a, 0x49, 0x3b, 0x8a, 0x01, 0x38, 0x7a,
-	0x36, 0x12, 0x0f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x2d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x73, 0x1a, 0x02, 0x62, 0x70, 0x22, 0x1d, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x20, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x65, 0x78, 0x20, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x02, 0x52, 0x0e, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xbd, 0x03, 0x0a,
-	0x15, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x42, 0x4b, 0x9a, 0x49,
-	0x48, 0x8a, 0x01, 0x45, 0x0a, 0x43, 0x12, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x1a, 0x01, 0x6d, 0x22, 0x30, 0x53, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x20, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x33, 0x2e, 0x31, 0x34, 0x2c, 0x20, 0x32, 0x2e,
-	0x37, 0x31, 0x2c, 0x20, 0x31, 0x2e, 0x34, 0x31, 0x29, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x5f, 0x0a, 0x0c, 0x63, 0x6f, 0x6f, 0x72, 0x64,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x36, 0x9a,
-	0x49, 0x33, 0x0a, 0x31, 0x12, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
-	0x73, 0x1a, 0x01, 0x63, 0x22, 0x1f, 0x47, 0x50, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x20, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x73, 0x32, 0x88, 0x01, 0x01, 0x12, 0x5e, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x02, 0x42, 0x3a,
-	0x9a, 0x49, 0x37, 0x8a, 0x01, 0x34, 0x0a, 0x32, 0x12, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x01, 0x74, 0x22, 0x1f, 0x54, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x20,
-	0x69, 0x6e, 0x20, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x02, 0x42, 0x3a, 0x9a,
-	0x49, 0x37, 0x8a, 0x01, 0x34, 0x0a, 0x32, 0x12, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x61, 0x67, 0x65, 0x73, 0x1a, 0x01, 0x70, 0x22, 0x20, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x61, 0x67, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x28, 0x30, 0x2e, 0x30, 0x20,
-	0x74, 0x6f, 0x20, 0x31, 0x30, 0x30, 0x2e, 0x30, 0x29, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x32, 0x22, 0xa1, 0x06, 0x0a,
-	0x15, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x78, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
-	0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x33, 0x9a, 0x49, 0x30, 0x0a, 0x2e,
-	0x12, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x02,
-	0x73, 0x76, 0x22, 0x1a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x66, 0x6c, 0x6f, 0x61, 0x74,
-	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x48, 0x00,
-	0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x82, 0x01, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x39, 0x9a, 0x49, 0x36, 0x8a, 0x01, 0x33, 0x7a, 0x31, 0x12,
+	0x0b, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x1a, 0x02, 0x64, 0x63,
+	0x22, 0x1c, 0x44, 0x61, 0x74, 0x61, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x20, 0x69, 0x6e,
+	0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x01,
+	0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x7f, 0x0a, 0x0d,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x3d, 0x9a, 0x49, 0x3a, 0x8a, 0x01, 0x37, 0x7a, 0x35, 0x12, 0x0d, 0x66, 0x69, 0x6c, 0x65,
+	0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x02, 0x66, 0x63, 0x22, 0x1e, 0x46,
+	0x69, 0x6c, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x69, 0x6e, 0x20,
+	0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x01, 0x52,
+	0x0c, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6c, 0x0a,
+	0x08, 0x68, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x34, 0x9a, 0x49,
+	0x31, 0x8a, 0x01, 0x2e, 0x7a, 0x2c, 0x12, 0x08, 0x68, 0x65, 0x78, 0x2d, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0x02, 0x68, 0x64, 0x22, 0x1a, 0x44, 0x61, 0x74, 0x61, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x65,
+	0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x40, 0x02, 0x52, 0x07, 0x68, 0x65, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x84, 0x01, 0x0a, 0x0f,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x42, 0x3e, 0x9a, 0x49, 0x3b, 0x8a, 0x01, 0x38, 0x7a, 0x36, 0x12, 0x0f, 0x62, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x2d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x1a, 0x02, 0x62,
+	0x70, 0x22, 0x1d, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x65, 0x78, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x40, 0x02, 0x52, 0x0e, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xbd, 0x03, 0x0a, 0x15, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x42, 0x4b, 0x9a, 0x49, 0x48, 0x8a, 0x01, 0x45, 0x0a,
+	0x43, 0x12, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a,
+	0x01, 0x6d, 0x22, 0x30, 0x53, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x20, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67,
+	0x2e, 0x2c, 0x20, 0x33, 0x2e, 0x31, 0x34, 0x2c, 0x20, 0x32, 0x2e, 0x37, 0x31, 0x2c, 0x20, 0x31,
+	0x2e, 0x34, 0x31, 0x29, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x5f, 0x0a, 0x0c, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x73, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x36, 0x9a, 0x49, 0x33, 0x0a, 0x31, 0x12,
+	0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x01, 0x63, 0x22,
+	0x1f, 0x47, 0x50, 0x53, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73,
+	0x20, 0x69, 0x6e, 0x20, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x32,
+	0x88, 0x01, 0x01, 0x12, 0x5e, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x02, 0x42, 0x3a, 0x9a, 0x49, 0x37, 0x8a, 0x01,
+	0x34, 0x0a, 0x32, 0x12, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x1a, 0x01, 0x74, 0x22, 0x1f, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x43, 0x65,
+	0x6c, 0x73, 0x69, 0x75, 0x73, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x02, 0x42, 0x3a, 0x9a, 0x49, 0x37, 0x8a, 0x01, 0x34,
+	0x0a, 0x32, 0x12, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x73, 0x1a,
+	0x01, 0x70, 0x22, 0x20, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x20, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x28, 0x30, 0x2e, 0x30, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x30,
+	0x30, 0x2e, 0x30, 0x29, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x32, 0x22, 0xa1, 0x06, 0x0a, 0x15, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x78, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x42, 0x9a, 0x49, 0x3f, 0x8a, 0x01, 0x3c, 0x0a, 0x3a, 0x12, 0x0c,
-	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x03, 0x66, 0x76,
-	0x73, 0x22, 0x25, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x74, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x30, 0x9a, 0x49, 0x2d, 0x0a, 0x2b, 0x12, 0x0b,
-	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x04, 0x74, 0x65, 0x6d,
-	0x70, 0x22, 0x16, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x20, 0x69,
-	0x6e, 0x20, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x48, 0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x7d, 0x0a, 0x0f, 0x73,
-	0x65, 0x6e, 0x73, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x42, 0x37, 0x9a, 0x49, 0x34, 0x8a, 0x01, 0x31, 0x0a, 0x2f, 0x12, 0x0f, 0x73, 0x65, 0x6e,
-	0x73, 0x6f, 0x72, 0x2d, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x02, 0x73, 0x72,
-	0x22, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x73, 0x65, 0x6e, 0x73, 0x6f,
-	0x72, 0x20, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x73,
-	0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x7c, 0x0a, 0x0b, 0x70, 0x72,
-	0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x33, 0x9a, 0x49, 0x30, 0x0a, 0x2e, 0x12, 0x0c, 0x73, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x02, 0x73, 0x76, 0x22, 0x1a, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x0c,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x42, 0x9a, 0x49, 0x3f, 0x8a, 0x01, 0x3c, 0x0a, 0x3a, 0x12, 0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x03, 0x66, 0x76, 0x73, 0x22, 0x25, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x74, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x42, 0x30, 0x9a, 0x49, 0x2d, 0x0a, 0x2b, 0x12, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x22, 0x16, 0x54, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x43, 0x65, 0x6c,
+	0x73, 0x69, 0x75, 0x73, 0x48, 0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x7d, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x38, 0x9a, 0x49,
-	0x35, 0x0a, 0x33, 0x12, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x1a, 0x04, 0x70, 0x72, 0x6f, 0x62, 0x22, 0x1e, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x30, 0x2e, 0x30, 0x20, 0x74,
-	0x6f, 0x20, 0x31, 0x2e, 0x30, 0x29, 0x48, 0x02, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x60, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x2b, 0x9a, 0x49, 0x28, 0x8a, 0x01, 0x25, 0x0a, 0x23, 0x12,
-	0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x02, 0x73, 0x63, 0x22, 0x15, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x22, 0x9b, 0x06, 0x0a, 0x18, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x69,
-	0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x6b, 0x0a,
-	0x06, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x38, 0x9a, 0x49, 0x35, 0x8a, 0x01, 0x32,
-	0x8a, 0x01, 0x2f, 0x12, 0x06, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x1a, 0x01, 0x64, 0x22, 0x22,
-	0x44, 0x65, 0x6c, 0x61, 0x79, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
-	0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x31, 0x73, 0x2c, 0x20, 0x32, 0x6d, 0x2c, 0x20, 0x33,
-	0x68, 0x29, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x09, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x4d, 0x9a, 0x49, 0x4a, 0x8a, 0x01,
-	0x47, 0x8a, 0x01, 0x44, 0x12, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x1a,
-	0x01, 0x69, 0x22, 0x34, 0x54, 0x69, 0x6d, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x73, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x35, 0x30, 0x30, 0x6d, 0x73, 0x2c, 0x20,
-	0x31, 0x30, 0x73, 0x2c, 0x20, 0x35, 0x6d, 0x29, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x4c, 0x9a, 0x49, 0x49, 0x8a, 0x01, 0x46, 0x8a, 0x01, 0x43, 0x12, 0x08, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x1a, 0x01, 0x74, 0x22, 0x34, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72,
-	0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67,
-	0x2e, 0x2c, 0x20, 0x33, 0x30, 0x73, 0x2c, 0x20, 0x35, 0x6d, 0x2c, 0x20, 0x31, 0x68, 0x29, 0x52,
-	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x11, 0x70, 0x6f,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x57, 0x9a, 0x49, 0x54, 0x8a, 0x01, 0x51, 0x8a, 0x01, 0x4e, 0x12, 0x11, 0x70, 0x6f, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x2d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x1a, 0x01,
-	0x70, 0x22, 0x36, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x61, 0x6c, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x69, 0x6e, 0x67, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x31, 0x30, 0x30, 0x6d, 0x73,
-	0x2c, 0x20, 0x35, 0x73, 0x2c, 0x20, 0x31, 0x6d, 0x29, 0x52, 0x10, 0x70, 0x6f, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x58, 0x0a, 0x08, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x37, 0x9a, 0x49,
+	0x34, 0x8a, 0x01, 0x31, 0x0a, 0x2f, 0x12, 0x0f, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x2d, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x02, 0x73, 0x72, 0x22, 0x18, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x7c, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x38, 0x9a, 0x49, 0x35, 0x0a, 0x33, 0x12, 0x0b,
+	0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x1a, 0x04, 0x70, 0x72, 0x6f,
+	0x62, 0x22, 0x1e, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x20, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x20, 0x28, 0x30, 0x2e, 0x30, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x2e, 0x30,
+	0x29, 0x48, 0x02, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x60, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x2b, 0x9a, 0x49, 0x28, 0x8a, 0x01, 0x25, 0x0a, 0x23, 0x12, 0x06, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x1a, 0x02, 0x73, 0x63, 0x22, 0x15, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
+	0x20, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x06, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x9b, 0x06, 0x0a, 0x18,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x6b, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x38, 0x9a, 0x49, 0x35, 0x8a, 0x01, 0x32, 0x8a, 0x01, 0x2f, 0x12, 0x06,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x1a, 0x01, 0x64, 0x22, 0x22, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e,
+	0x2c, 0x20, 0x31, 0x73, 0x2c, 0x20, 0x32, 0x6d, 0x2c, 0x20, 0x33, 0x68, 0x29, 0x52, 0x06, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x4d, 0x9a, 0x49, 0x4a, 0x8a, 0x01, 0x47, 0x8a, 0x01, 0x44, 0x12,
+	0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x1a, 0x01, 0x69, 0x22, 0x34, 0x54,
+	0x69, 0x6d, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x20, 0x62, 0x65,
+	0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x28, 0x65, 0x2e,
+	0x67, 0x2e, 0x2c, 0x20, 0x35, 0x30, 0x30, 0x6d, 0x73, 0x2c, 0x20, 0x31, 0x30, 0x73, 0x2c, 0x20,
+	0x35, 0x6d, 0x29, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x83,
+	0x01, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x4c, 0x9a, 0x49,
+	0x49, 0x8a, 0x01, 0x46, 0x8a, 0x01, 0x43, 0x12, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x73, 0x1a, 0x01, 0x74, 0x22, 0x34, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x20, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x33, 0x30,
+	0x73, 0x2c, 0x20, 0x35, 0x6d, 0x2c, 0x20, 0x31, 0x68, 0x29, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x11, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x57, 0x9a, 0x49, 0x54,
+	0x8a, 0x01, 0x51, 0x8a, 0x01, 0x4e, 0x12, 0x11, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x1a, 0x01, 0x70, 0x22, 0x36, 0x50, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x28,
+	0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x31, 0x30, 0x30, 0x6d, 0x73, 0x2c, 0x20, 0x35, 0x73, 0x2c,
+	0x20, 0x31, 0x6d, 0x29, 0x52, 0x10, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x58, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x20, 0x9a, 0x49, 0x1d, 0xa2, 0x01, 0x1a, 0x22, 0x0e, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x20, 0x75, 0x73, 0x61, 0x67, 0x65, 0x42, 0x03, 0x61, 0x62,
+	0x63, 0x4a, 0x03, 0x6e, 0x6f, 0x77, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x6c, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1e, 0x9a, 0x49, 0x1b, 0xa2, 0x01, 0x18, 0x22,
+	0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x42, 0x03, 0x61, 0x62, 0x63, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x3a, 0x03,
+	0xa8, 0x49, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x0f, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x9a, 0x49,
+	0x29, 0x72, 0x27, 0x12, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1e, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x70, 0x70, 0x65,
+	0x61, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x65, 0x6c, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x56, 0x0a, 0x0e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x19, 0x9a, 0x49, 0x16, 0xaa, 0x01, 0x13, 0x08, 0x01, 0x12, 0x0f, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x2d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x36, 0x52, 0x0d, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x20, 0x9a, 0x49, 0x1d, 0xa2, 0x01,
-	0x1a, 0x22, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x20, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x42, 0x03, 0x61, 0x62, 0x63, 0x4a, 0x03, 0x6e, 0x6f, 0x77, 0x52, 0x08, 0x64, 0x65, 0x61,
-	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x6c, 0x0a, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1e, 0x9a, 0x49,
-	0x1b, 0xa2, 0x01, 0x18, 0x22, 0x11, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x03, 0x61, 0x62, 0x63, 0x48, 0x00, 0x52, 0x10,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x88, 0x01, 0x01, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x80,
-	0x02, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x2c, 0x9a, 0x49, 0x29, 0x72, 0x27, 0x12, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x1e, 0x54, 0x68, 0x69, 0x73, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6e, 0x6f, 0x74,
-	0x20, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x65, 0x6c, 0x70, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x42, 0x19, 0x9a, 0x49, 0x16, 0xaa, 0x01, 0x13, 0x08, 0x01, 0x12, 0x0f,
-	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x36, 0x52,
-	0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x11,
-	0x9a, 0x49, 0x0e, 0xa2, 0x01, 0x0b, 0x12, 0x04, 0x68, 0x61, 0x63, 0x6b, 0x42, 0x03, 0x61, 0x62,
-	0x63, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x03, 0x98, 0x49,
-	0x01, 0x22, 0x29, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x25, 0x0a, 0x0d,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x0e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x42, 0x13, 0x9a, 0x49, 0x10, 0x0a, 0x0e, 0x12, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x30, 0x9a, 0x49, 0x2d, 0x8a, 0x01, 0x2a, 0x72, 0x28, 0x12, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x32, 0x22, 0x1e, 0x54, 0x68, 0x69, 0x73, 0x20, 0x73, 0x68, 0x6f, 0x75,
-	0x6c, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x20, 0x69, 0x6e,
-	0x20, 0x68, 0x65, 0x6c, 0x70, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x65, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x73,
-	0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x9a, 0x49, 0x2a, 0x72, 0x28, 0x12, 0x0a, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x2d, 0x6b, 0x65, 0x79, 0x22, 0x18, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6b,
-	0x65, 0x79, 0x28, 0x01, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12,
-	0x49, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x2f, 0x9a, 0x49, 0x2c, 0x1a, 0x2a, 0x12, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x22, 0x1d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x40,
-	0x1e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x3a, 0x06, 0xa0, 0x49, 0x01, 0xa8,
-	0x49, 0x01, 0x22, 0xe9, 0x06, 0x0a, 0x17, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31,
-	0x0a, 0x02, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x42, 0x21, 0x9a, 0x49, 0x1e, 0x0a,
-	0x1c, 0x12, 0x02, 0x70, 0x69, 0x22, 0x11, 0x50, 0x69, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0xd0, 0x0f, 0x49, 0x40, 0x52, 0x02, 0x70,
-	0x69, 0x12, 0x3b, 0x0a, 0x05, 0x65, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x42, 0x25, 0x9a, 0x49, 0x22, 0x12, 0x20, 0x12, 0x05, 0x65, 0x75, 0x6c, 0x65, 0x72, 0x22, 0x0e,
-	0x45, 0x75, 0x6c, 0x65, 0x72, 0x27, 0x73, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x90,
-	0xf7, 0xaa, 0x95, 0x09, 0xbf, 0x05, 0x40, 0x52, 0x05, 0x65, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x4e,
-	0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x2b, 0x9a, 0x49, 0x28, 0x1a, 0x26, 0x12, 0x0c, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x2d, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x13, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x70, 0x6f, 0x72, 0x74, 0x40, 0x90,
-	0x3f, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x5f,
-	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x36, 0x9a, 0x49, 0x33, 0x22, 0x31, 0x12, 0x0f,
-	0x6d, 0x61, 0x78, 0x2d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x1b, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
-	0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x40, 0xe8, 0x07, 0x52,
-	0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x4c, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x2b, 0x9a, 0x49, 0x28, 0x2a, 0x26, 0x12, 0x0b, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x14, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x20, 0x73, 0x69, 0x7a, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x40, 0x80,
-	0x20, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x53, 0x0a,
-	0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x30, 0x9a, 0x49, 0x2d, 0x32, 0x2b, 0x12, 0x0c, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x2d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x15, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x40,
-	0x80, 0x80, 0x80, 0x80, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x4a, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x26, 0x9a, 0x49, 0x23, 0x6a, 0x21, 0x12, 0x0a, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x11, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x20, 0x64, 0x65, 0x62, 0x75, 0x67, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x40, 0x01, 0x48, 0x00,
-	0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46,
-	0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x29, 0x9a, 0x49, 0x26, 0x72, 0x24, 0x12, 0x09, 0x6c, 0x6f, 0x67, 0x2d, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x22, 0x11, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x6c, 0x6f, 0x67,
-	0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x63, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x2e,
-	0x9a, 0x49, 0x2b, 0x82, 0x01, 0x28, 0x12, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d,
-	0x6d, 0x6f, 0x64, 0x65, 0x22, 0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x40, 0x01, 0x52, 0x0b,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x6b, 0x0a, 0x0d, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x32, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45,
-	0x6e, 0x75, 0x6d, 0x31, 0x42, 0x2f, 0x9a, 0x49, 0x2c, 0x82, 0x01, 0x29, 0x12, 0x0d, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x31, 0x22, 0x16, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6d,
-	0x6f, 0x64, 0x65, 0x40, 0x01, 0x48, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x4d, 0x6f, 0x64, 0x65, 0x32, 0x88, 0x01, 0x01, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x32, 0x22, 0xd4,
-	0x06, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x0c, 0x73, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x44,
-	0x9a, 0x49, 0x41, 0x72, 0x3f, 0x12, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x02, 0x73, 0x76, 0x22, 0x1b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x42, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x73, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x44, 0x9a, 0x49,
-	0x41, 0x8a, 0x01, 0x3e, 0x72, 0x3c, 0x12, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x03, 0x73, 0x76, 0x73, 0x22, 0x26, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x65, 0x20, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x12, 0x86, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x42, 0x9a, 0x49, 0x3f, 0x72, 0x3d, 0x12, 0x0b, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2d, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x03, 0x63, 0x66, 0x67, 0x22, 0x17, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x69, 0x6c,
-	0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x42, 0x10, 0x2f, 0x65, 0x74, 0x63, 0x2f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x79, 0x61, 0x6d, 0x6c, 0x48, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x11, 0x9a, 0x49, 0x0e, 0xa2, 0x01,
+	0x0b, 0x12, 0x04, 0x68, 0x61, 0x63, 0x6b, 0x42, 0x03, 0x61, 0x62, 0x63, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x03, 0x98, 0x49, 0x01, 0x22, 0x29, 0x0a, 0x0c,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x25, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb1,
+	0x01, 0x0a, 0x0e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x4b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x13, 0x9a,
+	0x49, 0x10, 0x0a, 0x0e, 0x12, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x05, 0x68, 0x65, 0x6c,
+	0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x30,
+	0x9a, 0x49, 0x2d, 0x8a, 0x01, 0x2a, 0x72, 0x28, 0x12, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32,
+	0x22, 0x1e, 0x54, 0x68, 0x69, 0x73, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x65, 0x6c, 0x70,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0a,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2d, 0x9a, 0x49, 0x2a, 0x72, 0x28, 0x12, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2d,
+	0x6b, 0x65, 0x79, 0x22, 0x18, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6b, 0x65, 0x79, 0x28, 0x01, 0x52,
+	0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x49, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2f, 0x9a, 0x49, 0x2c,
+	0x1a, 0x2a, 0x12, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x1d, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x20,
+	0x69, 0x6e, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x40, 0x1e, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x3a, 0x06, 0xa0, 0x49, 0x01, 0xa8, 0x49, 0x01, 0x22, 0xe9, 0x06,
+	0x0a, 0x17, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x02, 0x70, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x42, 0x21, 0x9a, 0x49, 0x1e, 0x0a, 0x1c, 0x12, 0x02, 0x70, 0x69,
+	0x22, 0x11, 0x50, 0x69, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x20, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x45, 0xd0, 0x0f, 0x49, 0x40, 0x52, 0x02, 0x70, 0x69, 0x12, 0x3b, 0x0a, 0x05,
+	0x65, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x25, 0x9a, 0x49, 0x22,
+	0x12, 0x20, 0x12, 0x05, 0x65, 0x75, 0x6c, 0x65, 0x72, 0x22, 0x0e, 0x45, 0x75, 0x6c, 0x65, 0x72,
+	0x27, 0x73, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x90, 0xf7, 0xaa, 0x95, 0x09, 0xbf,
+	0x05, 0x40, 0x52, 0x05, 0x65, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0c, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x2b, 0x9a, 0x49, 0x28, 0x1a, 0x26, 0x12, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x13, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x20, 0x70, 0x6f, 0x72, 0x74, 0x40, 0x90, 0x3f, 0x52, 0x0b, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x5f, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x36, 0x9a, 0x49, 0x33, 0x22, 0x31, 0x12, 0x0f, 0x6d, 0x61, 0x78, 0x2d, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1b, 0x4d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x40, 0xe8, 0x07, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x2b, 0x9a, 0x49, 0x28, 0x2a, 0x26, 0x12, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2d, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x14, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x20, 0x73, 0x69, 0x7a, 0x65,
+	0x20, 0x69, 0x6e, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x40, 0x80, 0x20, 0x52, 0x0a, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x30,
+	0x9a, 0x49, 0x2d, 0x32, 0x2b, 0x12, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x2d, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x15, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x20, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x40, 0x80, 0x80, 0x80, 0x80, 0x04,
+	0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4a, 0x0a,
+	0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x26, 0x9a, 0x49, 0x23, 0x6a, 0x21, 0x12, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2d,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0x11, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x40, 0x01, 0x48, 0x00, 0x52, 0x09, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x6c, 0x6f, 0x67,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0x9a, 0x49,
+	0x26, 0x72, 0x24, 0x12, 0x09, 0x6c, 0x6f, 0x67, 0x2d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x11,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x6c, 0x6f, 0x67, 0x20, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x42, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x63, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x2e, 0x9a, 0x49, 0x2b, 0x82, 0x01,
+	0x28, 0x12, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x22,
+	0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x40, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x6b, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x32, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42,
+	0x2f, 0x9a, 0x49, 0x2c, 0x82, 0x01, 0x29, 0x12, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x31, 0x22, 0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x40, 0x01,
+	0x48, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x32,
+	0x88, 0x01, 0x01, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x32, 0x22, 0xd4, 0x06, 0x0a, 0x16, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x44, 0x9a, 0x49, 0x41, 0x72, 0x3f,
+	0x12, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x02,
+	0x73, 0x76, 0x22, 0x1b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42,
+	0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x44, 0x9a, 0x49, 0x41, 0x8a, 0x01, 0x3e, 0x72,
+	0x3c, 0x12, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x1a, 0x03, 0x73, 0x76, 0x73, 0x22, 0x26, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0c, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x3d, 0x9a, 0x49, 0x3a, 0x8a, 0x01, 0x37, 0x72, 0x35, 0x12, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x2d, 0x70, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x03, 0x69, 0x6e, 0x63, 0x22, 0x1f, 0x49,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x73, 0x20, 0x66, 0x6f, 0x72,
-	0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x7a, 0x0a, 0x0b,
-	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x42, 0x9a, 0x49, 0x3f, 0x72, 0x3d, 0x12, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2d, 0x70,
+	0x61, 0x74, 0x68, 0x1a, 0x03, 0x63, 0x66, 0x67, 0x22, 0x17, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x70, 0x61, 0x74,
+	0x68, 0x42, 0x10, 0x2f, 0x65, 0x74, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x79,
+	0x61, 0x6d, 0x6c, 0x48, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74,
+	0x68, 0x88, 0x01, 0x01, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x3d, 0x9a, 0x49, 0x3a, 0x8a,
+	0x01, 0x37, 0x72, 0x35, 0x12, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x2d, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x1a, 0x03, 0x69, 0x6e, 0x63, 0x22, 0x1f, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x20, 0x70, 0x61, 0x74, 0x68, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x7a, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x35, 0x9a, 0x49, 0x32, 0x72,
+	0x30, 0x12, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x03,
+	0x65, 0x6e, 0x76, 0x22, 0x10, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x02, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x65, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x35, 0x9a, 0x49, 0x32, 0x72, 0x30, 0x12, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x1a, 0x03, 0x65, 0x6e, 0x76, 0x22, 0x10, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x65, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x33, 0x9a, 0x49, 0x30, 0x8a, 0x01, 0x2d, 0x72, 0x2b, 0x12, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x1a, 0x01, 0x74, 0x22, 0x20, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
-	0x65, 0x20, 0x74, 0x61, 0x67, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x3a,
-	0x03, 0xa8, 0x49, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xe7, 0x08, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65,
-	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x71, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x2e, 0x9a, 0x49, 0x2b, 0x1a, 0x29, 0x12, 0x0b, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x03, 0x69, 0x33, 0x32, 0x22, 0x13, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x40, 0x2a, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x79, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x36, 0x9a, 0x49, 0x33, 0x22, 0x31, 0x12, 0x0b, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x03, 0x69, 0x36, 0x34, 0x22,
-	0x13, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x40, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0x48, 0x01,
-	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x76, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x30, 0x9a, 0x49, 0x2d, 0x2a, 0x2b, 0x12, 0x0c, 0x75, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x03, 0x75, 0x33, 0x32, 0x22, 0x14, 0x55,
+	0x33, 0x9a, 0x49, 0x30, 0x8a, 0x01, 0x2d, 0x72, 0x2b, 0x12, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a,
+	0x01, 0x74, 0x22, 0x20, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x74, 0x61, 0x67,
+	0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0xe7, 0x08, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x71, 0x0a, 0x0b,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x2e,
+	0x9a, 0x49, 0x2b, 0x1a, 0x29, 0x12, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2d, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x1a, 0x03, 0x69, 0x33, 0x32, 0x22, 0x13, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x20, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x40, 0x2a, 0x48, 0x00,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x79, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x36, 0x9a, 0x49, 0x33, 0x22, 0x31, 0x12, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x2d,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x03, 0x69, 0x36, 0x34, 0x22, 0x13, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x40,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f, 0x48, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x76, 0x0a, 0x0c, 0x75, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x30,
+	0x9a, 0x49, 0x2d, 0x2a, 0x2b, 0x12, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2d, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x03, 0x75, 0x33, 0x32, 0x22, 0x14, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x40, 0x64,
+	0x48, 0x02, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x7f, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x39, 0x9a, 0x49, 0x36, 0x32, 0x34, 0x12, 0x0c, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x03, 0x75, 0x36, 0x34,
+	0x22, 0x14, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x40, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0x01, 0x48, 0x03, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x44, 0x9a, 0x49, 0x41, 0x8a, 0x01, 0x3e, 0x1a,
+	0x3c, 0x12, 0x0c, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a,
+	0x04, 0x69, 0x33, 0x32, 0x73, 0x22, 0x26, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20,
 	0x49, 0x6e, 0x74, 0x33, 0x32, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x40, 0x64, 0x48, 0x02, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x7f, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x39, 0x9a, 0x49, 0x36,
-	0x32, 0x34, 0x12, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x1a, 0x03, 0x75, 0x36, 0x34, 0x22, 0x14, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x20, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x40, 0xff, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x48, 0x03, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x44, 0x9a, 0x49,
-	0x41, 0x8a, 0x01, 0x3e, 0x1a, 0x3c, 0x12, 0x0c, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x2d, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x04, 0x69, 0x33, 0x32, 0x73, 0x22, 0x26, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x70, 0x6c, 0x65, 0x20, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
-	0x88, 0x01, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x48, 0x9a, 0x49, 0x45, 0x8a, 0x01, 0x42, 0x22, 0x40, 0x12, 0x0c, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x04, 0x69, 0x36, 0x34,
-	0x73, 0x22, 0x26, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x02, 0x01, 0x02, 0x52, 0x0b, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0e, 0x66,
-	0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x4e, 0x9a, 0x49, 0x4b, 0x8a, 0x01, 0x48, 0x0a, 0x46, 0x12, 0x0d, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x78, 0x1a, 0x04, 0x69, 0x36, 0x34, 0x73, 0x22,
-	0x25, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x08, 0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x40,
-	0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
-	0x77, 0x0a, 0x0d, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x01, 0x42, 0x52, 0x9a, 0x49, 0x4f, 0x8a, 0x01, 0x4c, 0x12, 0x4a,
-	0x12, 0x0e, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x78,
-	0x1a, 0x05, 0x69, 0x36, 0x34, 0x73, 0x78, 0x22, 0x1f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
-	0x65, 0x20, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-	0xf0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x52, 0x0c, 0x64, 0x6f, 0x75, 0x62,
-	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0xcc, 0x06, 0x0a, 0x14, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x73,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x7b, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x0c, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x48,
+	0x9a, 0x49, 0x45, 0x8a, 0x01, 0x42, 0x22, 0x40, 0x12, 0x0c, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x2d,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x04, 0x69, 0x36, 0x34, 0x73, 0x22, 0x26, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x20, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x42, 0x02, 0x01, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0e, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36,
+	0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x37, 0x9a, 0x49, 0x34, 0x6a,
-	0x32, 0x12, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a,
-	0x02, 0x73, 0x76, 0x22, 0x1c, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x62, 0x6f, 0x6f, 0x6c,
-	0x65, 0x61, 0x6e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x40, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x7d, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x40, 0x9a, 0x49, 0x3d, 0x8a, 0x01, 0x3a, 0x6a, 0x38,
-	0x12, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x03, 0x62,
-	0x76, 0x73, 0x22, 0x24, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x42, 0x6f, 0x6f,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x3a, 0x9a, 0x49, 0x37, 0x6a, 0x35,
-	0x12, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x2d, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x1a, 0x04, 0x66, 0x65, 0x61, 0x74, 0x22, 0x1b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x65,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x20, 0x66, 0x65, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x40, 0x00, 0x48, 0x01, 0x52, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x74, 0x0a, 0x0d, 0x66, 0x65, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x33, 0x9a, 0x49,
-	0x30, 0x8a, 0x01, 0x2d, 0x6a, 0x2b, 0x12, 0x0d, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2d,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x02, 0x66, 0x66, 0x22, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x70, 0x6c, 0x65, 0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x20, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x52, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12,
-	0x83, 0x01, 0x0a, 0x0f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x67,
-	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x4e, 0x9a, 0x49, 0x4b,
+	0x8a, 0x01, 0x48, 0x0a, 0x46, 0x12, 0x0d, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x2d, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x78, 0x1a, 0x04, 0x69, 0x36, 0x34, 0x73, 0x22, 0x25, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x65, 0x20, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x42, 0x08, 0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x40, 0x52, 0x0d, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x0d, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x01, 0x42, 0x52, 0x9a, 0x49, 0x4f, 0x8a, 0x01, 0x4c, 0x12, 0x4a, 0x12, 0x0e, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x78, 0x1a, 0x05, 0x69, 0x36, 0x34,
+	0x73, 0x78, 0x22, 0x1f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x42, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x40, 0x52, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xcc, 0x06, 0x0a, 0x14, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x7b, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x39, 0x9a, 0x49, 0x36, 0x6a, 0x34, 0x12, 0x0f, 0x76, 0x65,
-	0x72, 0x62, 0x6f, 0x73, 0x65, 0x2d, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x1a, 0x07, 0x76,
-	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x22, 0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x76,
-	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x20, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x40, 0x00,
-	0x48, 0x02, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x69,
-	0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x7a, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x39, 0x9a, 0x49, 0x36, 0x8a, 0x01, 0x33,
-	0x6a, 0x31, 0x12, 0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x1a, 0x03, 0x64, 0x62, 0x67, 0x22, 0x1b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
-	0x20, 0x64, 0x65, 0x62, 0x75, 0x67, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x52, 0x0c, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x76,
-	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x22, 0x86,
-	0x05, 0x0a, 0x1c, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65,
-	0x46, 0x6c, 0x61, 0x67, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x52, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x36, 0x9a, 0x49, 0x33, 0x72, 0x31, 0x12, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x1a, 0x01, 0x75, 0x22, 0x1b, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20,
-	0x66, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0x9a, 0x49, 0x2e, 0x72, 0x2c, 0x12, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x01, 0x70, 0x22, 0x1b, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x70, 0x0a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0x9a, 0x49, 0x4a, 0x72, 0x48, 0x12,
-	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x02, 0x6c,
-	0x74, 0x22, 0x1b, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x01,
-	0x3a, 0x15, 0x55, 0x73, 0x65, 0x20, 0x2d, 0x2d, 0x61, 0x70, 0x69, 0x2d, 0x6b, 0x65, 0x79, 0x20,
-	0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x52, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x6a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3f,
-	0x9a, 0x49, 0x3c, 0x1a, 0x3a, 0x12, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x02, 0x63, 0x63, 0x22, 0x20, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x40, 0x0a, 0x52,
-	0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x72, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x51, 0x9a, 0x49, 0x4e, 0x1a, 0x4c, 0x12, 0x0b, 0x6d, 0x61,
-	0x78, 0x2d, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x1a, 0x02, 0x6d, 0x74, 0x22, 0x19, 0x4d,
-	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66,
-	0x20, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x30, 0x01, 0x3a, 0x1a, 0x55, 0x73, 0x65, 0x20,
-	0x2d, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x69,
-	0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x40, 0x64, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x73, 0x12, 0x6c, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x3f, 0x9a, 0x49, 0x3c, 0x6a, 0x3a, 0x12, 0x11, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x2d, 0x6d, 0x6f, 0x64, 0x65, 0x1a, 0x03, 0x65, 0x78, 0x70, 0x22, 0x1c,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x6c, 0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x28, 0x01, 0x40, 0x00,
-	0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x4d, 0x6f,
-	0x64, 0x65, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xca, 0x02, 0x0a, 0x18, 0x4e, 0x65, 0x73, 0x74,
-	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x42, 0x10, 0x9a, 0x49, 0x0d, 0xaa, 0x01, 0x0a, 0x08, 0x01, 0x12, 0x06, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
-	0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08,
-	0x9a, 0x49, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x9a, 0x49, 0x09, 0xaa, 0x01, 0x06, 0x08, 0x01, 0x12,
-	0x02, 0x64, 0x62, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x4a, 0x0a, 0x0b, 0x64, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
-	0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x9a, 0x49, 0x0a, 0xaa, 0x01, 0x07, 0x08, 0x01, 0x12, 0x03,
-	0x61, 0x70, 0x70, 0x52, 0x0a, 0x64, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a,
-	0x03, 0xa8, 0x49, 0x01, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x0c,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x29, 0x9a, 0x49, 0x26, 0x72, 0x24, 0x12, 0x0c, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x32, 0x2d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x14, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x20, 0x32,
-	0x20, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x0d, 0x6e, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x10, 0x9a, 0x49, 0x0d, 0xaa, 0x01, 0x0a, 0x08,
-	0x01, 0x12, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x0c, 0x6e, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x83, 0x09, 0x0a,
-	0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61,
-	0x70, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x85, 0x01, 0x0a,
-	0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x54, 0x65, 0x73, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x30, 0x9a, 0x49, 0x2d, 0x92, 0x01, 0x2a, 0x12, 0x0b,
-	0x6a, 0x73, 0x6f, 0x6e, 0x2d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x02, 0x6a, 0x6c, 0x22,
-	0x15, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x01, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x37, 0x9a, 0x49, 0x34, 0x6a, 0x32, 0x12, 0x0c, 0x73, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x02, 0x73, 0x76, 0x22, 0x1c,
+	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x20, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x40, 0x01, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x7d, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x40, 0x9a, 0x49, 0x3d, 0x8a, 0x01, 0x3a, 0x6a, 0x38, 0x12, 0x0b, 0x62, 0x6f, 0x6f,
+	0x6c, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x03, 0x62, 0x76, 0x73, 0x22, 0x24, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x20, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x82, 0x01, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x3a, 0x9a, 0x49, 0x37, 0x6a, 0x35, 0x12, 0x0e, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x2d, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x04, 0x66, 0x65, 0x61,
+	0x74, 0x22, 0x1b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x40, 0x00,
+	0x48, 0x01, 0x52, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x74, 0x0a, 0x0d, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x33, 0x9a, 0x49, 0x30, 0x8a, 0x01, 0x2d, 0x6a,
+	0x2b, 0x12, 0x0d, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2d, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x1a, 0x02, 0x66, 0x66, 0x22, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x0c, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x76,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x39, 0x9a, 0x49, 0x36, 0x6a, 0x34, 0x12, 0x0f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65,
+	0x2d, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x1a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
+	0x65, 0x22, 0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
+	0x65, 0x20, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x40, 0x00, 0x48, 0x02, 0x52, 0x0e, 0x76,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01,
+	0x12, 0x7a, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x39, 0x9a, 0x49, 0x36, 0x8a, 0x01, 0x33, 0x6a, 0x31, 0x12, 0x0d, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x2d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x03, 0x64, 0x62,
+	0x67, 0x22, 0x1b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x0c,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x03, 0xa8, 0x49,
+	0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
+	0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x22, 0x86, 0x05, 0x0a, 0x1c, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x54,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0x9a, 0x49,
+	0x33, 0x72, 0x31, 0x12, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x01, 0x75,
+	0x22, 0x1b, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x05, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4d,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0x9a, 0x49, 0x2e, 0x72, 0x2c, 0x12, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x1a, 0x01, 0x70, 0x22, 0x1b, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x70, 0x0a,
+	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x4d, 0x9a, 0x49, 0x4a, 0x72, 0x48, 0x12, 0x0c, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x02, 0x6c, 0x74, 0x22, 0x1b, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x30, 0x01, 0x3a, 0x15, 0x55, 0x73, 0x65,
+	0x20, 0x2d, 0x2d, 0x61, 0x70, 0x69, 0x2d, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65,
+	0x61, 0x64, 0x52, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x6a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3f, 0x9a, 0x49, 0x3c, 0x1a, 0x3a,
+	0x12, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x02, 0x63, 0x63, 0x22, 0x20, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x40, 0x0a, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x72, 0x0a, 0x0b, 0x6d,
+	0x61, 0x78, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x51, 0x9a, 0x49, 0x4e, 0x1a, 0x4c, 0x12, 0x0b, 0x6d, 0x61, 0x78, 0x2d, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x1a, 0x02, 0x6d, 0x74, 0x22, 0x19, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x30, 0x01, 0x3a, 0x1a, 0x55, 0x73, 0x65, 0x20, 0x2d, 0x2d, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61,
+	0x64, 0x40, 0x64, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12,
+	0x6c, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3f, 0x9a, 0x49, 0x3c, 0x6a,
+	0x3a, 0x12, 0x11, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2d,
+	0x6d, 0x6f, 0x64, 0x65, 0x1a, 0x03, 0x65, 0x78, 0x70, 0x22, 0x1c, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x20, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x20, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x28, 0x01, 0x40, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x3a, 0x03, 0xa8,
+	0x49, 0x01, 0x22, 0xca, 0x02, 0x0a, 0x18, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x4b, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x53,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x10, 0x9a, 0x49,
+	0x0d, 0xaa, 0x01, 0x0a, 0x08, 0x01, 0x12, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0c,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x9a, 0x49, 0x05, 0xaa, 0x01,
+	0x02, 0x08, 0x01, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x4b, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x0c, 0x9a, 0x49, 0x09, 0xaa, 0x01, 0x06, 0x08, 0x01, 0x12, 0x02, 0x64, 0x62, 0x52, 0x0e,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4a,
+	0x0a, 0x0b, 0x64, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4e, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x0d, 0x9a, 0x49, 0x0a, 0xaa, 0x01, 0x07, 0x08, 0x01, 0x12, 0x03, 0x61, 0x70, 0x70, 0x52, 0x0a,
+	0x64, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22,
+	0xb5, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x32,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x0c, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0x9a,
+	0x49, 0x26, 0x72, 0x24, 0x12, 0x0c, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32, 0x2d, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x22, 0x14, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x20, 0x32, 0x20, 0x6e, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x32,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x0d, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x10, 0x9a, 0x49, 0x0d, 0xaa, 0x01, 0x0a, 0x08, 0x01, 0x12, 0x06, 0x6e, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x52, 0x0c, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x83, 0x09, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x54, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73,
+	0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x30, 0x9a, 0x49, 0x2d, 0x92, 0x01, 0x2a, 0x12, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x2d,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x02, 0x6a, 0x6c, 0x22, 0x15, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x48, 0x01, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x8f, 0x01, 0x0a, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70,
+	0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x34, 0x9a,
+	0x49, 0x31, 0x92, 0x01, 0x2e, 0x12, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x2d, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x1a, 0x02, 0x6e, 0x6c, 0x22, 0x17, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x20, 0x69, 0x6e, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x48, 0x02, 0x52, 0x0c, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0xba, 0x01, 0x0a, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74,
 	0x65, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69,
 	0x76, 0x65, 0x4d, 0x61, 0x70, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x34, 0x9a, 0x49, 0x31, 0x92, 0x01, 0x2e, 0x12, 0x0d, 0x6e, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x2d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x02, 0x6e, 0x6c, 0x22, 0x17, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x02, 0x52, 0x0c, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0xba, 0x01, 0x0a, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
-	0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x56, 0x9a, 0x49, 0x53, 0x92,
-	0x01, 0x50, 0x12, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x65, 0x72, 0x73, 0x1a, 0x02, 0x64, 0x63, 0x22, 0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x42, 0x1e, 0x7b, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3a, 0x20, 0x31,
-	0x30, 0x30, 0x2c, 0x20, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x3a, 0x20, 0x30, 0x7d,
-	0x48, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0xac, 0x01, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76,
-	0x65, 0x4d, 0x61, 0x70, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0x51, 0x9a, 0x49, 0x4e, 0x92, 0x01, 0x4b, 0x12, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x02, 0x6c, 0x63, 0x22, 0x18, 0x4c, 0x65,
-	0x67, 0x61, 0x63, 0x79, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x20, 0x6d, 0x61, 0x70, 0x30, 0x01, 0x3a, 0x18, 0x55, 0x73, 0x65, 0x20, 0x2d, 0x2d,
-	0x6e, 0x65, 0x77, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65,
-	0x61, 0x64, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x92, 0x01, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x65, 0x73,
-	0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65,
-	0x4d, 0x61, 0x70, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x37, 0x9a, 0x49, 0x34, 0x92, 0x01, 0x31, 0x12, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x02, 0x73, 0x63, 0x22, 0x18, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x20, 0x6d, 0x61, 0x70, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3d, 0x0a, 0x0f, 0x4a, 0x73, 0x6f, 0x6e, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x56, 0x9a, 0x49, 0x53, 0x92, 0x01, 0x50, 0x12, 0x10, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x1a,
+	0x02, 0x64, 0x63, 0x22, 0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x1e, 0x7b, 0x22, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x30, 0x2c, 0x20, 0x22,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x3a, 0x20, 0x30, 0x7d, 0x48, 0x03, 0x52, 0x0f, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0xac,
+	0x01, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x54,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x51, 0x9a, 0x49,
+	0x4e, 0x92, 0x01, 0x4b, 0x12, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x2d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x1a, 0x02, 0x6c, 0x63, 0x22, 0x18, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x20,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6d, 0x61,
+	0x70, 0x30, 0x01, 0x3a, 0x18, 0x55, 0x73, 0x65, 0x20, 0x2d, 0x2d, 0x6e, 0x65, 0x77, 0x2d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x48, 0x01, 0x52,
+	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x92, 0x01,
+	0x0a, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x68, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x54, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x37, 0x9a, 0x49, 0x34,
+	0x92, 0x01, 0x31, 0x12, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x1a, 0x02, 0x73, 0x63, 0x22, 0x18, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6d, 0x61, 0x70,
+	0x28, 0x01, 0x48, 0x01, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x1a, 0x3d, 0x0a, 0x0f, 0x4a, 0x73, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x4c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xcf, 0x06,
+	0x0a, 0x19, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x6c, 0x69, 0x63, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0xc4, 0x01, 0x0a, 0x0b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x86, 0x01,
+	0x9a, 0x49, 0x82, 0x01, 0x8a, 0x01, 0x7f, 0x92, 0x01, 0x7c, 0x12, 0x0b, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x1a, 0x02, 0x65, 0x74, 0x22, 0x43, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x20, 0x28, 0x65,
+	0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x32, 0x30, 0x32, 0x33, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x54,
+	0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x2c, 0x20, 0x32, 0x30, 0x32, 0x33, 0x2d,
+	0x31, 0x32, 0x2d, 0x33, 0x31, 0x54, 0x32, 0x33, 0x3a, 0x35, 0x39, 0x3a, 0x35, 0x39, 0x5a, 0x29,
+	0x42, 0x07, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x4a, 0x14, 0x32, 0x30, 0x32, 0x33, 0x2d,
+	0x31, 0x32, 0x2d, 0x32, 0x35, 0x54, 0x31, 0x30, 0x3a, 0x33, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x4a,
+	0x05, 0x6e, 0x6f, 0x77, 0x28, 0x29, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x4e, 0x9a, 0x49, 0x4b, 0x8a, 0x01, 0x48, 0x92,
+	0x01, 0x45, 0x12, 0x0e, 0x6c, 0x6f, 0x67, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x73, 0x1a, 0x02, 0x6c, 0x74, 0x22, 0x26, 0x4c, 0x6f, 0x67, 0x20, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x20, 0x69, 0x6e, 0x20,
+	0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x07,
+	0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x47, 0x9a, 0x49,
+	0x44, 0x8a, 0x01, 0x41, 0x92, 0x01, 0x3e, 0x12, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x1a, 0x02, 0x73, 0x74, 0x22, 0x1e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x42, 0x07, 0x52, 0x46,
+	0x43, 0x33, 0x33, 0x33, 0x39, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x5d, 0x9a, 0x49, 0x5a, 0x8a, 0x01, 0x57,
+	0x92, 0x01, 0x54, 0x12, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2d, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x1a, 0x02, 0x62, 0x74, 0x22, 0x37, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x20, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x32, 0x30, 0x32, 0x34, 0x2d, 0x30, 0x31,
+	0x2d, 0x30, 0x31, 0x54, 0x30, 0x32, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x29, 0x42, 0x07,
+	0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x57,
+	0x9a, 0x49, 0x54, 0x8a, 0x01, 0x51, 0x92, 0x01, 0x4e, 0x12, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x2d, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x1a, 0x03,
+	0x63, 0x66, 0x74, 0x22, 0x18, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x42, 0x07, 0x52,
+	0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x42, 0x07, 0x49, 0x53, 0x4f, 0x38, 0x36, 0x30, 0x31, 0x42,
+	0x06, 0x52, 0x46, 0x43, 0x38, 0x32, 0x32, 0x52, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22,
+	0xb4, 0x07, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x0d,
+	0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x42, 0x3c, 0x9a, 0x49, 0x39, 0x8a, 0x01, 0x36, 0x7a, 0x34, 0x12, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x36, 0x34, 0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x1a, 0x03, 0x62, 0x36,
+	0x34, 0x22, 0x1c, 0x44, 0x61, 0x74, 0x61, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x20, 0x69,
+	0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40,
+	0x01, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12,
+	0x54, 0x0a, 0x0a, 0x68, 0x65, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0c, 0x42, 0x35, 0x9a, 0x49, 0x32, 0x8a, 0x01, 0x2f, 0x7a, 0x2d, 0x12, 0x0a, 0x68,
+	0x65, 0x78, 0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x1a, 0x02, 0x68, 0x78, 0x22, 0x19, 0x44,
+	0x61, 0x74, 0x61, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x65,
+	0x78, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x02, 0x52, 0x09, 0x68, 0x65, 0x78, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x9a, 0x49, 0x72,
+	0x8a, 0x01, 0x6f, 0x7a, 0x6d, 0x12, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x62,
+	0x61, 0x73, 0x65, 0x36, 0x34, 0x1a, 0x04, 0x64, 0x62, 0x36, 0x34, 0x22, 0x1d, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x40, 0x01, 0x4a, 0x10, 0x53, 0x47,
+	0x56, 0x73, 0x62, 0x47, 0x38, 0x67, 0x56, 0x32, 0x39, 0x79, 0x62, 0x47, 0x51, 0x3d, 0x4a, 0x0c,
+	0x56, 0x47, 0x56, 0x7a, 0x64, 0x43, 0x42, 0x45, 0x59, 0x58, 0x52, 0x68, 0x4a, 0x14, 0x55, 0x32,
+	0x46, 0x74, 0x63, 0x47, 0x78, 0x6c, 0x49, 0x45, 0x4e, 0x76, 0x62, 0x6e, 0x52, 0x6c, 0x62, 0x6e,
+	0x51, 0x3d, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x61, 0x73, 0x65, 0x36,
+	0x34, 0x12, 0xc1, 0x01, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x82, 0x01, 0x9a, 0x49, 0x7f, 0x8a, 0x01, 0x7c, 0x7a, 0x7a, 0x12,
+	0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x68, 0x65, 0x78, 0x1a, 0x03, 0x64, 0x68,
+	0x78, 0x22, 0x1a, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x68, 0x65, 0x78, 0x20, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x40, 0x02, 0x4a,
+	0x16, 0x34, 0x38, 0x36, 0x35, 0x36, 0x63, 0x36, 0x63, 0x36, 0x66, 0x32, 0x30, 0x35, 0x37, 0x36,
+	0x66, 0x37, 0x32, 0x36, 0x63, 0x36, 0x34, 0x4a, 0x12, 0x35, 0x34, 0x36, 0x35, 0x37, 0x33, 0x37,
+	0x34, 0x32, 0x30, 0x34, 0x34, 0x36, 0x31, 0x37, 0x34, 0x36, 0x31, 0x4a, 0x1c, 0x35, 0x33, 0x36,
+	0x31, 0x36, 0x64, 0x37, 0x30, 0x36, 0x63, 0x36, 0x35, 0x32, 0x30, 0x34, 0x33, 0x36, 0x66, 0x36,
+	0x65, 0x37, 0x34, 0x36, 0x35, 0x36, 0x65, 0x37, 0x34, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x48, 0x65, 0x78, 0x12, 0x5d, 0x0a, 0x0a, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x3e, 0x9a, 0x49, 0x3b, 0x8a, 0x01,
+	0x38, 0x7a, 0x36, 0x12, 0x0a, 0x72, 0x61, 0x77, 0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x1a,
+	0x02, 0x72, 0x63, 0x22, 0x24, 0x52, 0x61, 0x77, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x73, 0x20, 0x28, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74,
+	0x6f, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x29, 0x52, 0x09, 0x72, 0x61, 0x77, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x73, 0x12, 0x75, 0x0a, 0x09, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x58, 0x9a, 0x49, 0x55, 0x8a, 0x01, 0x52, 0x7a,
+	0x50, 0x12, 0x09, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x2d, 0x68, 0x65, 0x78, 0x1a, 0x02, 0x6d, 0x68,
+	0x22, 0x13, 0x4d, 0x69, 0x78, 0x65, 0x64, 0x20, 0x63, 0x61, 0x73, 0x65, 0x20, 0x68, 0x65, 0x78,
+	0x20, 0x64, 0x61, 0x74, 0x61, 0x40, 0x02, 0x4a, 0x0c, 0x61, 0x42, 0x63, 0x31, 0x32, 0x33, 0x44,
+	0x65, 0x46, 0x34, 0x35, 0x36, 0x4a, 0x0c, 0x37, 0x38, 0x39, 0x41, 0x62, 0x43, 0x64, 0x45, 0x30,
+	0x31, 0x32, 0x33, 0x4a, 0x0c, 0x66, 0x46, 0x65, 0x45, 0x64, 0x44, 0x63, 0x43, 0x62, 0x42, 0x61,
+	0x41, 0x52, 0x08, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x48, 0x65, 0x78, 0x12, 0x83, 0x01, 0x0a, 0x0b,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x36, 0x34, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0c, 0x42, 0x62, 0x9a, 0x49, 0x5f, 0x8a, 0x01, 0x5c, 0x7a, 0x5a, 0x12, 0x0b, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x36, 0x34, 0x1a, 0x04, 0x73, 0x62, 0x36, 0x34, 0x22, 0x1d,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x64, 0x61, 0x74, 0x61, 0x40, 0x01, 0x4a,
+	0x0c, 0x77, 0x36, 0x54, 0x44, 0x74, 0x73, 0x4f, 0x38, 0x77, 0x34, 0x51, 0x3d, 0x4a, 0x08, 0x38,
+	0x4a, 0x2b, 0x59, 0x67, 0x41, 0x3d, 0x3d, 0x4a, 0x0c, 0x77, 0x34, 0x50, 0x44, 0x6f, 0x4d, 0x4f,
+	0x67, 0x77, 0x36, 0x45, 0x3d, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x36,
+	0x34, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x8d, 0x08, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x53, 0x0a, 0x08, 0x63, 0x73, 0x76, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x38, 0x9a, 0x49, 0x35, 0x8a, 0x01, 0x32, 0x72, 0x30, 0x12,
+	0x08, 0x63, 0x73, 0x76, 0x2d, 0x74, 0x61, 0x67, 0x73, 0x22, 0x22, 0x54, 0x61, 0x67, 0x73, 0x20,
+	0x69, 0x6e, 0x20, 0x43, 0x53, 0x56, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x28, 0x65,
+	0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x61, 0x2c, 0x22, 0x62, 0x2c, 0x63, 0x22, 0x29, 0x48, 0x01, 0x52,
+	0x07, 0x63, 0x73, 0x76, 0x54, 0x61, 0x67, 0x73, 0x12, 0x55, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x61,
+	0x79, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x32,
+	0x9a, 0x49, 0x2f, 0x8a, 0x01, 0x2c, 0x72, 0x2a, 0x12, 0x0c, 0x61, 0x72, 0x72, 0x61, 0x79, 0x2d,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x18, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x2c, 0x20,
+	0x6f, 0x6e, 0x65, 0x20, 0x70, 0x65, 0x72, 0x20, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x02, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x5d, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x3a, 0x9a, 0x49, 0x37, 0x8a, 0x01, 0x34, 0x72, 0x32, 0x12,
+	0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x1d, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x70, 0x61, 0x74, 0x68, 0x73, 0x20, 0x73, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x27, 0x3a, 0x27, 0x48, 0x03, 0x52, 0x01,
+	0x3a, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x5d,
+	0x0a, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x05, 0x42, 0x3e, 0x9a, 0x49, 0x3b, 0x8a, 0x01, 0x38, 0x1a, 0x36, 0x12, 0x0a, 0x6a, 0x73,
+	0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x26, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x20,
+	0x61, 0x73, 0x20, 0x61, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x20,
+	0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x5b, 0x38, 0x30, 0x2c, 0x34, 0x34, 0x33, 0x5d, 0x29,
+	0x48, 0x04, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x39, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x27, 0x9a, 0x49, 0x24, 0x8a,
+	0x01, 0x21, 0x2a, 0x1f, 0x12, 0x03, 0x69, 0x64, 0x73, 0x22, 0x14, 0x55, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42,
+	0x02, 0x01, 0x02, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x5a, 0x0a, 0x09, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x06, 0x42, 0x3c, 0x9a, 0x49, 0x39,
+	0x8a, 0x01, 0x36, 0x52, 0x34, 0x12, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73,
+	0x22, 0x22, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x73, 0x20, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79,
+	0x20, 0x27, 0x3b, 0x27, 0x48, 0x03, 0x52, 0x01, 0x3b, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x73, 0x12, 0x60, 0x0a, 0x05, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x2c, 0x9a, 0x49, 0x29, 0x8a, 0x01, 0x26, 0x32, 0x24, 0x12, 0x05, 0x73, 0x69, 0x7a,
+	0x65, 0x73, 0x22, 0x16, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x20, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x27, 0x20, 0x27, 0x48, 0x03, 0x52, 0x01, 0x20, 0x52,
+	0x05, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x3a, 0x9a, 0x49, 0x37, 0x8a, 0x01, 0x34, 0x8a, 0x01, 0x31, 0x12, 0x08,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x73, 0x22, 0x23, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x6f, 0x6e, 0x65,
+	0x20, 0x70, 0x65, 0x72, 0x20, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x02, 0x52,
+	0x08, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x73, 0x12, 0x46, 0x0a, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x32, 0x9a, 0x49, 0x2f, 0x8a, 0x01, 0x2c, 0x7a,
+	0x2a, 0x12, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x1e, 0x48, 0x65, 0x78, 0x20, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x43, 0x53, 0x56,
+	0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x02, 0x50, 0x01, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x12, 0x51, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x75,
+	0x6d, 0x31, 0x42, 0x29, 0x9a, 0x49, 0x26, 0x8a, 0x01, 0x23, 0x82, 0x01, 0x20, 0x12, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x73, 0x22, 0x15, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x20, 0x61, 0x73, 0x20, 0x61,
+	0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x61, 0x72, 0x72, 0x61, 0x79, 0x48, 0x04, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x7b, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x45, 0x9a, 0x49, 0x42, 0x8a, 0x01, 0x3f, 0x92, 0x01, 0x3c, 0x12, 0x07, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x23, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x20, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x27, 0x7c, 0x27, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33,
+	0x33, 0x33, 0x39, 0x50, 0x03, 0x5a, 0x01, 0x7c, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xf9, 0x03, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x60, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x4c, 0x9a, 0x49, 0x49, 0x8a, 0x01, 0x46, 0x72, 0x44, 0x12, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x22, 0x32, 0x54, 0x61, 0x67, 0x73, 0x20, 0x61, 0x64, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x28, 0x70,
+	0x61, 0x73, 0x73, 0x20, 0x2d, 0x2d, 0x74, 0x61, 0x67, 0x73, 0x3d, 0x20, 0x74, 0x6f, 0x20, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x29, 0x42, 0x04, 0x62, 0x61, 0x73, 0x65, 0x58, 0x02, 0x60, 0x01, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x7b, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x64, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x58, 0x9a, 0x49, 0x55,
+	0x8a, 0x01, 0x52, 0x72, 0x50, 0x12, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x2d, 0x64,
+	0x69, 0x72, 0x73, 0x22, 0x30, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x20, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x64, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x42, 0x0c, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x58, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69,
+	0x72, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x43, 0x9a, 0x49, 0x40, 0x8a, 0x01, 0x3d, 0x22, 0x3b, 0x12, 0x0a,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x23, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2c, 0x20, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x02, 0x0a, 0x14, 0x58, 0x02, 0x60, 0x01, 0x68, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x76, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x41, 0x9a, 0x49, 0x3e, 0x8a, 0x01, 0x3b, 0x8a, 0x01, 0x38, 0x12, 0x07, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x23, 0x52, 0x65, 0x74, 0x72, 0x79, 0x20, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x73, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x42, 0x02, 0x31, 0x73, 0x42, 0x02,
+	0x32, 0x73, 0x58, 0x01, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x03, 0xa8,
+	0x49, 0x01, 0x22, 0x99, 0x03, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x41, 0x0a, 0x04, 0x63, 0x70, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x42, 0x2d,
+	0x9a, 0x49, 0x2a, 0x8a, 0x01, 0x27, 0x1a, 0x25, 0x12, 0x04, 0x63, 0x70, 0x75, 0x73, 0x22, 0x15,
+	0x43, 0x50, 0x55, 0x20, 0x73, 0x65, 0x74, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x30,
+	0x2d, 0x33, 0x2c, 0x38, 0x29, 0x42, 0x04, 0x00, 0x01, 0x02, 0x03, 0x70, 0x01, 0x52, 0x04, 0x63,
+	0x70, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0d, 0x42, 0x30, 0x9a, 0x49, 0x2d, 0x8a, 0x01, 0x2a, 0x2a, 0x28, 0x12, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x22, 0x1a, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e,
+	0x2c, 0x20, 0x38, 0x30, 0x30, 0x30, 0x2d, 0x38, 0x31, 0x30, 0x30, 0x3a, 0x31, 0x30, 0x29, 0x70,
+	0x01, 0x78, 0x80, 0x08, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x6a, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x35, 0x9a, 0x49, 0x32, 0x8a, 0x01, 0x2f,
+	0x22, 0x2d, 0x12, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x22, 0x1d, 0x53, 0x68, 0x61, 0x72,
+	0x64, 0x20, 0x49, 0x44, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x31, 0x2d, 0x35,
+	0x2c, 0x38, 0x2c, 0x31, 0x30, 0x2d, 0x31, 0x32, 0x29, 0x60, 0x01, 0x68, 0x01, 0x70, 0x01, 0x52,
+	0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x12, 0x42, 0x2a, 0x9a, 0x49, 0x27, 0x8a, 0x01, 0x24,
+	0x42, 0x22, 0x12, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x22, 0x15, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x2d, 0x35, 0x2d, 0x2d,
+	0x31, 0x29, 0x70, 0x01, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x12, 0x3a, 0x0a,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x07, 0x42, 0x24, 0x9a, 0x49,
+	0x21, 0x8a, 0x01, 0x1e, 0x4a, 0x1c, 0x12, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x11, 0x53,
+	0x6c, 0x6f, 0x74, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x30, 0x2d, 0x37, 0x29,
+	0x70, 0x01, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xa9,
+	0x04, 0x0a, 0x0f, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3b, 0x9a, 0x49, 0x38, 0x22, 0x36, 0x12, 0x0a, 0x6d,
+	0x61, 0x78, 0x2d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0x20, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x35,
+	0x31, 0x32, 0x4d, 0x69, 0x42, 0x2c, 0x20, 0x32, 0x47, 0x42, 0x29, 0x48, 0x01, 0x52, 0x04, 0x31,
+	0x47, 0x69, 0x42, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x45,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x31, 0x9a, 0x49,
+	0x2e, 0x2a, 0x2c, 0x12, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x1f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x20, 0x70, 0x65, 0x72, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x20, 0x28,
+	0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x31, 0x30, 0x6b, 0x29, 0x40, 0xe8, 0x07, 0x48, 0x02, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x34, 0x9a, 0x49, 0x31, 0x0a, 0x2f, 0x12,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x1b, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x20, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x20, 0x28, 0x65, 0x2e, 0x67,
+	0x2e, 0x2c, 0x20, 0x38, 0x30, 0x25, 0x29, 0x48, 0x03, 0x52, 0x03, 0x37, 0x35, 0x25, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x66, 0x0a, 0x0b, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x28, 0x9a, 0x49, 0x25,
+	0x22, 0x23, 0x12, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2d, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x0b, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x48, 0x01, 0x52, 0x05,
+	0x36, 0x34, 0x4b, 0x69, 0x42, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x49, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0x9a, 0x49, 0x22, 0x32, 0x20, 0x12, 0x0a, 0x64, 0x69,
+	0x73, 0x6b, 0x2d, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x20, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x48, 0x01, 0x52, 0x04, 0x31, 0x30, 0x47, 0x42, 0x48, 0x00, 0x52, 0x09,
+	0x64, 0x69, 0x73, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x3c,
+	0x9a, 0x49, 0x39, 0x12, 0x37, 0x12, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0x29, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x20, 0x28, 0x65,
+	0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x32, 0x35, 0x30, 0x6d, 0x29, 0x48, 0x02, 0x52, 0x08, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0xe6, 0x02, 0x0a, 0x1a, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x27, 0x9a,
+	0x49, 0x24, 0x9a, 0x01, 0x21, 0x12, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x0f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42,
+	0x03, 0x33, 0x30, 0x73, 0x48, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1c, 0x9a, 0x49, 0x19, 0x9a, 0x01, 0x16, 0x12, 0x03,
+	0x74, 0x74, 0x6c, 0x22, 0x09, 0x43, 0x61, 0x63, 0x68, 0x65, 0x20, 0x54, 0x54, 0x4c, 0x42, 0x02,
+	0x31, 0x68, 0x48, 0x04, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x70, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x28, 0x9a, 0x49, 0x25, 0x9a, 0x01, 0x22, 0x12,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x0d, 0x53, 0x79, 0x6e, 0x63, 0x20,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x05, 0x32, 0x68, 0x33, 0x30, 0x6d, 0x48,
+	0x05, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x10, 0x42, 0x1d, 0x9a, 0x49, 0x1a, 0x9a, 0x01, 0x17, 0x12, 0x05, 0x67, 0x72,
+	0x61, 0x63, 0x65, 0x22, 0x0c, 0x47, 0x72, 0x61, 0x63, 0x65, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x48, 0x01, 0x48, 0x00, 0x52, 0x07, 0x67, 0x72, 0x61, 0x63, 0x65, 0x4e, 0x73, 0x88, 0x01,
+	0x01, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x6e, 0x73, 0x22, 0xff, 0x02, 0x0a, 0x1b, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x71, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x38, 0x9a, 0x49, 0x35, 0x9a, 0x01, 0x32, 0x12, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x33, 0x30,
+	0x64, 0x2c, 0x20, 0x32, 0x77, 0x29, 0x42, 0x03, 0x33, 0x30, 0x64, 0x52, 0x09, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7e, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x47, 0x9a, 0x49, 0x44, 0x8a, 0x01, 0x41, 0x8a, 0x01, 0x3e, 0x12, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x26, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x20, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e,
+	0x2c, 0x20, 0x31, 0x64, 0x31, 0x32, 0x68, 0x2c, 0x20, 0x50, 0x31, 0x44, 0x54, 0x32, 0x48, 0x29,
+	0x42, 0x02, 0x31, 0x77, 0x42, 0x06, 0x50, 0x31, 0x44, 0x54, 0x32, 0x48, 0x52, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x68, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x3f, 0x9a, 0x49, 0x3c, 0x9a, 0x01, 0x39, 0x12, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x2d, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x20, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x20, 0x69, 0x6e, 0x20, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x42, 0x02, 0x32, 0x77, 0x48, 0x06,
+	0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xf5, 0x03, 0x0a, 0x1c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x6a, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x38, 0x9a, 0x49, 0x35, 0xa2, 0x01, 0x32, 0x12, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x22, 0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74,
+	0x69, 0x6d, 0x65, 0x20, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33,
+	0x33, 0x33, 0x39, 0x4a, 0x06, 0x6e, 0x6f, 0x77, 0x2d, 0x31, 0x68, 0x52, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x12, 0xb1, 0x01, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x73, 0x9a, 0x49, 0x70, 0xa2, 0x01, 0x6d, 0x12, 0x0b, 0x6d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x27, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x42, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x20, 0x74, 0x69,
+	0x6d, 0x65, 0x42, 0x08, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x07, 0x52, 0x46,
+	0x43, 0x33, 0x33, 0x33, 0x39, 0x4a, 0x13, 0x32, 0x30, 0x32, 0x34, 0x2d, 0x30, 0x31, 0x2d, 0x30,
+	0x31, 0x20, 0x30, 0x32, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x52, 0x0d, 0x45, 0x75, 0x72, 0x6f,
+	0x70, 0x65, 0x2f, 0x42, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0xaf, 0x01, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x71, 0x9a, 0x49, 0x6e, 0x8a, 0x01, 0x6b,
+	0x92, 0x01, 0x68, 0x12, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x22, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x20, 0x69, 0x6e,
+	0x20, 0x54, 0x6f, 0x6b, 0x79, 0x6f, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x08, 0x44, 0x61, 0x74,
+	0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x4a, 0x05,
+	0x74, 0x6f, 0x64, 0x61, 0x79, 0x4a, 0x0c, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79,
+	0x2b, 0x36, 0x68, 0x4a, 0x0a, 0x32, 0x30, 0x32, 0x34, 0x2d, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x7a,
+	0x0a, 0x41, 0x73, 0x69, 0x61, 0x2f, 0x54, 0x6f, 0x6b, 0x79, 0x6f, 0x52, 0x0b, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x9b, 0x04,
+	0x0a, 0x17, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x62, 0x69, 0x6e,
+	0x64, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x9a, 0x49, 0x21, 0x72,
+	0x1f, 0x12, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x2d, 0x69, 0x70, 0x22, 0x12, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x20, 0x74, 0x6f, 0x48, 0x01,
+	0x52, 0x06, 0x62, 0x69, 0x6e, 0x64, 0x49, 0x70, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0x9a, 0x49, 0x28, 0x72, 0x26, 0x12,
+	0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x22, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x20, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x42, 0x0a, 0x31, 0x30, 0x2e, 0x31, 0x2e, 0x32, 0x2e,
+	0x33, 0x2f, 0x38, 0x48, 0x04, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x6d, 0x0a,
+	0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x33, 0x9a,
+	0x49, 0x30, 0x72, 0x2e, 0x12, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x10,
+	0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38, 0x30,
+	0x48, 0x05, 0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x44, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
+	0x9a, 0x49, 0x20, 0x72, 0x1e, 0x12, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22,
+	0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x48, 0x06, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x55, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x3b, 0x9a, 0x49, 0x38, 0x8a, 0x01, 0x35, 0x72, 0x33, 0x12, 0x07, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22, 0x10, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x20,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x42, 0x0b, 0x31, 0x32, 0x37, 0x2e, 0x30, 0x2e,
+	0x30, 0x2e, 0x31, 0x2f, 0x38, 0x42, 0x07, 0x3a, 0x3a, 0x31, 0x2f, 0x31, 0x32, 0x38, 0x70, 0x04,
+	0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x5e, 0x0a, 0x06, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x28, 0x9a, 0x49, 0x25, 0x8a, 0x01, 0x22, 0x72,
+	0x20, 0x12, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x70,
+	0x08, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x0f,
+	0x50, 0x61, 0x74, 0x68, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x66, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x4e, 0x9a, 0x49, 0x4b, 0x72, 0x49, 0x12, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x12,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x69,
+	0x6c, 0x65, 0x42, 0x19, 0x7e, 0x2f, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x61, 0x70,
+	0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x79, 0x61, 0x6d, 0x6c, 0x52, 0x10, 0x08,
+	0x01, 0x18, 0x02, 0x22, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x22, 0x04, 0x2e, 0x79, 0x6d, 0x6c, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x70, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x37, 0x9a, 0x49, 0x34, 0x72, 0x32, 0x12, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x2d, 0x64, 0x69, 0x72, 0x22, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x20, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x16, 0x12, 0x12, 0x24, 0x48, 0x4f, 0x4d,
+	0x45, 0x2f, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x03,
+	0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x9a, 0x49, 0x1b, 0x72, 0x19,
+	0x12, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x0b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x20, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x02, 0x18, 0x04, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x66, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x4a, 0x9a, 0x49, 0x47, 0x8a, 0x01, 0x44,
+	0x72, 0x42, 0x12, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x22, 0x13, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x20, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x42, 0x0d, 0x24, 0x48, 0x4f, 0x4d, 0x45, 0x2f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x42, 0x0c, 0x2f, 0x75, 0x73, 0x72, 0x2f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x7a, 0x04,
+	0x08, 0x01, 0x18, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x3a, 0x03,
+	0xa8, 0x49, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xe1,
+	0x02, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x41, 0x9a, 0x49, 0x3e, 0x72, 0x3c, 0x12, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x20, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x04, 0x6e, 0x6f, 0x6e,
+	0x65, 0x5a, 0x04, 0x67, 0x7a, 0x69, 0x70, 0x5a, 0x04, 0x7a, 0x73, 0x74, 0x64, 0x5a, 0x04, 0x6e,
+	0x6f, 0x6e, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x6d, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x39, 0x9a,
+	0x49, 0x36, 0x72, 0x34, 0x12, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x09, 0x4c, 0x6f, 0x67,
+	0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x5a, 0x05, 0x44, 0x45,
+	0x42, 0x55, 0x47, 0x5a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x5a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x5a,
+	0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x60, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x6c, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x50, 0x9a, 0x49, 0x4d, 0x8a, 0x01, 0x4a, 0x72, 0x48, 0x12, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x10, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x20, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x42, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x82, 0x01, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x82, 0x01, 0x07, 0x74, 0x72, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x82, 0x01, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67,
+	0x88, 0x01, 0x01, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x03, 0xa8,
+	0x49, 0x01, 0x22, 0xb4, 0x03, 0x0a, 0x12, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x54, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x9a, 0x49, 0x21,
+	0x72, 0x1f, 0x12, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x11, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x68,
+	0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x5f, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x25, 0x9a, 0x49, 0x22,
+	0x72, 0x20, 0x12, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x10, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x06,
+	0x68, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1e, 0x9a, 0x49, 0x1b, 0x7a, 0x19,
+	0x12, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x0e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x6b, 0x65, 0x79, 0x40, 0x02, 0x50, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x52,
+	0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x21, 0x9a, 0x49, 0x1e, 0x7a, 0x1c,
+	0x12, 0x04, 0x63, 0x65, 0x72, 0x74, 0x22, 0x12, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x01, 0x52, 0x04, 0x63, 0x65,
+	0x72, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x1f, 0x9a, 0x49, 0x1c, 0x8a, 0x01, 0x19, 0x72, 0x17, 0x12, 0x06, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x22, 0x0a, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x90, 0x01, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x73,
+	0x61, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x21, 0x9a, 0x49, 0x1e, 0x8a,
+	0x01, 0x1b, 0x7a, 0x19, 0x12, 0x05, 0x73, 0x61, 0x6c, 0x74, 0x73, 0x22, 0x0e, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x73, 0x61, 0x6c, 0x74, 0x73, 0x78, 0x01, 0x52, 0x05, 0x73,
+	0x61, 0x6c, 0x74, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x86, 0x03, 0x0a, 0x14, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x19, 0x9a, 0x49, 0x16, 0x72, 0x14, 0x22, 0x07, 0x41, 0x50, 0x49, 0x20,
+	0x6b, 0x65, 0x79, 0x42, 0x07, 0x64, 0x65, 0x76, 0x2d, 0x6b, 0x65, 0x79, 0x70, 0x01, 0x52, 0x06,
+	0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x21, 0x9a, 0x49, 0x1e, 0x7a, 0x1c, 0x12, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x50, 0x01, 0x58, 0x01, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x39,
+	0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x21,
+	0x9a, 0x49, 0x1e, 0x8a, 0x01, 0x1b, 0x72, 0x19, 0x12, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x22, 0x0a, 0x41, 0x50, 0x49, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x28, 0x01, 0x98, 0x01,
+	0x01, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x66, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x22, 0x9a, 0x49, 0x1f, 0x92, 0x01, 0x1c, 0x12, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x50, 0x01, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
 	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x03, 0xa8,
-	0x49, 0x01, 0x22, 0xcf, 0x06, 0x0a, 0x19, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x53, 0x6c, 0x69, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0xc4, 0x01, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x42, 0x86, 0x01, 0x9a, 0x49, 0x82, 0x01, 0x8a, 0x01, 0x7f, 0x92, 0x01, 0x7c, 0x12,
-	0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x1a, 0x02, 0x65, 0x74,
-	0x22, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x32, 0x30, 0x32, 0x33, 0x2d, 0x30,
-	0x31, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x2c, 0x20,
-	0x32, 0x30, 0x32, 0x33, 0x2d, 0x31, 0x32, 0x2d, 0x33, 0x31, 0x54, 0x32, 0x33, 0x3a, 0x35, 0x39,
-	0x3a, 0x35, 0x39, 0x5a, 0x29, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x4a, 0x14,
-	0x32, 0x30, 0x32, 0x33, 0x2d, 0x31, 0x32, 0x2d, 0x32, 0x35, 0x54, 0x31, 0x30, 0x3a, 0x33, 0x30,
-	0x3a, 0x30, 0x30, 0x5a, 0x4a, 0x05, 0x6e, 0x6f, 0x77, 0x28, 0x29, 0x52, 0x0a, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x4e, 0x9a, 0x49,
-	0x4b, 0x8a, 0x01, 0x48, 0x92, 0x01, 0x45, 0x12, 0x0e, 0x6c, 0x6f, 0x67, 0x2d, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x1a, 0x02, 0x6c, 0x74, 0x22, 0x26, 0x4c, 0x6f, 0x67,
-	0x20, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x73, 0x20, 0x69, 0x6e, 0x20, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x20, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x52, 0x0d, 0x6c, 0x6f,
-	0x67, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x0f,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x47, 0x9a, 0x49, 0x44, 0x8a, 0x01, 0x41, 0x92, 0x01, 0x3e, 0x12, 0x0f, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x1a, 0x02, 0x73,
-	0x74, 0x22, 0x1e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x61, 0x73,
-	0x6b, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x0c, 0x62,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x49, 0x01, 0x22, 0xb3, 0x05, 0x0a, 0x18, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x39, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23,
+	0x9a, 0x49, 0x20, 0x7a, 0x1e, 0x12, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0d, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x40, 0x03, 0x4a, 0x04, 0x2d,
+	0x5f, 0x38, 0x3d, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x58, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x25, 0x9a, 0x49, 0x22, 0x7a, 0x20, 0x12, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x22, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x40, 0x04, 0x4a, 0x06, 0x41, 0x41, 0x45, 0x43, 0x41, 0x77, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0b, 0x6a, 0x77, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x29, 0x9a, 0x49, 0x26, 0x7a, 0x24,
+	0x12, 0x0b, 0x6a, 0x77, 0x74, 0x2d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x13, 0x4a,
+	0x57, 0x54, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x40, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x6a, 0x77, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x27, 0x9a, 0x49, 0x24, 0x7a, 0x22, 0x12, 0x08,
+	0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x0d, 0x47, 0x72, 0x65, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x20, 0x74, 0x65, 0x78, 0x74, 0x40, 0x06, 0x4a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+	0x52, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x57, 0x0a, 0x04, 0x63, 0x65,
+	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x26, 0x9a, 0x49, 0x23, 0x7a, 0x21, 0x12, 0x04, 0x63, 0x65,
+	0x72, 0x74, 0x22, 0x17, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x40, 0x07, 0x52, 0x04, 0x63,
+	0x65, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0c, 0x42, 0x27, 0x9a, 0x49, 0x24, 0x8a, 0x01, 0x21, 0x7a, 0x1f, 0x12, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x0d, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x20, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x40, 0x06, 0x4a, 0x01, 0x61, 0x4a, 0x01, 0x62, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x62, 0x0a, 0x08, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x2a, 0x9a, 0x49, 0x27, 0x8a, 0x01, 0x24, 0x7a, 0x22, 0x12, 0x08, 0x63,
+	0x61, 0x2d, 0x63, 0x65, 0x72, 0x74, 0x73, 0x22, 0x14, 0x43, 0x41, 0x20, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x40, 0x07, 0x52,
+	0x07, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x59, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x28, 0x9a, 0x49, 0x25, 0x8a, 0x01, 0x22, 0x7a, 0x20, 0x12, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x22, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6b, 0x65, 0x79,
+	0x73, 0x40, 0x05, 0x4a, 0x03, 0x2d, 0x5f, 0x38, 0x4a, 0x03, 0x41, 0x41, 0x45, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6a, 0x77, 0x74,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xb3, 0x03, 0x0a, 0x11, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x74,
+	0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x45, 0x9a, 0x49, 0x42, 0xb2, 0x01, 0x3f, 0x12, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x15, 0x45, 0x78, 0x74, 0x72, 0x61, 0x20, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x1f, 0x7b, 0x22,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x30, 0x2c, 0x20, 0x22, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x61, 0x66, 0x65, 0x22, 0x7d, 0x52, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x12, 0x60, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x2c,
+	0x9a, 0x49, 0x29, 0xb2, 0x01, 0x26, 0x12, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x12, 0x46, 0x72, 0x65, 0x65, 0x2d, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x06, 0x22, 0x6e, 0x6f, 0x6e, 0x65, 0x22, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5a, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x2a, 0x9a, 0x49, 0x27, 0xb2, 0x01, 0x24, 0x12, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x10,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x20, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x42, 0x0a, 0x5b, 0x31, 0x2c, 0x20, 0x22, 0x74, 0x77, 0x6f, 0x22, 0x5d, 0x52, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x12, 0x65, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x42, 0x2a, 0x9a, 0x49, 0x27, 0xb2, 0x01, 0x24, 0x12, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x13, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x20, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xac,
+	0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x1a, 0x38, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xb6, 0x02,
+	0x0a, 0x14, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x54, 0x9a, 0x49, 0x51, 0xba, 0x01, 0x4e,
+	0x12, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x18, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x74, 0x6f,
+	0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x4a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x4a, 0x0f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x5d, 0x9a,
-	0x49, 0x5a, 0x8a, 0x01, 0x57, 0x92, 0x01, 0x54, 0x12, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x2d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x1a, 0x02, 0x62, 0x74, 0x22, 0x37, 0x42, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x2c, 0x20, 0x32, 0x30,
-	0x32, 0x34, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x32, 0x3a, 0x30, 0x30, 0x3a, 0x30,
-	0x30, 0x5a, 0x29, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x52, 0x0b, 0x62, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x13, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x57, 0x9a, 0x49, 0x54, 0x8a, 0x01, 0x51, 0x92, 0x01, 0x4e, 0x12, 0x13,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2d, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2d, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x1a, 0x03, 0x63, 0x66, 0x74, 0x22, 0x18, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x73, 0x42, 0x07, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x42, 0x07, 0x49, 0x53, 0x4f,
-	0x38, 0x36, 0x30, 0x31, 0x42, 0x06, 0x52, 0x46, 0x43, 0x38, 0x32, 0x32, 0x52, 0x11, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x3a,
-	0x03, 0xa8, 0x49, 0x01, 0x22, 0xb4, 0x07, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x61, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x3c, 0x9a, 0x49, 0x39, 0x8a, 0x01, 0x36,
-	0x7a, 0x34, 0x12, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x73, 0x1a, 0x03, 0x62, 0x36, 0x34, 0x22, 0x1c, 0x44, 0x61, 0x74, 0x61, 0x20, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x40, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x43, 0x68,
-	0x75, 0x6e, 0x6b, 0x73, 0x12, 0x54, 0x0a, 0x0a, 0x68, 0x65, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x35, 0x9a, 0x49, 0x32, 0x8a, 0x01, 0x2f,
-	0x7a, 0x2d, 0x12, 0x0a, 0x68, 0x65, 0x78, 0x2d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x1a, 0x02,
-	0x68, 0x78, 0x22, 0x19, 0x44, 0x61, 0x74, 0x61, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x20,
-	0x69, 0x6e, 0x20, 0x68, 0x65, 0x78, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x40, 0x02, 0x52,
-	0x09, 0x68, 0x65, 0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x0e, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x75, 0x9a, 0x49, 0x72, 0x8a, 0x01, 0x6f, 0x7a, 0x6d, 0x12, 0x0e, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x2d, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x1a, 0x04, 0x64, 0x62, 0x36, 0x34,
-	0x22, 0x1d, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34,
-	0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x40,
-	0x01, 0x4a, 0x10, 0x53, 0x47, 0x56, 0x73, 0x62, 0x47, 0x38, 0x67, 0x56, 0x32, 0x39, 0x79, 0x62,
-	0x47, 0x51, 0x3d, 0x4a, 0x0c, 0x56, 0x47, 0x56, 0x7a, 0x64, 0x43, 0x42, 0x45, 0x59, 0x58, 0x52,
-	0x68, 0x4a, 0x14, 0x55, 0x32, 0x46, 0x74, 0x63, 0x47, 0x78, 0x6c, 0x49, 0x45, 0x4e, 0x76, 0x62,
-	0x6e, 0x52, 0x6c, 0x62, 0x6e, 0x51, 0x3d, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x12, 0xc1, 0x01, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x82, 0x01, 0x9a, 0x49, 0x7f, 0x8a,
-	0x01, 0x7c, 0x7a, 0x7a, 0x12, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2d, 0x68, 0x65,
-	0x78, 0x1a, 0x03, 0x64, 0x68, 0x78, 0x22, 0x1a, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20,
-	0x68, 0x65, 0x78, 0x20, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x40, 0x02, 0x4a, 0x16, 0x34, 0x38, 0x36, 0x35, 0x36, 0x63, 0x36, 0x63, 0x36, 0x66,
-	0x32, 0x30, 0x35, 0x37, 0x36, 0x66, 0x37, 0x32, 0x36, 0x63, 0x36, 0x34, 0x4a, 0x12, 0x35, 0x34,
-	0x36, 0x35, 0x37, 0x33, 0x37, 0x34, 0x32, 0x30, 0x34, 0x34, 0x36, 0x31, 0x37, 0x34, 0x36, 0x31,
-	0x4a, 0x1c, 0x35, 0x33, 0x36, 0x31, 0x36, 0x64, 0x37, 0x30, 0x36, 0x63, 0x36, 0x35, 0x32, 0x30,
-	0x34, 0x33, 0x36, 0x66, 0x36, 0x65, 0x37, 0x34, 0x36, 0x35, 0x36, 0x65, 0x37, 0x34, 0x52, 0x0a,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x78, 0x12, 0x5d, 0x0a, 0x0a, 0x72, 0x61,
-	0x77, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x3e,
-	0x9a, 0x49, 0x3b, 0x8a, 0x01, 0x38, 0x7a, 0x36, 0x12, 0x0a, 0x72, 0x61, 0x77, 0x2d, 0x63, 0x68,
-	0x75, 0x6e, 0x6b, 0x73, 0x1a, 0x02, 0x72, 0x63, 0x22, 0x24, 0x52, 0x61, 0x77, 0x20, 0x64, 0x61,
-	0x74, 0x61, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x20, 0x28, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x29, 0x52, 0x09,
-	0x72, 0x61, 0x77, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x75, 0x0a, 0x09, 0x6d, 0x69, 0x78,
-	0x65, 0x64, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x58, 0x9a, 0x49,
-	0x55, 0x8a, 0x01, 0x52, 0x7a, 0x50, 0x12, 0x09, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x2d, 0x68, 0x65,
-	0x78, 0x1a, 0x02, 0x6d, 0x68, 0x22, 0x13, 0x4d, 0x69, 0x78, 0x65, 0x64, 0x20, 0x63, 0x61, 0x73,
-	0x65, 0x20, 0x68, 0x65, 0x78, 0x20, 0x64, 0x61, 0x74, 0x61, 0x40, 0x02, 0x4a, 0x0c, 0x61, 0x42,
-	0x63, 0x31, 0x32, 0x33, 0x44, 0x65, 0x46, 0x34, 0x35, 0x36, 0x4a, 0x0c, 0x37, 0x38, 0x39, 0x41,
-	0x62, 0x43, 0x64, 0x45, 0x30, 0x31, 0x32, 0x33, 0x4a, 0x0c, 0x66, 0x46, 0x65, 0x45, 0x64, 0x44,
-	0x63, 0x43, 0x62, 0x42, 0x61, 0x41, 0x52, 0x08, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x48, 0x65, 0x78,
-	0x12, 0x83, 0x01, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x36, 0x34,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x62, 0x9a, 0x49, 0x5f, 0x8a, 0x01, 0x5c, 0x7a, 0x5a,
-	0x12, 0x0b, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x36, 0x34, 0x1a, 0x04, 0x73,
-	0x62, 0x36, 0x34, 0x22, 0x1d, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x20, 0x63, 0x68, 0x61,
-	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x20, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x20, 0x64, 0x61,
-	0x74, 0x61, 0x40, 0x01, 0x4a, 0x0c, 0x77, 0x36, 0x54, 0x44, 0x74, 0x73, 0x4f, 0x38, 0x77, 0x34,
-	0x51, 0x3d, 0x4a, 0x08, 0x38, 0x4a, 0x2b, 0x59, 0x67, 0x41, 0x3d, 0x3d, 0x4a, 0x0c, 0x77, 0x34,
-	0x50, 0x44, 0x6f, 0x4d, 0x4f, 0x67, 0x77, 0x36, 0x45, 0x3d, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x61, 0x6c, 0x42, 0x36, 0x34, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x2a, 0x7e, 0x0a, 0x09, 0x54,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x45, 0x9a,
+	0x49, 0x42, 0xba, 0x01, 0x3f, 0x12, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x6d,
+	0x61, 0x73, 0x6b, 0x22, 0x18, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x15, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x73,
+	0x6b, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x3a, 0x0a, 0x08, 0x53, 0x33, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x22, 0x23, 0x0a, 0x09, 0x47, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xd8, 0x02, 0x0a, 0x0e, 0x41, 0x6e, 0x79, 0x54,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0xe0, 0x01, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x42, 0xad, 0x01, 0x9a, 0x49, 0xa9, 0x01, 0xc2, 0x01, 0xa5, 0x01, 0x12, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x1e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x53, 0x33, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x47, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x58, 0x7b, 0x22, 0x40, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x53, 0x33, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2c, 0x20, 0x22, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x73, 0x2d, 0x65, 0x61, 0x73, 0x74, 0x2d,
+	0x31, 0x22, 0x7d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x5e, 0x0a,
+	0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x2a, 0x9a, 0x49, 0x27, 0xc2, 0x01, 0x24, 0x12, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x03, 0xa8,
+	0x49, 0x01, 0x22, 0xf4, 0x03, 0x0a, 0x15, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x71, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44,
+	0x61, 0x74, 0x65, 0x42, 0x3f, 0x9a, 0x49, 0x3c, 0xca, 0x01, 0x39, 0x12, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x2d, 0x64, 0x61, 0x74, 0x65, 0x22, 0x1f, 0x46, 0x69, 0x72, 0x73, 0x74, 0x20, 0x64,
+	0x61, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x0a, 0x32, 0x30, 0x32, 0x36, 0x2d, 0x31,
+	0x30, 0x2d, 0x31, 0x36, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x6a, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x42, 0x2f, 0x9a,
+	0x49, 0x2c, 0xd2, 0x01, 0x29, 0x12, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x12, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x05, 0x30, 0x38, 0x3a, 0x33, 0x30, 0x52, 0x0b,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x24,
+	0x9a, 0x49, 0x21, 0xda, 0x01, 0x1e, 0x12, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x0a, 0x55,
+	0x6e, 0x69, 0x74, 0x20, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x09, 0x31, 0x32, 0x2e, 0x35, 0x30,
+	0x20, 0x55, 0x53, 0x44, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x61, 0x74, 0x4c,
+	0x6e, 0x67, 0x42, 0x23, 0x9a, 0x49, 0x20, 0xe2, 0x01, 0x1d, 0x12, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x01, 0x6c, 0x22, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x51, 0x0a, 0x08, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x20, 0x9a, 0x49, 0x1d, 0xea, 0x01,
+	0x1a, 0x12, 0x08, 0x74, 0x61, 0x78, 0x2d, 0x72, 0x61, 0x74, 0x65, 0x22, 0x08, 0x54, 0x61, 0x78,
+	0x20, 0x72, 0x61, 0x74, 0x65, 0x42, 0x04, 0x30, 0x2e, 0x31, 0x39, 0x52, 0x07, 0x74, 0x61, 0x78,
+	0x52, 0x61, 0x74, 0x65, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xf7, 0x01, 0x0a, 0x09, 0x54, 0x4c,
+	0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0x9a, 0x49, 0x2b, 0x72,
+	0x29, 0x12, 0x09, 0x63, 0x65, 0x72, 0x74, 0x2d, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x10, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0a,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x63, 0x72, 0x74, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x9a, 0x49, 0x2a, 0x72, 0x28, 0x12, 0x08, 0x6b,
+	0x65, 0x79, 0x2d, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x10, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x20, 0x6b, 0x65, 0x79, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6b, 0x65, 0x79, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x53,
+	0x0a, 0x0d, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2e, 0x9a, 0x49, 0x2b, 0x8a, 0x01, 0x28, 0x72, 0x26, 0x12,
+	0x0d, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x2d, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x22, 0x15,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x20, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69,
+	0x74, 0x65, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x54,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x56, 0x0a, 0x03, 0x74, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x32, 0x9a, 0x49, 0x2f, 0xaa, 0x01,
+	0x2c, 0x08, 0x01, 0x12, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x01, 0x22, 0x21, 0x54, 0x4c, 0x53, 0x20,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x73,
+	0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x65, 0x78, 0x74, 0x52, 0x03, 0x74,
+	0x6c, 0x73, 0x12, 0x50, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x42, 0x2a, 0x9a, 0x49, 0x27, 0xaa, 0x01, 0x24, 0x18, 0x01, 0x22, 0x1d, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x20, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x61, 0x73, 0x20, 0x4a, 0x53,
+	0x4f, 0x4e, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x65, 0x78, 0x74, 0x2a, 0x01, 0x6f, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xf9, 0x01, 0x0a, 0x13, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x3f, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x1b, 0x9a, 0x49, 0x18, 0xaa, 0x01, 0x15, 0x08, 0x01, 0x12, 0x03, 0x74, 0x6c, 0x73, 0x22,
+	0x0a, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x54, 0x4c, 0x53, 0x30, 0x01, 0x52, 0x03, 0x74,
+	0x6c, 0x73, 0x12, 0x42, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x42, 0x1c, 0x9a, 0x49, 0x19, 0xaa, 0x01, 0x16, 0x22, 0x12, 0x53, 0x65, 0x74, 0x20, 0x61,
+	0x6e, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x30, 0x01, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
+	0x27, 0x9a, 0x49, 0x24, 0xaa, 0x01, 0x21, 0x12, 0x07, 0x64, 0x72, 0x79, 0x2d, 0x72, 0x75, 0x6e,
+	0x22, 0x16, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x21, 0x9a, 0x49, 0x1e, 0x6a, 0x1c, 0x12,
+	0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x1a, 0x01, 0x76, 0x22, 0x0e, 0x56, 0x65, 0x72,
+	0x62, 0x6f, 0x73, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x62, 0x6f, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x9a, 0x49, 0x1e, 0x72, 0x1c, 0x12, 0x09,
+	0x6c, 0x6f, 0x67, 0x2d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x09, 0x4c, 0x6f, 0x67, 0x20, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x42, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x42, 0x0a, 0x9a, 0x49, 0x07, 0xaa, 0x01, 0x04, 0x08, 0x01, 0x38, 0x01, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x19, 0x9a, 0x49, 0x16, 0x72, 0x14, 0x12, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x2d, 0x9a, 0x49, 0x2a, 0x2a,
+	0x28, 0x12, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x1a, 0x4d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x40, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x60, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x2b, 0x9a, 0x49, 0x28, 0x9a, 0x01, 0x25, 0x12, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x22, 0x16, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x42, 0x02, 0x31, 0x73, 0x52, 0x07, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x3a, 0x03, 0xa0, 0x49, 0x01, 0x22, 0xde, 0x01, 0x0a, 0x1b, 0x55,
+	0x6e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0x9a,
+	0x49, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x85,
+	0x01, 0x0a, 0x0e, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x4a, 0x9a, 0x49, 0x47,
+	0xaa, 0x01, 0x44, 0x08, 0x01, 0x12, 0x0e, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2d,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x22, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x20, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x6f, 0x6e, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x20, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x30, 0x01, 0x52, 0x0d, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x3a, 0x03, 0xa8, 0x49, 0x01, 0x2a, 0x7e, 0x0a, 0x09, 0x54,
 	0x65, 0x73, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x45, 0x53, 0x54,
 	0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
 	0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x4e, 0x55, 0x4d,
@@ -3379,7 +6044,7 @@ func file_tests_test_proto_rawDescGZIP() []byte {
 }
 
 var file_tests_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_tests_test_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_tests_test_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_tests_test_proto_goTypes = []interface{}{
 	(TestEnum1)(0),                       // 0: tests.TestEnum1
 	(*TestForMessage)(nil),               // 1: tests.TestForMessage
@@ -3405,133 +6070,222 @@ var file_tests_test_proto_goTypes = []interface{}{
 	(*ComprehensiveMapTestMessage)(nil),  // 21: tests.ComprehensiveMapTestMessage
 	(*TimestampSliceTestMessage)(nil),    // 22: tests.TimestampSliceTestMessage
 	(*RepeatedBytesTestMessage)(nil),     // 23: tests.RepeatedBytesTestMessage
-	nil,                                  // 24: tests.TestForMessage.LabelsEntry
-	nil,                                  // 25: tests.TestForMessage.CountersEntry
-	nil,                                  // 26: tests.TestForMessage.StringMapEntry
-	nil,                                  // 27: tests.TestForMessage.Int32MapEntry
-	nil,                                  // 28: tests.TestForMessage.Int64MapEntry
-	nil,                                  // 29: tests.TestForMessage.Uint32MapEntry
-	nil,                                  // 30: tests.TestForMessage.Uint64MapEntry
-	nil,                                  // 31: tests.TestForMessage.Sfixed32MapEntry
-	nil,                                  // 32: tests.TestForMessage.Sfixed64MapEntry
-	nil,                                  // 33: tests.TestForMessage.JsonMapEntry
-	nil,                                  // 34: tests.ComprehensiveMapTestMessage.JsonLabelsEntry
-	nil,                                  // 35: tests.ComprehensiveMapTestMessage.NativeLabelsEntry
-	nil,                                  // 36: tests.ComprehensiveMapTestMessage.DefaultCountersEntry
-	nil,                                  // 37: tests.ComprehensiveMapTestMessage.LegacyConfigEntry
-	nil,                                  // 38: tests.ComprehensiveMapTestMessage.SecretConfigEntry
-	(*wrapperspb.CustomWrapper)(nil),     // 39: tests.wrapperspb.CustomWrapper
-	(*utils.SimpleMessage)(nil),          // 40: tests.utils.SimpleMessage
-	(*wrapperspb1.BytesValue)(nil),       // 41: google.protobuf.BytesValue
-	(*durationpb.Duration)(nil),          // 42: google.protobuf.Duration
-	(*utils1.NestedMessage)(nil),         // 43: tests.utils.utils.NestedMessage
-	(*types.CustomType)(nil),             // 44: tests.types.CustomType
-	(*timestamppb.Timestamp)(nil),        // 45: google.protobuf.Timestamp
-	(*wrapperspb1.BoolValue)(nil),        // 46: google.protobuf.BoolValue
-	(*wrapperspb1.DoubleValue)(nil),      // 47: google.protobuf.DoubleValue
-	(*wrapperspb1.FloatValue)(nil),       // 48: google.protobuf.FloatValue
-	(*wrapperspb1.StringValue)(nil),      // 49: google.protobuf.StringValue
-	(*wrapperspb1.Int32Value)(nil),       // 50: google.protobuf.Int32Value
-	(*wrapperspb1.Int64Value)(nil),       // 51: google.protobuf.Int64Value
-	(*wrapperspb1.UInt32Value)(nil),      // 52: google.protobuf.UInt32Value
-	(*wrapperspb1.UInt64Value)(nil),      // 53: google.protobuf.UInt64Value
+	(*RepeatedSplitTestMessage)(nil),     // 24: tests.RepeatedSplitTestMessage
+	(*RepeatedMergeTestMessage)(nil),     // 25: tests.RepeatedMergeTestMessage
+	(*RepeatedRangesTestMessage)(nil),    // 26: tests.RepeatedRangesTestMessage
+	(*UnitTestMessage)(nil),              // 27: tests.UnitTestMessage
+	(*IntegerDurationTestMessage)(nil),   // 28: tests.IntegerDurationTestMessage
+	(*ExtendedDurationTestMessage)(nil),  // 29: tests.ExtendedDurationTestMessage
+	(*RelativeTimestampTestMessage)(nil), // 30: tests.RelativeTimestampTestMessage
+	(*StringFormatTestMessage)(nil),      // 31: tests.StringFormatTestMessage
+	(*PathTestMessage)(nil),              // 32: tests.PathTestMessage
+	(*StringChoicesTestMessage)(nil),     // 33: tests.StringChoicesTestMessage
+	(*FileRefTestMessage)(nil),           // 34: tests.FileRefTestMessage
+	(*SensitiveTestMessage)(nil),         // 35: tests.SensitiveTestMessage
+	(*BytesEncodingTestMessage)(nil),     // 36: tests.BytesEncodingTestMessage
+	(*StructTestMessage)(nil),            // 37: tests.StructTestMessage
+	(*Profile)(nil),                      // 38: tests.Profile
+	(*FieldMaskTestMessage)(nil),         // 39: tests.FieldMaskTestMessage
+	(*S3Config)(nil),                     // 40: tests.S3Config
+	(*GCSConfig)(nil),                    // 41: tests.GCSConfig
+	(*AnyTestMessage)(nil),               // 42: tests.AnyTestMessage
+	(*GoogleTypeTestMessage)(nil),        // 43: tests.GoogleTypeTestMessage
+	(*TLSConfig)(nil),                    // 44: tests.TLSConfig
+	(*LiteralTestMessage)(nil),           // 45: tests.LiteralTestMessage
+	(*PresenceTestMessage)(nil),          // 46: tests.PresenceTestMessage
+	(*CommonOptions)(nil),                // 47: tests.CommonOptions
+	(*InlineTestMessage)(nil),            // 48: tests.InlineTestMessage
+	(*RetryPolicy)(nil),                  // 49: tests.RetryPolicy
+	(*UnexportedNestedTestMessage)(nil),  // 50: tests.UnexportedNestedTestMessage
+	nil,                                  // 51: tests.TestForMessage.LabelsEntry
+	nil,                                  // 52: tests.TestForMessage.CountersEntry
+	nil,                                  // 53: tests.TestForMessage.StringMapEntry
+	nil,                                  // 54: tests.TestForMessage.Int32MapEntry
+	nil,                                  // 55: tests.TestForMessage.Int64MapEntry
+	nil,                                  // 56: tests.TestForMessage.Uint32MapEntry
+	nil,                                  // 57: tests.TestForMessage.Uint64MapEntry
+	nil,                                  // 58: tests.TestForMessage.Sfixed32MapEntry
+	nil,                                  // 59: tests.TestForMessage.Sfixed64MapEntry
+	nil,                                  // 60: tests.TestForMessage.JsonMapEntry
+	nil,                                  // 61: tests.ComprehensiveMapTestMessage.JsonLabelsEntry
+	nil,                                  // 62: tests.ComprehensiveMapTestMessage.NativeLabelsEntry
+	nil,                                  // 63: tests.ComprehensiveMapTestMessage.DefaultCountersEntry
+	nil,                                  // 64: tests.ComprehensiveMapTestMessage.LegacyConfigEntry
+	nil,                                  // 65: tests.ComprehensiveMapTestMessage.SecretConfigEntry
+	nil,                                  // 66: tests.SensitiveTestMessage.HeadersEntry
+	(*Profile_Address)(nil),              // 67: tests.Profile.Address
+	(*wrapperspb.CustomWrapper)(nil),     // 68: tests.wrapperspb.CustomWrapper
+	(*utils.SimpleMessage)(nil),          // 69: tests.utils.SimpleMessage
+	(*wrapperspb1.BytesValue)(nil),       // 70: google.protobuf.BytesValue
+	(*durationpb.Duration)(nil),          // 71: google.protobuf.Duration
+	(*utils1.NestedMessage)(nil),         // 72: tests.utils.utils.NestedMessage
+	(*types.CustomType)(nil),             // 73: tests.types.CustomType
+	(*timestamppb.Timestamp)(nil),        // 74: google.protobuf.Timestamp
+	(*wrapperspb1.BoolValue)(nil),        // 75: google.protobuf.BoolValue
+	(*wrapperspb1.DoubleValue)(nil),      // 76: google.protobuf.DoubleValue
+	(*wrapperspb1.FloatValue)(nil),       // 77: google.protobuf.FloatValue
+	(*wrapperspb1.StringValue)(nil),      // 78: google.protobuf.StringValue
+	(*wrapperspb1.Int32Value)(nil),       // 79: google.protobuf.Int32Value
+	(*wrapperspb1.Int64Value)(nil),       // 80: google.protobuf.Int64Value
+	(*wrapperspb1.UInt32Value)(nil),      // 81: google.protobuf.UInt32Value
+	(*wrapperspb1.UInt64Value)(nil),      // 82: google.protobuf.UInt64Value
+	(*structpb.Struct)(nil),              // 83: google.protobuf.Struct
+	(*structpb.Value)(nil),               // 84: google.protobuf.Value
+	(*structpb.ListValue)(nil),           // 85: google.protobuf.ListValue
+	(*fieldmaskpb.FieldMask)(nil),        // 86: google.protobuf.FieldMask
+	(*anypb.Any)(nil),                    // 87: google.protobuf.Any
+	(*date.Date)(nil),                    // 88: google.type.Date
+	(*timeofday.TimeOfDay)(nil),          // 89: google.type.TimeOfDay
+	(*money.Money)(nil),                  // 90: google.type.Money
+	(*latlng.LatLng)(nil),                // 91: google.type.LatLng
+	(*decimal.Decimal)(nil),              // 92: google.type.Decimal
+	(*emptypb.Empty)(nil),                // 93: google.protobuf.Empty
 }
 var file_tests_test_proto_depIdxs = []int32{
-	39, // 0: tests.TestForMessage.custom_wrapper:type_name -> tests.wrapperspb.CustomWrapper
-	40, // 1: tests.TestForMessage.simple_message:type_name -> tests.utils.SimpleMessage
-	41, // 2: tests.TestForMessage.base64_defaults:type_name -> google.protobuf.BytesValue
-	0,  // 3: tests.TestForMessage.test_enum:type_name -> tests.TestEnum1
-	42, // 4: tests.TestForMessage.timeout_duration:type_name -> google.protobuf.Duration
-	2,  // 5: tests.TestForMessage.simple_field:type_name -> tests.SimpleMessage
-	24, // 6: tests.TestForMessage.labels:type_name -> tests.TestForMessage.LabelsEntry
-	25, // 7: tests.TestForMessage.counters:type_name -> tests.TestForMessage.CountersEntry
-	26, // 8: tests.TestForMessage.string_map:type_name -> tests.TestForMessage.StringMapEntry
-	27, // 9: tests.TestForMessage.int32_map:type_name -> tests.TestForMessage.Int32MapEntry
-	28, // 10: tests.TestForMessage.int64_map:type_name -> tests.TestForMessage.Int64MapEntry
-	29, // 11: tests.TestForMessage.uint32_map:type_name -> tests.TestForMessage.Uint32MapEntry
-	30, // 12: tests.TestForMessage.uint64_map:type_name -> tests.TestForMessage.Uint64MapEntry
-	31, // 13: tests.TestForMessage.sfixed32_map:type_name -> tests.TestForMessage.Sfixed32MapEntry
-	32, // 14: tests.TestForMessage.sfixed64_map:type_name -> tests.TestForMessage.Sfixed64MapEntry
-	33, // 15: tests.TestForMessage.json_map:type_name -> tests.TestForMessage.JsonMapEntry
-	42, // 16: tests.TestForMessage.delays:type_name -> google.protobuf.Duration
-	42, // 17: tests.TestForMessage.intervals:type_name -> google.protobuf.Duration
-	42, // 18: tests.TestForMessage.timeouts:type_name -> google.protobuf.Duration
-	43, // 19: tests.TestForMessage.nested_test:type_name -> tests.utils.utils.NestedMessage
-	44, // 20: tests.TestForMessage.custom_type:type_name -> tests.types.CustomType
-	45, // 21: tests.SimpleMessage.created_at:type_name -> google.protobuf.Timestamp
-	46, // 22: tests.WrapperValueMessage.name:type_name -> google.protobuf.BoolValue
-	47, // 23: tests.WrapperValueMessage.double_value:type_name -> google.protobuf.DoubleValue
-	47, // 24: tests.WrapperValueMessage.double_values:type_name -> google.protobuf.DoubleValue
-	41, // 25: tests.WrapperValueMessage.bytes_value:type_name -> google.protobuf.BytesValue
-	41, // 26: tests.WrapperValueMessage.bytes_values:type_name -> google.protobuf.BytesValue
-	41, // 27: tests.WrapperValueMessage.bytes_hex_values:type_name -> google.protobuf.BytesValue
-	41, // 28: tests.WrapperValueMessage.bytes_hex_valuesx:type_name -> google.protobuf.BytesValue
-	47, // 29: tests.DoubleSliceTestMessage.measurements:type_name -> google.protobuf.DoubleValue
-	47, // 30: tests.DoubleSliceTestMessage.scientific_values:type_name -> google.protobuf.DoubleValue
-	47, // 31: tests.DoubleSliceTestMessage.temperature_readings:type_name -> google.protobuf.DoubleValue
-	47, // 32: tests.DoubleSliceTestMessage.coordinates:type_name -> google.protobuf.DoubleValue
-	41, // 33: tests.BytesSliceTestMessage.data_chunks:type_name -> google.protobuf.BytesValue
-	41, // 34: tests.BytesSliceTestMessage.file_contents:type_name -> google.protobuf.BytesValue
-	41, // 35: tests.BytesSliceTestMessage.hex_data:type_name -> google.protobuf.BytesValue
-	41, // 36: tests.BytesSliceTestMessage.binary_payloads:type_name -> google.protobuf.BytesValue
-	48, // 37: tests.FloatValueTestMessage.single_value:type_name -> google.protobuf.FloatValue
-	48, // 38: tests.FloatValueTestMessage.float_values:type_name -> google.protobuf.FloatValue
-	48, // 39: tests.FloatValueTestMessage.temperature:type_name -> google.protobuf.FloatValue
-	48, // 40: tests.FloatValueTestMessage.sensor_readings:type_name -> google.protobuf.FloatValue
-	48, // 41: tests.FloatValueTestMessage.probability:type_name -> google.protobuf.FloatValue
-	48, // 42: tests.FloatValueTestMessage.scores:type_name -> google.protobuf.FloatValue
-	42, // 43: tests.DurationSliceTestMessage.delays:type_name -> google.protobuf.Duration
-	42, // 44: tests.DurationSliceTestMessage.intervals:type_name -> google.protobuf.Duration
-	42, // 45: tests.DurationSliceTestMessage.timeouts:type_name -> google.protobuf.Duration
-	42, // 46: tests.DurationSliceTestMessage.polling_intervals:type_name -> google.protobuf.Duration
-	45, // 47: tests.DurationSliceTestMessage.deadline:type_name -> google.protobuf.Timestamp
-	45, // 48: tests.DurationSliceTestMessage.optional_deadline:type_name -> google.protobuf.Timestamp
-	2,  // 49: tests.DisabledMessage.simple_message:type_name -> tests.SimpleMessage
-	45, // 50: tests.DisabledMessage.created_at:type_name -> google.protobuf.Timestamp
-	48, // 51: tests.WrapperMessage.value:type_name -> google.protobuf.FloatValue
-	0,  // 52: tests.DefaultValueTestMessage.default_mode:type_name -> tests.TestEnum1
-	0,  // 53: tests.DefaultValueTestMessage.default_mode2:type_name -> tests.TestEnum1
-	49, // 54: tests.StringValueTestMessage.single_value:type_name -> google.protobuf.StringValue
-	49, // 55: tests.StringValueTestMessage.string_values:type_name -> google.protobuf.StringValue
-	49, // 56: tests.StringValueTestMessage.config_path:type_name -> google.protobuf.StringValue
-	49, // 57: tests.StringValueTestMessage.include_paths:type_name -> google.protobuf.StringValue
-	49, // 58: tests.StringValueTestMessage.environment:type_name -> google.protobuf.StringValue
-	49, // 59: tests.StringValueTestMessage.tags:type_name -> google.protobuf.StringValue
-	50, // 60: tests.IntegerValueTestMessage.int32_value:type_name -> google.protobuf.Int32Value
-	51, // 61: tests.IntegerValueTestMessage.int64_value:type_name -> google.protobuf.Int64Value
-	52, // 62: tests.IntegerValueTestMessage.uint32_value:type_name -> google.protobuf.UInt32Value
-	53, // 63: tests.IntegerValueTestMessage.uint64_value:type_name -> google.protobuf.UInt64Value
-	50, // 64: tests.IntegerValueTestMessage.int32_values:type_name -> google.protobuf.Int32Value
-	51, // 65: tests.IntegerValueTestMessage.int64_values:type_name -> google.protobuf.Int64Value
-	48, // 66: tests.IntegerValueTestMessage.float64_values:type_name -> google.protobuf.FloatValue
-	46, // 67: tests.BoolValueTestMessage.single_value:type_name -> google.protobuf.BoolValue
-	46, // 68: tests.BoolValueTestMessage.bool_values:type_name -> google.protobuf.BoolValue
-	46, // 69: tests.BoolValueTestMessage.enable_feature:type_name -> google.protobuf.BoolValue
-	46, // 70: tests.BoolValueTestMessage.feature_flags:type_name -> google.protobuf.BoolValue
-	46, // 71: tests.BoolValueTestMessage.verbose_logging:type_name -> google.protobuf.BoolValue
-	46, // 72: tests.BoolValueTestMessage.debug_options:type_name -> google.protobuf.BoolValue
-	2,  // 73: tests.NestedMessageTestMessage.server_config:type_name -> tests.SimpleMessage
-	2,  // 74: tests.NestedMessageTestMessage.client_config:type_name -> tests.SimpleMessage
-	2,  // 75: tests.NestedMessageTestMessage.database_config:type_name -> tests.SimpleMessage
-	20, // 76: tests.NestedMessageTestMessage.deep_config:type_name -> tests.NestedLevel2Message
-	2,  // 77: tests.NestedLevel2Message.nested_simple:type_name -> tests.SimpleMessage
-	34, // 78: tests.ComprehensiveMapTestMessage.json_labels:type_name -> tests.ComprehensiveMapTestMessage.JsonLabelsEntry
-	35, // 79: tests.ComprehensiveMapTestMessage.native_labels:type_name -> tests.ComprehensiveMapTestMessage.NativeLabelsEntry
-	36, // 80: tests.ComprehensiveMapTestMessage.default_counters:type_name -> tests.ComprehensiveMapTestMessage.DefaultCountersEntry
-	37, // 81: tests.ComprehensiveMapTestMessage.legacy_config:type_name -> tests.ComprehensiveMapTestMessage.LegacyConfigEntry
-	38, // 82: tests.ComprehensiveMapTestMessage.secret_config:type_name -> tests.ComprehensiveMapTestMessage.SecretConfigEntry
-	45, // 83: tests.TimestampSliceTestMessage.event_times:type_name -> google.protobuf.Timestamp
-	45, // 84: tests.TimestampSliceTestMessage.log_timestamps:type_name -> google.protobuf.Timestamp
-	45, // 85: tests.TimestampSliceTestMessage.scheduled_tasks:type_name -> google.protobuf.Timestamp
-	45, // 86: tests.TimestampSliceTestMessage.backup_times:type_name -> google.protobuf.Timestamp
-	45, // 87: tests.TimestampSliceTestMessage.custom_format_times:type_name -> google.protobuf.Timestamp
-	41, // 88: tests.RepeatedBytesTestMessage.default_base64:type_name -> google.protobuf.BytesValue
-	41, // 89: tests.RepeatedBytesTestMessage.default_hex:type_name -> google.protobuf.BytesValue
-	90, // [90:90] is the sub-list for method output_type
-	90, // [90:90] is the sub-list for method input_type
-	90, // [90:90] is the sub-list for extension type_name
-	90, // [90:90] is the sub-list for extension extendee
-	0,  // [0:90] is the sub-list for field type_name
+	68,  // 0: tests.TestForMessage.custom_wrapper:type_name -> tests.wrapperspb.CustomWrapper
+	69,  // 1: tests.TestForMessage.simple_message:type_name -> tests.utils.SimpleMessage
+	70,  // 2: tests.TestForMessage.base64_defaults:type_name -> google.protobuf.BytesValue
+	0,   // 3: tests.TestForMessage.test_enum:type_name -> tests.TestEnum1
+	71,  // 4: tests.TestForMessage.timeout_duration:type_name -> google.protobuf.Duration
+	2,   // 5: tests.TestForMessage.simple_field:type_name -> tests.SimpleMessage
+	51,  // 6: tests.TestForMessage.labels:type_name -> tests.TestForMessage.LabelsEntry
+	52,  // 7: tests.TestForMessage.counters:type_name -> tests.TestForMessage.CountersEntry
+	53,  // 8: tests.TestForMessage.string_map:type_name -> tests.TestForMessage.StringMapEntry
+	54,  // 9: tests.TestForMessage.int32_map:type_name -> tests.TestForMessage.Int32MapEntry
+	55,  // 10: tests.TestForMessage.int64_map:type_name -> tests.TestForMessage.Int64MapEntry
+	56,  // 11: tests.TestForMessage.uint32_map:type_name -> tests.TestForMessage.Uint32MapEntry
+	57,  // 12: tests.TestForMessage.uint64_map:type_name -> tests.TestForMessage.Uint64MapEntry
+	58,  // 13: tests.TestForMessage.sfixed32_map:type_name -> tests.TestForMessage.Sfixed32MapEntry
+	59,  // 14: tests.TestForMessage.sfixed64_map:type_name -> tests.TestForMessage.Sfixed64MapEntry
+	60,  // 15: tests.TestForMessage.json_map:type_name -> tests.TestForMessage.JsonMapEntry
+	71,  // 16: tests.TestForMessage.delays:type_name -> google.protobuf.Duration
+	71,  // 17: tests.TestForMessage.intervals:type_name -> google.protobuf.Duration
+	71,  // 18: tests.TestForMessage.timeouts:type_name -> google.protobuf.Duration
+	72,  // 19: tests.TestForMessage.nested_test:type_name -> tests.utils.utils.NestedMessage
+	73,  // 20: tests.TestForMessage.custom_type:type_name -> tests.types.CustomType
+	74,  // 21: tests.SimpleMessage.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 22: tests.WrapperValueMessage.name:type_name -> google.protobuf.BoolValue
+	76,  // 23: tests.WrapperValueMessage.double_value:type_name -> google.protobuf.DoubleValue
+	76,  // 24: tests.WrapperValueMessage.double_values:type_name -> google.protobuf.DoubleValue
+	70,  // 25: tests.WrapperValueMessage.bytes_value:type_name -> google.protobuf.BytesValue
+	70,  // 26: tests.WrapperValueMessage.bytes_values:type_name -> google.protobuf.BytesValue
+	70,  // 27: tests.WrapperValueMessage.bytes_hex_values:type_name -> google.protobuf.BytesValue
+	70,  // 28: tests.WrapperValueMessage.bytes_hex_valuesx:type_name -> google.protobuf.BytesValue
+	76,  // 29: tests.DoubleSliceTestMessage.measurements:type_name -> google.protobuf.DoubleValue
+	76,  // 30: tests.DoubleSliceTestMessage.scientific_values:type_name -> google.protobuf.DoubleValue
+	76,  // 31: tests.DoubleSliceTestMessage.temperature_readings:type_name -> google.protobuf.DoubleValue
+	76,  // 32: tests.DoubleSliceTestMessage.coordinates:type_name -> google.protobuf.DoubleValue
+	70,  // 33: tests.BytesSliceTestMessage.data_chunks:type_name -> google.protobuf.BytesValue
+	70,  // 34: tests.BytesSliceTestMessage.file_contents:type_name -> google.protobuf.BytesValue
+	70,  // 35: tests.BytesSliceTestMessage.hex_data:type_name -> google.protobuf.BytesValue
+	70,  // 36: tests.BytesSliceTestMessage.binary_payloads:type_name -> google.protobuf.BytesValue
+	77,  // 37: tests.FloatValueTestMessage.single_value:type_name -> google.protobuf.FloatValue
+	77,  // 38: tests.FloatValueTestMessage.float_values:type_name -> google.protobuf.FloatValue
+	77,  // 39: tests.FloatValueTestMessage.temperature:type_name -> google.protobuf.FloatValue
+	77,  // 40: tests.FloatValueTestMessage.sensor_readings:type_name -> google.protobuf.FloatValue
+	77,  // 41: tests.FloatValueTestMessage.probability:type_name -> google.protobuf.FloatValue
+	77,  // 42: tests.FloatValueTestMessage.scores:type_name -> google.protobuf.FloatValue
+	71,  // 43: tests.DurationSliceTestMessage.delays:type_name -> google.protobuf.Duration
+	71,  // 44: tests.DurationSliceTestMessage.intervals:type_name -> google.protobuf.Duration
+	71,  // 45: tests.DurationSliceTestMessage.timeouts:type_name -> google.protobuf.Duration
+	71,  // 46: tests.DurationSliceTestMessage.polling_intervals:type_name -> google.protobuf.Duration
+	74,  // 47: tests.DurationSliceTestMessage.deadline:type_name -> google.protobuf.Timestamp
+	74,  // 48: tests.DurationSliceTestMessage.optional_deadline:type_name -> google.protobuf.Timestamp
+	2,   // 49: tests.DisabledMessage.simple_message:type_name -> tests.SimpleMessage
+	74,  // 50: tests.DisabledMessage.created_at:type_name -> google.protobuf.Timestamp
+	77,  // 51: tests.WrapperMessage.value:type_name -> google.protobuf.FloatValue
+	0,   // 52: tests.DefaultValueTestMessage.default_mode:type_name -> tests.TestEnum1
+	0,   // 53: tests.DefaultValueTestMessage.default_mode2:type_name -> tests.TestEnum1
+	78,  // 54: tests.StringValueTestMessage.single_value:type_name -> google.protobuf.StringValue
+	78,  // 55: tests.StringValueTestMessage.string_values:type_name -> google.protobuf.StringValue
+	78,  // 56: tests.StringValueTestMessage.config_path:type_name -> google.protobuf.StringValue
+	78,  // 57: tests.StringValueTestMessage.include_paths:type_name -> google.protobuf.StringValue
+	78,  // 58: tests.StringValueTestMessage.environment:type_name -> google.protobuf.StringValue
+	78,  // 59: tests.StringValueTestMessage.tags:type_name -> google.protobuf.StringValue
+	79,  // 60: tests.IntegerValueTestMessage.int32_value:type_name -> google.protobuf.Int32Value
+	80,  // 61: tests.IntegerValueTestMessage.int64_value:type_name -> google.protobuf.Int64Value
+	81,  // 62: tests.IntegerValueTestMessage.uint32_value:type_name -> google.protobuf.UInt32Value
+	82,  // 63: tests.IntegerValueTestMessage.uint64_value:type_name -> google.protobuf.UInt64Value
+	79,  // 64: tests.IntegerValueTestMessage.int32_values:type_name -> google.protobuf.Int32Value
+	80,  // 65: tests.IntegerValueTestMessage.int64_values:type_name -> google.protobuf.Int64Value
+	77,  // 66: tests.IntegerValueTestMessage.float64_values:type_name -> google.protobuf.FloatValue
+	75,  // 67: tests.BoolValueTestMessage.single_value:type_name -> google.protobuf.BoolValue
+	75,  // 68: tests.BoolValueTestMessage.bool_values:type_name -> google.protobuf.BoolValue
+	75,  // 69: tests.BoolValueTestMessage.enable_feature:type_name -> google.protobuf.BoolValue
+	75,  // 70: tests.BoolValueTestMessage.feature_flags:type_name -> google.protobuf.BoolValue
+	75,  // 71: tests.BoolValueTestMessage.verbose_logging:type_name -> google.protobuf.BoolValue
+	75,  // 72: tests.BoolValueTestMessage.debug_options:type_name -> google.protobuf.BoolValue
+	2,   // 73: tests.NestedMessageTestMessage.server_config:type_name -> tests.SimpleMessage
+	2,   // 74: tests.NestedMessageTestMessage.client_config:type_name -> tests.SimpleMessage
+	2,   // 75: tests.NestedMessageTestMessage.database_config:type_name -> tests.SimpleMessage
+	20,  // 76: tests.NestedMessageTestMessage.deep_config:type_name -> tests.NestedLevel2Message
+	2,   // 77: tests.NestedLevel2Message.nested_simple:type_name -> tests.SimpleMessage
+	61,  // 78: tests.ComprehensiveMapTestMessage.json_labels:type_name -> tests.ComprehensiveMapTestMessage.JsonLabelsEntry
+	62,  // 79: tests.ComprehensiveMapTestMessage.native_labels:type_name -> tests.ComprehensiveMapTestMessage.NativeLabelsEntry
+	63,  // 80: tests.ComprehensiveMapTestMessage.default_counters:type_name -> tests.ComprehensiveMapTestMessage.DefaultCountersEntry
+	64,  // 81: tests.ComprehensiveMapTestMessage.legacy_config:type_name -> tests.ComprehensiveMapTestMessage.LegacyConfigEntry
+	65,  // 82: tests.ComprehensiveMapTestMessage.secret_config:type_name -> tests.ComprehensiveMapTestMessage.SecretConfigEntry
+	74,  // 83: tests.TimestampSliceTestMessage.event_times:type_name -> google.protobuf.Timestamp
+	74,  // 84: tests.TimestampSliceTestMessage.log_timestamps:type_name -> google.protobuf.Timestamp
+	74,  // 85: tests.TimestampSliceTestMessage.scheduled_tasks:type_name -> google.protobuf.Timestamp
+	74,  // 86: tests.TimestampSliceTestMessage.backup_times:type_name -> google.protobuf.Timestamp
+	74,  // 87: tests.TimestampSliceTestMessage.custom_format_times:type_name -> google.protobuf.Timestamp
+	70,  // 88: tests.RepeatedBytesTestMessage.default_base64:type_name -> google.protobuf.BytesValue
+	70,  // 89: tests.RepeatedBytesTestMessage.default_hex:type_name -> google.protobuf.BytesValue
+	82,  // 90: tests.RepeatedSplitTestMessage.sizes:type_name -> google.protobuf.UInt64Value
+	71,  // 91: tests.RepeatedSplitTestMessage.backoffs:type_name -> google.protobuf.Duration
+	0,   // 92: tests.RepeatedSplitTestMessage.modes:type_name -> tests.TestEnum1
+	74,  // 93: tests.RepeatedSplitTestMessage.windows:type_name -> google.protobuf.Timestamp
+	80,  // 94: tests.RepeatedMergeTestMessage.priorities:type_name -> google.protobuf.Int64Value
+	71,  // 95: tests.RepeatedMergeTestMessage.retries:type_name -> google.protobuf.Duration
+	80,  // 96: tests.RepeatedRangesTestMessage.shards:type_name -> google.protobuf.Int64Value
+	80,  // 97: tests.UnitTestMessage.buffer_size:type_name -> google.protobuf.Int64Value
+	79,  // 98: tests.IntegerDurationTestMessage.interval_minutes:type_name -> google.protobuf.Int32Value
+	71,  // 99: tests.ExtendedDurationTestMessage.retention:type_name -> google.protobuf.Duration
+	71,  // 100: tests.ExtendedDurationTestMessage.schedule:type_name -> google.protobuf.Duration
+	74,  // 101: tests.RelativeTimestampTestMessage.since:type_name -> google.protobuf.Timestamp
+	74,  // 102: tests.RelativeTimestampTestMessage.maintenance:type_name -> google.protobuf.Timestamp
+	74,  // 103: tests.RelativeTimestampTestMessage.checkpoints:type_name -> google.protobuf.Timestamp
+	78,  // 104: tests.StringFormatTestMessage.upstream:type_name -> google.protobuf.StringValue
+	78,  // 105: tests.StringFormatTestMessage.admins:type_name -> google.protobuf.StringValue
+	78,  // 106: tests.PathTestMessage.data_dir:type_name -> google.protobuf.StringValue
+	78,  // 107: tests.StringChoicesTestMessage.level:type_name -> google.protobuf.StringValue
+	78,  // 108: tests.FileRefTestMessage.endpoint:type_name -> google.protobuf.StringValue
+	70,  // 109: tests.FileRefTestMessage.cert:type_name -> google.protobuf.BytesValue
+	70,  // 110: tests.SensitiveTestMessage.secret:type_name -> google.protobuf.BytesValue
+	66,  // 111: tests.SensitiveTestMessage.headers:type_name -> tests.SensitiveTestMessage.HeadersEntry
+	70,  // 112: tests.BytesEncodingTestMessage.nonce:type_name -> google.protobuf.BytesValue
+	70,  // 113: tests.BytesEncodingTestMessage.cert:type_name -> google.protobuf.BytesValue
+	70,  // 114: tests.BytesEncodingTestMessage.ca_certs:type_name -> google.protobuf.BytesValue
+	70,  // 115: tests.BytesEncodingTestMessage.keys:type_name -> google.protobuf.BytesValue
+	83,  // 116: tests.StructTestMessage.extra:type_name -> google.protobuf.Struct
+	84,  // 117: tests.StructTestMessage.metadata:type_name -> google.protobuf.Value
+	85,  // 118: tests.StructTestMessage.args:type_name -> google.protobuf.ListValue
+	83,  // 119: tests.StructTestMessage.credentials:type_name -> google.protobuf.Struct
+	67,  // 120: tests.Profile.address:type_name -> tests.Profile.Address
+	86,  // 121: tests.FieldMaskTestMessage.update_mask:type_name -> google.protobuf.FieldMask
+	86,  // 122: tests.FieldMaskTestMessage.address_mask:type_name -> google.protobuf.FieldMask
+	87,  // 123: tests.AnyTestMessage.provider:type_name -> google.protobuf.Any
+	87,  // 124: tests.AnyTestMessage.extension:type_name -> google.protobuf.Any
+	88,  // 125: tests.GoogleTypeTestMessage.start_date:type_name -> google.type.Date
+	89,  // 126: tests.GoogleTypeTestMessage.opening_time:type_name -> google.type.TimeOfDay
+	90,  // 127: tests.GoogleTypeTestMessage.price:type_name -> google.type.Money
+	91,  // 128: tests.GoogleTypeTestMessage.location:type_name -> google.type.LatLng
+	92,  // 129: tests.GoogleTypeTestMessage.tax_rate:type_name -> google.type.Decimal
+	44,  // 130: tests.LiteralTestMessage.tls:type_name -> tests.TLSConfig
+	38,  // 131: tests.LiteralTestMessage.owner:type_name -> tests.Profile
+	44,  // 132: tests.PresenceTestMessage.tls:type_name -> tests.TLSConfig
+	38,  // 133: tests.PresenceTestMessage.owner:type_name -> tests.Profile
+	93,  // 134: tests.PresenceTestMessage.dry_run:type_name -> google.protobuf.Empty
+	47,  // 135: tests.InlineTestMessage.common:type_name -> tests.CommonOptions
+	71,  // 136: tests.RetryPolicy.backoff:type_name -> google.protobuf.Duration
+	49,  // 137: tests.UnexportedNestedTestMessage.retry:type_name -> tests.RetryPolicy
+	49,  // 138: tests.UnexportedNestedTestMessage.fallback_retry:type_name -> tests.RetryPolicy
+	139, // [139:139] is the sub-list for method output_type
+	139, // [139:139] is the sub-list for method input_type
+	139, // [139:139] is the sub-list for extension type_name
+	139, // [139:139] is the sub-list for extension extendee
+	0,   // [0:139] is the sub-list for field type_name
 }
 
 func init() { file_tests_test_proto_init() }
@@ -3552,8 +6306,128 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimpleMessage); i {
+		file_tests_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimpleMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WrapperValueMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleSliceTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesSliceTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatSliceTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloatValueTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurationSliceTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisabledMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyMessage2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WrapperMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3564,8 +6438,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WrapperValueMessage); i {
+		file_tests_test_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnexportedMessageTest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3576,8 +6450,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DoubleSliceTestMessage); i {
+		file_tests_test_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultValueTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3588,8 +6462,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BytesSliceTestMessage); i {
+		file_tests_test_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringValueTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3600,8 +6474,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FloatSliceTestMessage); i {
+		file_tests_test_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntegerValueTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3612,8 +6486,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FloatValueTestMessage); i {
+		file_tests_test_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoolValueTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3624,8 +6498,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DurationSliceTestMessage); i {
+		file_tests_test_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComprehensiveFlagTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3636,8 +6510,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisabledMessage); i {
+		file_tests_test_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NestedMessageTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3648,8 +6522,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyMessage); i {
+		file_tests_test_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NestedLevel2Message); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3660,8 +6534,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyMessage2); i {
+		file_tests_test_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComprehensiveMapTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3672,8 +6546,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WrapperMessage); i {
+		file_tests_test_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimestampSliceTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3684,8 +6558,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnexportedMessageTest); i {
+		file_tests_test_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedBytesTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3696,8 +6570,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultValueTestMessage); i {
+		file_tests_test_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedSplitTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3708,8 +6582,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringValueTestMessage); i {
+		file_tests_test_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedMergeTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3720,8 +6594,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntegerValueTestMessage); i {
+		file_tests_test_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedRangesTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3732,8 +6606,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoolValueTestMessage); i {
+		file_tests_test_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnitTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3744,8 +6618,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComprehensiveFlagTestMessage); i {
+		file_tests_test_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntegerDurationTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3756,8 +6630,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NestedMessageTestMessage); i {
+		file_tests_test_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendedDurationTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3768,8 +6642,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NestedLevel2Message); i {
+		file_tests_test_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelativeTimestampTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3780,8 +6654,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComprehensiveMapTestMessage); i {
+		file_tests_test_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringFormatTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3792,8 +6666,8 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimestampSliceTestMessage); i {
+		file_tests_test_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathTestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3804,8 +6678,224 @@ func file_tests_test_proto_init() {
 				return nil
 			}
 		}
-		file_tests_test_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedBytesTestMessage); i {
+		file_tests_test_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringChoicesTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileRefTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SensitiveTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BytesEncodingTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldMaskTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S3Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCSConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnyTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoogleTypeTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLSConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiteralTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresenceTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommonOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InlineTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnexportedNestedTestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_test_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile_Address); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3827,13 +6917,18 @@ func file_tests_test_proto_init() {
 	file_tests_test_proto_msgTypes[14].OneofWrappers = []interface{}{}
 	file_tests_test_proto_msgTypes[15].OneofWrappers = []interface{}{}
 	file_tests_test_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_tests_test_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_tests_test_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_tests_test_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_tests_test_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_tests_test_proto_msgTypes[35].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tests_test_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   38,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
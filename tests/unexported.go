@@ -0,0 +1,24 @@
+package tests
+
+import (
+	"github.com/kunstack/protoc-gen-flags/flags"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+)
+
+// unexportedFlagger is implemented by the messages marked unexported, whose generated methods are
+// not exported.
+type unexportedFlagger interface {
+	_AddFlags(fs *pflag.FlagSet, opts ...flags.Option)
+	_SetDefaults()
+}
+
+// UnexportedMethods returns the generated _AddFlags and _SetDefaults methods of msg when it is
+// marked unexported, for the tests of other packages, which cannot call them directly.
+func UnexportedMethods(msg proto.Message) (addFlags flags.FlaggerFunc, setDefaults func(), ok bool) {
+	u, ok := msg.(unexportedFlagger)
+	if !ok {
+		return nil, nil, false
+	}
+	return u._AddFlags, u._SetDefaults, true
+}
@@ -9,7 +9,7 @@
 package utils
 
 import (
-	_ "github.com/kunstack/protoc-gen-flags/flags"
+	_ "github.com/kunstack/protoc-gen-flags/flags/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
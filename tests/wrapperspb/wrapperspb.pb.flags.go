@@ -7,7 +7,10 @@ import (
 	"github.com/kunstack/protoc-gen-flags/types"
 	"github.com/kunstack/protoc-gen-flags/utils"
 	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -20,6 +23,9 @@ var (
 	_ = wrapperspb.String
 	_ = (*durationpb.Duration)(nil)
 	_ = (*timestamppb.Timestamp)(nil)
+	_ = (*structpb.Struct)(nil)
+	_ = (*fieldmaskpb.FieldMask)(nil)
+	_ = (*anypb.Any)(nil)
 )
 
 func (x *CustomWrapper) AddFlags(fs *pflag.FlagSet, opts ...flags.Option) {
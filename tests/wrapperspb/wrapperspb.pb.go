@@ -7,7 +7,7 @@
 package wrapperspb
 
 import (
-	_ "github.com/kunstack/protoc-gen-flags/flags"
+	_ "github.com/kunstack/protoc-gen-flags/flags/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
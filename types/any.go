@@ -0,0 +1,133 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var _ pflag.Value = (*AnyValue)(nil)
+
+// AnyResolver resolves the message types of AnyValue. flags.TypeResolver and *protoregistry.Types
+// implement it.
+type AnyResolver interface {
+	protoregistry.MessageTypeResolver
+	protoregistry.ExtensionTypeResolver
+}
+
+// anyTypePrefix is the prefix of the type URLs of the messages packed by anypb.
+const anyTypePrefix = "type.googleapis.com/"
+
+// AnyValue is a pflag.Value implementation for google.protobuf.Any fields.
+// It accepts protojson with an "@type" URL, e.g. {"@type": "type.googleapis.com/pkg.Config", ...},
+// or the shorthand "type=pkg.Config;{...}", whose JSON may be omitted for an empty message.
+type AnyValue struct {
+	value    *anypb.Any
+	resolver AnyResolver
+	allowed  []string
+}
+
+// String returns the value as compact protojson with its "@type" URL.
+func (a *AnyValue) String() string {
+	if a == nil || a.value == nil || a.value.GetTypeUrl() == "" {
+		return ""
+	}
+	out, err := protojson.MarshalOptions{Resolver: a.resolver}.Marshal(a.value)
+	if err != nil {
+		return "type=" + a.value.GetTypeUrl()
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, out); err != nil {
+		return string(out)
+	}
+	return compact.String()
+}
+
+func (a *AnyValue) Set(val string) error {
+	val = strings.TrimSpace(val)
+	if rest := strings.TrimPrefix(val, "type="); rest != val {
+		name, data, _ := strings.Cut(rest, ";")
+		mt, err := a.findType(strings.TrimSpace(name))
+		if err != nil {
+			return err
+		}
+		msg := mt.New().Interface()
+		if data = strings.TrimSpace(data); data != "" {
+			if err := (protojson.UnmarshalOptions{Resolver: a.resolver}).Unmarshal([]byte(data), msg); err != nil {
+				return fmt.Errorf("invalid %s: %w", mt.Descriptor().FullName(), err)
+			}
+		}
+		return anypb.MarshalFrom(a.value, msg, proto.MarshalOptions{})
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(val), &fields); err != nil {
+		return fmt.Errorf("expected a JSON object with an @type or type=NAME;{json}: %w", err)
+	}
+	var url string
+	if err := json.Unmarshal(fields["@type"], &url); err != nil || url == "" {
+		return errors.New(`missing "@type" in JSON, or use type=NAME;{json}`)
+	}
+	if _, err := a.findType(url); err != nil {
+		return err
+	}
+	parsed := &anypb.Any{}
+	if err := (protojson.UnmarshalOptions{Resolver: a.resolver}).Unmarshal([]byte(val), parsed); err != nil {
+		return err
+	}
+	a.value.TypeUrl, a.value.Value = parsed.TypeUrl, parsed.Value
+	return nil
+}
+
+func (a *AnyValue) Type() string {
+	return "json"
+}
+
+// findType resolves a type URL or a full message name, checking it against the allowed types.
+func (a *AnyValue) findType(name string) (protoreflect.MessageType, error) {
+	fullName := name
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		fullName = name[i+1:]
+	}
+	if len(a.allowed) > 0 {
+		allowed := false
+		for _, t := range a.allowed {
+			if t == fullName {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, fmt.Errorf("message type %q is not allowed, must be one of: %s", fullName, strings.Join(a.allowed, ", "))
+		}
+	}
+	if !strings.Contains(name, "/") {
+		name = anyTypePrefix + name
+	}
+	mt, err := a.resolver.FindMessageByURL(name)
+	if errors.Is(err, protoregistry.NotFound) {
+		return nil, fmt.Errorf("unknown message type %q: its Go package is not linked into the program or it is missing from the type registry", fullName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve message type %q: %w", fullName, err)
+	}
+	return mt, nil
+}
+
+// Any binds v to a flag value resolving message types with resolver, protoregistry.GlobalTypes when
+// nil. When allowed is not empty, only the messages with these full names are accepted.
+func Any(v *anypb.Any, resolver AnyResolver, allowed ...string) *AnyValue {
+	if resolver == nil {
+		resolver = protoregistry.GlobalTypes
+	}
+	return &AnyValue{value: v, resolver: resolver, allowed: allowed}
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestAnyValue_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "json with type url",
+			input: `{"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "hi"}`,
+			want:  `{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"hi"}`,
+		},
+		{
+			name:  "shorthand",
+			input: `type=google.protobuf.Duration;"1.5s"`,
+			want:  `{"@type":"type.googleapis.com/google.protobuf.Duration","value":"1.500s"}`,
+		},
+		{
+			name:  "shorthand without json",
+			input: "type=google.protobuf.Int32Value",
+			want:  `{"@type":"type.googleapis.com/google.protobuf.Int32Value","value":0}`,
+		},
+		{
+			name:    "allowed type",
+			allowed: []string{"google.protobuf.StringValue"},
+			input:   `type=google.protobuf.StringValue;"hi"`,
+			want:    `{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"hi"}`,
+		},
+		{
+			name:    "type not allowed",
+			allowed: []string{"google.protobuf.StringValue"},
+			input:   `{"@type": "type.googleapis.com/google.protobuf.Duration", "value": "1s"}`,
+			wantErr: "is not allowed, must be one of: google.protobuf.StringValue",
+		},
+		{
+			name:    "unknown type",
+			input:   `type=example.Missing;{}`,
+			wantErr: `unknown message type "example.Missing"`,
+		},
+		{
+			name:    "missing type url",
+			input:   `{"value": "hi"}`,
+			wantErr: `missing "@type"`,
+		},
+		{
+			name:    "invalid message json",
+			input:   `type=google.protobuf.Duration;{"seconds": 1}`,
+			wantErr: "invalid google.protobuf.Duration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Any(&anypb.Any{}, nil, tt.allowed...)
+			err := v.Set(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("AnyValue.Set() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AnyValue.Set() error = %v", err)
+			}
+			if got := v.String(); got != tt.want {
+				t.Errorf("AnyValue.String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnyValue_Resolver(t *testing.T) {
+	registry := new(protoregistry.Types)
+	if err := registry.RegisterMessage((&wrapperspb.StringValue{}).ProtoReflect().Type()); err != nil {
+		t.Fatal(err)
+	}
+
+	a := &anypb.Any{}
+	v := Any(a, registry)
+	if err := v.Set(`type=google.protobuf.StringValue;"hi"`); err != nil {
+		t.Fatalf("AnyValue.Set() error = %v", err)
+	}
+	var s wrapperspb.StringValue
+	if err := a.UnmarshalTo(&s); err != nil || s.GetValue() != "hi" {
+		t.Errorf("AnyValue.Set() = %v, %v", s.GetValue(), err)
+	}
+	if err := v.Set(`type=google.protobuf.Duration;"1s"`); err == nil {
+		t.Error("AnyValue.Set() expected error for a type missing from the registry")
+	}
+
+	d, _ := anypb.New(durationpb.New(0))
+	if got := Any(d, registry).String(); got != "type=type.googleapis.com/google.protobuf.Duration" {
+		t.Errorf("AnyValue.String() = %s", got)
+	}
+	if got := Any(&anypb.Any{}, nil).String(); got != "" {
+		t.Errorf("AnyValue.String() = %q, want empty", got)
+	}
+}
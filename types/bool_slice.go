@@ -1,7 +1,6 @@
 package types
 
 import (
-	"io"
 	"strconv"
 	"strings"
 
@@ -16,16 +15,15 @@ var _ pflag.Value = (*BoolSliceValue)(nil)
 type BoolSliceValue struct {
 	value   *[]*wrapperspb.BoolValue
 	changed bool
+	sliceOptions
 }
 
 // Set converts, and assigns, the comma-separated boolean argument string representation as the []*wrapperspb.BoolValue value of this flag.
 // If Set is called on a flag that already has a []*wrapperspb.BoolValue assigned, the newly converted values will be appended.
+// A Splitter configured with WithSplitter replaces the CSV parsing.
 func (s *BoolSliceValue) Set(val string) error {
-	// remove all quote characters
-	rmQuote := strings.NewReplacer(`"`, "", `'`, "", "`", "")
-	// read flag arguments with CSV parser
-	boolStrSlice, err := utils.ReadAsCSV(rmQuote.Replace(val))
-	if err != nil && err != io.EOF {
+	boolStrSlice, err := s.split(val, splitQuotedCSV)
+	if err != nil {
 		return err
 	}
 
@@ -39,12 +37,7 @@ func (s *BoolSliceValue) Set(val string) error {
 		out = append(out, wrapperspb.Bool(b))
 	}
 
-	if !s.changed {
-		*s.value = out
-	} else {
-		*s.value = append(*s.value, out...)
-	}
-
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, wrapperKey[bool, *wrapperspb.BoolValue], boolValueLess)
 	s.changed = true
 	return nil
 }
@@ -65,7 +58,20 @@ func (s *BoolSliceValue) String() string {
 	return "[" + out + "]"
 }
 
-func BoolSlice(v *[]*wrapperspb.BoolValue) *BoolSliceValue {
-	result := BoolSliceValue{value: v}
+func BoolSlice(v *[]*wrapperspb.BoolValue, opts ...SliceOption) *BoolSliceValue {
+	result := BoolSliceValue{value: v, sliceOptions: newSliceOptions(opts)}
 	return &result
 }
+
+// splitQuotedCSV is the historical splitter of BoolSliceValue: quote characters
+// are dropped before the argument is read as a CSV record.
+func splitQuotedCSV(val string) ([]string, error) {
+	// remove all quote characters
+	rmQuote := strings.NewReplacer(`"`, "", `'`, "", "`", "")
+	// read flag arguments with CSV parser
+	return SplitCSV(rmQuote.Replace(val))
+}
+
+func boolValueLess(a, b *wrapperspb.BoolValue) bool {
+	return boolLess(a.GetValue(), b.GetValue())
+}
@@ -18,19 +18,25 @@ var (
 type BytesHexSliceValue struct {
 	value   *[]*wrapperspb.BytesValue
 	changed bool
+	sliceOptions
 }
 
+// A Splitter configured with WithSplitter may break an argument into several values.
 func (s *BytesHexSliceValue) Set(val string) error {
-	decodedBytes, err := hex.DecodeString(strings.TrimSpace(val))
+	ss, err := s.split(val, SplitNone)
 	if err != nil {
 		return err
 	}
-	if !s.changed {
-		*s.value = []*wrapperspb.BytesValue{{Value: decodedBytes}}
-		s.changed = true
-	} else {
-		*s.value = append(*s.value, wrapperspb.Bytes(decodedBytes))
+	out := make([]*wrapperspb.BytesValue, len(ss))
+	for i, d := range ss {
+		decodedBytes, err := hex.DecodeString(strings.TrimSpace(d))
+		if err != nil {
+			return err
+		}
+		out[i] = wrapperspb.Bytes(decodedBytes)
 	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, bytesValueKey, bytesValueLess)
+	s.changed = true
 	return nil
 }
 
@@ -83,19 +89,25 @@ func (s *BytesHexSliceValue) String() string {
 type BytesHexNativeSliceValue struct {
 	value   *[][]byte
 	changed bool
+	sliceOptions
 }
 
+// A Splitter configured with WithSplitter may break an argument into several values.
 func (s *BytesHexNativeSliceValue) Set(val string) error {
-	decodedBytes, err := hex.DecodeString(strings.TrimSpace(val))
+	ss, err := s.split(val, SplitNone)
 	if err != nil {
 		return err
 	}
-	if !s.changed {
-		*s.value = [][]byte{decodedBytes}
-		s.changed = true
-	} else {
-		*s.value = append(*s.value, decodedBytes)
+	out := make([][]byte, len(ss))
+	for i, d := range ss {
+		decodedBytes, err := hex.DecodeString(strings.TrimSpace(d))
+		if err != nil {
+			return err
+		}
+		out[i] = decodedBytes
 	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, bytesKey, bytesLess)
+	s.changed = true
 	return nil
 }
 
@@ -145,12 +157,12 @@ func (s *BytesHexNativeSliceValue) String() string {
 	return "[" + out + "]"
 }
 
-func BytesHexSlice[T []*wrapperspb.BytesValue | [][]byte](v *T) pflag.Value {
+func BytesHexSlice[T []*wrapperspb.BytesValue | [][]byte](v *T, opts ...SliceOption) pflag.Value {
 	switch wrap := any(v).(type) {
 	case *[]*wrapperspb.BytesValue:
-		return &BytesHexSliceValue{value: wrap}
+		return &BytesHexSliceValue{value: wrap, sliceOptions: newSliceOptions(opts)}
 	case *[][]byte:
-		return &BytesHexNativeSliceValue{value: wrap}
+		return &BytesHexNativeSliceValue{value: wrap, sliceOptions: newSliceOptions(opts)}
 	default:
 		// This should never happen due to type constraints
 		panic("BytesSlice: unsupported type")
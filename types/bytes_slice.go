@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/base64"
 	"strings"
 
@@ -15,19 +16,25 @@ var _ pflag.Value = (*BytesSliceValue)(nil)
 type BytesSliceValue struct {
 	value   *[]*wrapperspb.BytesValue
 	changed bool
+	sliceOptions
 }
 
+// A Splitter configured with WithSplitter may break an argument into several values.
 func (s *BytesSliceValue) Set(val string) error {
-	decodedBytes, err := base64.StdEncoding.DecodeString(strings.TrimSpace(val))
+	ss, err := s.split(val, SplitNone)
 	if err != nil {
 		return err
 	}
-	if !s.changed {
-		*s.value = []*wrapperspb.BytesValue{{Value: decodedBytes}}
-		s.changed = true
-	} else {
-		*s.value = append(*s.value, wrapperspb.Bytes(decodedBytes))
+	out := make([]*wrapperspb.BytesValue, len(ss))
+	for i, d := range ss {
+		decodedBytes, err := base64.StdEncoding.DecodeString(strings.TrimSpace(d))
+		if err != nil {
+			return err
+		}
+		out[i] = wrapperspb.Bytes(decodedBytes)
 	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, bytesValueKey, bytesValueLess)
+	s.changed = true
 	return nil
 }
 
@@ -81,19 +88,25 @@ func (s *BytesSliceValue) String() string {
 type NativeBytesSliceValue struct {
 	value   *[][]byte
 	changed bool
+	sliceOptions
 }
 
+// A Splitter configured with WithSplitter may break an argument into several values.
 func (s *NativeBytesSliceValue) Set(val string) error {
-	decodedBytes, err := base64.StdEncoding.DecodeString(strings.TrimSpace(val))
+	ss, err := s.split(val, SplitNone)
 	if err != nil {
 		return err
 	}
-	if !s.changed {
-		*s.value = [][]byte{decodedBytes}
-		s.changed = true
-	} else {
-		*s.value = append(*s.value, decodedBytes)
+	out := make([][]byte, len(ss))
+	for i, d := range ss {
+		decodedBytes, err := base64.StdEncoding.DecodeString(strings.TrimSpace(d))
+		if err != nil {
+			return err
+		}
+		out[i] = decodedBytes
 	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, bytesKey, bytesLess)
+	s.changed = true
 	return nil
 }
 
@@ -143,14 +156,30 @@ func (s *NativeBytesSliceValue) String() string {
 	return "[" + out + "]"
 }
 
-func BytesSlice[T []*wrapperspb.BytesValue | [][]byte](v *T) pflag.Value {
+func BytesSlice[T []*wrapperspb.BytesValue | [][]byte](v *T, opts ...SliceOption) pflag.Value {
 	switch wrap := any(v).(type) {
 	case *[]*wrapperspb.BytesValue:
-		return &BytesSliceValue{value: wrap}
+		return &BytesSliceValue{value: wrap, sliceOptions: newSliceOptions(opts)}
 	case *[][]byte:
-		return &NativeBytesSliceValue{value: wrap}
+		return &NativeBytesSliceValue{value: wrap, sliceOptions: newSliceOptions(opts)}
 	default:
 		// This should never happen due to type constraints
 		panic("BytesSlice: unsupported type")
 	}
 }
+
+func bytesKey(b []byte) string {
+	return string(b)
+}
+
+func bytesLess(a, b []byte) bool {
+	return bytes.Compare(a, b) < 0
+}
+
+func bytesValueKey(b *wrapperspb.BytesValue) string {
+	return bytesKey(b.GetValue())
+}
+
+func bytesValueLess(a, b *wrapperspb.BytesValue) bool {
+	return bytesLess(a.GetValue(), b.GetValue())
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// StringCheck validates a string argument and returns its canonical form, which is stored instead.
+type StringCheck func(string) (string, error)
+
+var _ pflag.Value = (*CheckedStringValue)(nil)
+
+// CheckedStringValue is a pflag.Value implementation for string fields whose arguments are
+// validated and canonicalized by a chain of StringCheck functions, applied in order.
+type CheckedStringValue struct {
+	value  *string
+	typ    string
+	checks []StringCheck
+}
+
+func (s *CheckedStringValue) String() string {
+	if s == nil || s.value == nil {
+		return ""
+	}
+	return *s.value
+}
+
+func (s *CheckedStringValue) Set(val string) error {
+	val, err := applyChecks(s.checks, val)
+	if err != nil {
+		return err
+	}
+	*s.value = val
+	return nil
+}
+
+// Type returns typ given to CheckedString, "string" when empty.
+func (s *CheckedStringValue) Type() string {
+	if s.typ == "" {
+		return "string"
+	}
+	return s.typ
+}
+
+// CheckedString binds v to a flag value applying checks to every argument.
+// typ is the value type shown in help output, e.g. "cidr".
+func CheckedString(v *string, typ string, checks ...StringCheck) *CheckedStringValue {
+	return &CheckedStringValue{value: v, typ: typ, checks: checks}
+}
+
+func applyChecks(checks []StringCheck, val string) (string, error) {
+	for _, check := range checks {
+		var err error
+		if val, err = check(val); err != nil {
+			return "", err
+		}
+	}
+	return val, nil
+}
@@ -0,0 +1,203 @@
+package types
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kunstack/protoc-gen-flags/utils"
+)
+
+func TestCheckedString_Formats(t *testing.T) {
+	tests := []struct {
+		name     string
+		check    StringCheck
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "ip v4", check: utils.NormalizeIP, input: "10.0.0.1", expected: "10.0.0.1"},
+		{name: "ip v6 canonical", check: utils.NormalizeIP, input: "2001:DB8:0:0:0:0:0:1", expected: "2001:db8::1"},
+		{name: "ip invalid", check: utils.NormalizeIP, input: "10.0.0.256", wantErr: true},
+		{name: "ipv4 rejects v6", check: utils.NormalizeIPv4, input: "::1", wantErr: true},
+		{name: "ipv6 rejects v4", check: utils.NormalizeIPv6, input: "127.0.0.1", wantErr: true},
+		{name: "ipv6", check: utils.NormalizeIPv6, input: "::1", expected: "::1"},
+		{name: "cidr masked", check: utils.NormalizeCIDR, input: "10.1.2.3/8", expected: "10.0.0.0/8"},
+		{name: "cidr v6", check: utils.NormalizeCIDR, input: "2001:db8::1/32", expected: "2001:db8::/32"},
+		{name: "cidr without prefix", check: utils.NormalizeCIDR, input: "10.0.0.1", wantErr: true},
+		{name: "host port", check: utils.NormalizeHostPort, input: "Example.COM:0080", expected: "example.com:80"},
+		{name: "host port ipv6", check: utils.NormalizeHostPort, input: "[::1]:443", expected: "[::1]:443"},
+		{name: "host port empty host", check: utils.NormalizeHostPort, input: ":8080", expected: ":8080"},
+		{name: "host port missing port", check: utils.NormalizeHostPort, input: "example.com", wantErr: true},
+		{name: "host port bad port", check: utils.NormalizeHostPort, input: "example.com:99999", wantErr: true},
+		{name: "url", check: utils.NormalizeURL, input: "HTTPS://Example.com/Path?q=1", expected: "https://example.com/Path?q=1"},
+		{name: "url without scheme", check: utils.NormalizeURL, input: "example.com/path", wantErr: true},
+		{name: "hostname", check: utils.NormalizeHostname, input: "API.Example.com.", expected: "api.example.com"},
+		{name: "hostname invalid label", check: utils.NormalizeHostname, input: "-bad.example.com", wantErr: true},
+		{name: "hostname underscore", check: utils.NormalizeHostname, input: "bad_host", wantErr: true},
+		{name: "email", check: utils.NormalizeEmail, input: "Ops@Example.COM", expected: "Ops@example.com"},
+		{name: "email display name", check: utils.NormalizeEmail, input: "Ops <ops@example.com>", wantErr: true},
+		{name: "email invalid", check: utils.NormalizeEmail, input: "ops.example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v string
+			s := CheckedString(&v, "test", tt.check)
+			err := s.Set(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && v != tt.expected {
+				t.Errorf("Set(%q) = %q, want %q", tt.input, v, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckedString_Type(t *testing.T) {
+	var v string
+	if got := CheckedString(&v, "cidr").Type(); got != "cidr" {
+		t.Errorf("Type() = %q, want %q", got, "cidr")
+	}
+	if got := CheckedString(&v, "").Type(); got != "string" {
+		t.Errorf("Type() = %q, want %q", got, "string")
+	}
+}
+
+func TestWithCheck(t *testing.T) {
+	var native []string
+	nsv := NativeStringSlice(&native, WithCheck(utils.NormalizeCIDR))
+	if err := nsv.Set("10.1.0.0/8,192.168.1.7/24"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(native, []string{"10.0.0.0/8", "192.168.1.0/24"}) {
+		t.Errorf("NativeStringSlice.Set() = %v", native)
+	}
+	if err := nsv.Set("bad"); err == nil {
+		t.Error("expected error for an invalid item")
+	}
+	if err := nsv.Append("bad"); err == nil {
+		t.Error("expected error from Append for an invalid item")
+	}
+
+	var wrapped []*wrapperspb.StringValue
+	ssv := StringSlice(&wrapped, WithCheck(utils.NormalizeIP))
+	if err := ssv.Set("2001:DB8::1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(ssv.GetSlice(), []string{"2001:db8::1"}) {
+		t.Errorf("StringSlice.Set() = %v", ssv.GetSlice())
+	}
+	if err := ssv.Replace([]string{"::1", "bad"}); err == nil {
+		t.Error("expected error from Replace for an invalid item")
+	}
+}
+
+func TestCheckedString_Path(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", dir)
+	t.Setenv("APP_DIR", dir)
+
+	tests := []struct {
+		name     string
+		path     utils.Path
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "home", path: utils.Path{}, input: "~/config.yaml", expected: file},
+		{name: "env", path: utils.Path{}, input: "${APP_DIR}/config.yaml", expected: file},
+		{name: "relative kept", path: utils.Path{}, input: "a/../b", expected: "a/../b"},
+		{name: "base", path: utils.Path{Base: "$APP_DIR"}, input: "config.yaml", expected: file},
+		{name: "base ignores absolute", path: utils.Path{Base: "/srv"}, input: file, expected: file},
+		{name: "must exist", path: utils.Path{Check: utils.PathMustExist}, input: "~/missing", wantErr: true},
+		{name: "must be file", path: utils.Path{Check: utils.PathMustBeFile}, input: file, expected: file},
+		{name: "must be file given dir", path: utils.Path{Check: utils.PathMustBeFile}, input: dir, wantErr: true},
+		{name: "must be dir", path: utils.Path{Check: utils.PathMustBeDir}, input: "~", expected: dir},
+		{name: "must be dir given file", path: utils.Path{Check: utils.PathMustBeDir}, input: file, wantErr: true},
+		{name: "must not exist", path: utils.Path{Check: utils.PathMustNotExist}, input: "~/out.txt", expected: filepath.Join(dir, "out.txt")},
+		{name: "must not exist given file", path: utils.Path{Check: utils.PathMustNotExist}, input: file, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v string
+			err := CheckedString(&v, "path", tt.path.Normalize).Set(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && v != tt.expected {
+				t.Errorf("Set(%q) = %q, want %q", tt.input, v, tt.expected)
+			}
+		})
+	}
+
+	t.Run("absolute", func(t *testing.T) {
+		wd, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v string
+		if err := CheckedString(&v, "path", utils.Path{Absolute: true}.Normalize).Set("a/../b"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := filepath.Join(wd, "b"); v != want {
+			t.Errorf("Set() = %q, want %q", v, want)
+		}
+	})
+
+	t.Run("default is not checked", func(t *testing.T) {
+		p := utils.Path{Check: utils.PathMustExist}
+		if got, want := p.Default("~/missing"), filepath.Join(dir, "missing"); got != want {
+			t.Errorf("Default() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestCheckedString_Choices(t *testing.T) {
+	tests := []struct {
+		name     string
+		choices  utils.Choices
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "match", choices: utils.Choices{Values: []string{"gzip", "zstd", "none"}}, input: "zstd", expected: "zstd"},
+		{name: "case sensitive", choices: utils.Choices{Values: []string{"gzip", "zstd", "none"}}, input: "ZSTD", wantErr: true},
+		{name: "case insensitive", choices: utils.Choices{Values: []string{"DEBUG", "INFO"}, CaseInsensitive: true}, input: "info", expected: "INFO"},
+		{name: "unknown", choices: utils.Choices{Values: []string{"DEBUG", "INFO"}, CaseInsensitive: true}, input: "trace", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v string
+			err := CheckedString(&v, "", tt.choices.Normalize).Set(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && v != tt.expected {
+				t.Errorf("Set(%q) = %q, want %q", tt.input, v, tt.expected)
+			}
+		})
+	}
+
+	var features []string
+	sv := NativeStringSlice(&features, WithCheck(utils.Choices{Values: []string{"metrics", "tracing"}, CaseInsensitive: true}.Normalize))
+	if err := sv.Set("Metrics,TRACING"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(features, []string{"metrics", "tracing"}) {
+		t.Errorf("NativeStringSlice.Set() = %v", features)
+	}
+	if err := sv.Set("metrics,profiling"); err == nil {
+		t.Error("expected error for an unknown choice")
+	}
+}
@@ -2,7 +2,6 @@ package types
 
 import (
 	"strconv"
-	"strings"
 
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -15,15 +14,19 @@ var _ pflag.Value = (*DoubleSliceValue)(nil)
 type DoubleSliceValue struct {
 	value   *[]*wrapperspb.DoubleValue
 	changed bool
+	sliceOptions
 }
 
 // Set converts, and assigns, the comma-separated double argument string representation as the []*wrapperspb.DoubleValue value of this flag.
 // If Set is called on a flag that already has a []*wrapperspb.DoubleValue assigned, the newly converted values will be appended.
+// A Splitter configured with WithSplitter replaces the comma separation.
 func (s *DoubleSliceValue) Set(val string) error {
-	ss := strings.Split(val, ",")
+	ss, err := s.split(val, splitComma)
+	if err != nil {
+		return err
+	}
 	out := make([]*wrapperspb.DoubleValue, len(ss))
 	for i, d := range ss {
-		var err error
 		var temp64 float64
 		temp64, err = strconv.ParseFloat(d, 64)
 		if err != nil {
@@ -31,11 +34,7 @@ func (s *DoubleSliceValue) Set(val string) error {
 		}
 		out[i] = wrapperspb.Double(temp64)
 	}
-	if !s.changed {
-		*s.value = out
-	} else {
-		*s.value = append(*s.value, out...)
-	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, wrapperKey[float64, *wrapperspb.DoubleValue], wrapperLess[float64, *wrapperspb.DoubleValue])
 	s.changed = true
 	return nil
 }
@@ -56,6 +55,6 @@ func (s *DoubleSliceValue) String() string {
 	return "[" + out + "]"
 }
 
-func DoubleSlice(v *[]*wrapperspb.DoubleValue) *DoubleSliceValue {
-	return &DoubleSliceValue{value: v}
+func DoubleSlice(v *[]*wrapperspb.DoubleValue, opts ...SliceOption) *DoubleSliceValue {
+	return &DoubleSliceValue{value: v, sliceOptions: newSliceOptions(opts)}
 }
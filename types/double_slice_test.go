@@ -37,9 +37,9 @@ func TestDoubleSliceValue_Set(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name:    "empty string",
-			input:   "",
-			wantErr: true, // strconv.ParseFloat doesn't handle empty string
+			name:  "empty string clears the list",
+			input: "",
+			want:  []*wrapperspb.DoubleValue{},
 		},
 		{
 			name:  "zero value",
@@ -207,17 +207,19 @@ func TestDoubleSliceValue_MultipleSets(t *testing.T) {
 }
 
 func TestDoubleSliceValue_EmptyString(t *testing.T) {
-	doubleSlice := []*wrapperspb.DoubleValue{}
+	doubleSlice := []*wrapperspb.DoubleValue{wrapperspb.Double(1.5)}
 	ds := DoubleSliceValue{value: &doubleSlice}
 
-	err := ds.Set("")
-	if err == nil {
-		t.Fatalf("Expected error for empty string, but got none")
+	if err := ds.Set("2.5"); err != nil {
+		t.Fatalf("DoubleSliceValue.Set() error = %v", err)
 	}
 
-	// Verify slice remains empty after failed Set
+	// An empty argument explicitly clears the list
+	if err := ds.Set(""); err != nil {
+		t.Fatalf("Expected no error for empty string, got %v", err)
+	}
 	if len(*ds.value) != 0 {
-		t.Errorf("Expected empty slice after failed Set, got %d elements", len(*ds.value))
+		t.Errorf("Expected empty slice after Set(\"\"), got %d elements", len(*ds.value))
 	}
 }
 
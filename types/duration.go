@@ -2,8 +2,8 @@ package types
 
 import (
 	"fmt"
-	"time"
 
+	"github.com/kunstack/protoc-gen-flags/utils"
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -16,14 +16,14 @@ func (d *DurationValue) String() string {
 	if d == nil {
 		return "0s"
 	}
-	return fmt.Sprintf("%s", (*durationpb.Duration)(d).AsDuration())
+	return utils.FormatDuration((*durationpb.Duration)(d).AsDuration())
 }
 
 func (d *DurationValue) Set(s string) error {
 	if d == nil {
 		return fmt.Errorf("cannot set nil Duration")
 	}
-	duration, err := time.ParseDuration(s)
+	duration, err := utils.ParseDuration(s)
 	if err != nil {
 		return err
 	}
@@ -1,10 +1,9 @@
 package types
 
 import (
-	"fmt"
 	"strings"
-	"time"
 
+	"github.com/kunstack/protoc-gen-flags/utils"
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -17,29 +16,32 @@ var (
 type DurationSliceValue struct {
 	value   *[]*durationpb.Duration
 	changed bool
+	sliceOptions
 }
 
+// Set parses the comma-separated durations of val, replacing the default value on the
+// first call and appending afterwards. A Splitter configured with WithSplitter replaces
+// the comma separation.
 func (s *DurationSliceValue) Set(val string) error {
-	ss := strings.Split(val, ",")
+	ss, err := s.split(val, splitComma)
+	if err != nil {
+		return err
+	}
 	out := make([]*durationpb.Duration, len(ss))
 	for i, d := range ss {
-		dur, err := time.ParseDuration(strings.TrimSpace(d))
+		dur, err := utils.ParseDuration(d)
 		if err != nil {
 			return err
 		}
 		out[i] = durationpb.New(dur)
 	}
-	if !s.changed {
-		*s.value = out
-	} else {
-		*s.value = append(*s.value, out...)
-	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, durationKey, durationLess)
 	s.changed = true
 	return nil
 }
 
 func (s *DurationSliceValue) Append(val string) error {
-	i, err := time.ParseDuration(strings.TrimSpace(val))
+	i, err := utils.ParseDuration(val)
 	if err != nil {
 		return err
 	}
@@ -50,7 +52,7 @@ func (s *DurationSliceValue) Append(val string) error {
 func (s *DurationSliceValue) Replace(val []string) error {
 	out := make([]*durationpb.Duration, len(val))
 	for i, d := range val {
-		dur, err := time.ParseDuration(strings.TrimSpace(d))
+		dur, err := utils.ParseDuration(d)
 		if err != nil {
 			return err
 		}
@@ -63,7 +65,7 @@ func (s *DurationSliceValue) Replace(val []string) error {
 func (s *DurationSliceValue) GetSlice() []string {
 	out := make([]string, len(*s.value))
 	for i, d := range *s.value {
-		out[i] = d.AsDuration().String()
+		out[i] = utils.FormatDuration(d.AsDuration())
 	}
 	return out
 }
@@ -75,13 +77,17 @@ func (s *DurationSliceValue) Type() string {
 
 // String defines a "native" format for this duration slice flag value.
 func (s *DurationSliceValue) String() string {
-	durationStrSlice := make([]string, len(*s.value))
-	for i, d := range *s.value {
-		durationStrSlice[i] = fmt.Sprintf("%s", d.AsDuration())
-	}
-	return "[" + strings.Join(durationStrSlice, ",") + "]"
+	return "[" + strings.Join(s.GetSlice(), ",") + "]"
+}
+
+func DurationSlice(v *[]*durationpb.Duration, opts ...SliceOption) *DurationSliceValue {
+	return &DurationSliceValue{value: v, sliceOptions: newSliceOptions(opts)}
+}
+
+func durationKey(d *durationpb.Duration) string {
+	return d.AsDuration().String()
 }
 
-func DurationSlice(v *[]*durationpb.Duration) *DurationSliceValue {
-	return &DurationSliceValue{value: v}
+func durationLess(a, b *durationpb.Duration) bool {
+	return a.AsDuration() < b.AsDuration()
 }
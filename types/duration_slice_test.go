@@ -82,9 +82,9 @@ func TestDurationSliceValue_Set(t *testing.T) {
 			expectError: true,
 		},
 		{
-			name:        "empty string",
-			input:       "",
-			expectError: true,
+			name:     "empty string clears the list",
+			input:    "",
+			expected: []*durationpb.Duration{},
 		},
 	}
 
@@ -279,7 +279,7 @@ func TestDurationSliceValue_GetSlice(t *testing.T) {
 				durationpb.New(120 * 1000000000),
 				durationpb.New(10800 * 1000000000),
 			},
-			expected: []string{"1s", "2m0s", "3h0m0s"},
+			expected: []string{"1s", "2m", "3h"},
 		},
 		{
 			name: "fractional durations",
@@ -287,7 +287,7 @@ func TestDurationSliceValue_GetSlice(t *testing.T) {
 				durationpb.New(2500000000),
 				durationpb.New(500000000),
 			},
-			expected: []string{"2.5s", "500ms"},
+			expected: []string{"2500ms", "500ms"},
 		},
 		{
 			name: "negative durations",
@@ -295,7 +295,7 @@ func TestDurationSliceValue_GetSlice(t *testing.T) {
 				durationpb.New(-30000000000),
 				durationpb.New(-60000000000),
 			},
-			expected: []string{"-30s", "-1m0s"},
+			expected: []string{"-30s", "-1m"},
 		},
 	}
 
@@ -337,7 +337,7 @@ func TestDurationSliceValue_String(t *testing.T) {
 				durationpb.New(120 * 1000000000),
 				durationpb.New(10800 * 1000000000),
 			},
-			expected: "[1s,2m0s,3h0m0s]",
+			expected: "[1s,2m,3h]",
 		},
 		{
 			name: "fractional durations",
@@ -345,7 +345,7 @@ func TestDurationSliceValue_String(t *testing.T) {
 				durationpb.New(2500000000),
 				durationpb.New(500000000),
 			},
-			expected: "[2.5s,500ms]",
+			expected: "[2500ms,500ms]",
 		},
 		{
 			name: "negative durations",
@@ -353,7 +353,7 @@ func TestDurationSliceValue_String(t *testing.T) {
 				durationpb.New(-30000000000),
 				durationpb.New(-60000000000),
 			},
-			expected: "[-30s,-1m0s]",
+			expected: "[-30s,-1m]",
 		},
 		{
 			name: "zero duration",
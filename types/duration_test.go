@@ -40,7 +40,7 @@ func TestDurationValue_String(t *testing.T) {
 		{
 			name:     "seconds and nanoseconds",
 			duration: &durationpb.Duration{Seconds: 2, Nanos: 500000000},
-			expected: "2.5s",
+			expected: "2500ms",
 		},
 		{
 			name:     "negative duration",
@@ -50,7 +50,22 @@ func TestDurationValue_String(t *testing.T) {
 		{
 			name:     "large duration",
 			duration: &durationpb.Duration{Seconds: 3600, Nanos: 0},
-			expected: "1h0m0s",
+			expected: "1h",
+		},
+		{
+			name:     "largest exact unit",
+			duration: &durationpb.Duration{Seconds: 5400},
+			expected: "90m",
+		},
+		{
+			name:     "days",
+			duration: &durationpb.Duration{Seconds: 30 * 86400},
+			expected: "30d",
+		},
+		{
+			name:     "weeks",
+			duration: &durationpb.Duration{Seconds: 14 * 86400},
+			expected: "2w",
 		},
 	}
 
@@ -132,6 +147,51 @@ func TestDurationValue_Set(t *testing.T) {
 			input:    "-30s",
 			expected: &durationpb.Duration{Seconds: -30, Nanos: 0},
 		},
+		{
+			name:     "days",
+			input:    "30d",
+			expected: &durationpb.Duration{Seconds: 30 * 86400},
+		},
+		{
+			name:     "weeks days and hours",
+			input:    "1w2d12h",
+			expected: &durationpb.Duration{Seconds: 9*86400 + 12*3600},
+		},
+		{
+			name:     "iso 8601",
+			input:    "P1DT2H",
+			expected: &durationpb.Duration{Seconds: 86400 + 7200},
+		},
+		{
+			name:     "iso 8601 fractional seconds",
+			input:    "PT0.5S",
+			expected: &durationpb.Duration{Nanos: 500000000},
+		},
+		{
+			name:     "iso 8601 weeks",
+			input:    "P2W",
+			expected: &durationpb.Duration{Seconds: 14 * 86400},
+		},
+		{
+			name:        "iso 8601 months",
+			input:       "P1M",
+			expectError: true,
+		},
+		{
+			name:        "iso 8601 designators out of order",
+			input:       "PT1S2M",
+			expectError: true,
+		},
+		{
+			name:        "beyond time.Duration range",
+			input:       "106752d",
+			expectError: true,
+		},
+		{
+			name:        "missing unit",
+			input:       "10",
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
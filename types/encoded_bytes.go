@@ -0,0 +1,84 @@
+package types
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/kunstack/protoc-gen-flags/utils"
+)
+
+// BytesEncoding is the text encoding of the command-line values of a bytes flag.
+type BytesEncoding = utils.BytesEncoding
+
+const (
+	EncodingBase64       = utils.EncodingBase64
+	EncodingHex          = utils.EncodingHex
+	EncodingBase64URL    = utils.EncodingBase64URL
+	EncodingBase64Raw    = utils.EncodingBase64Raw
+	EncodingBase64RawURL = utils.EncodingBase64RawURL
+	EncodingUTF8         = utils.EncodingUTF8
+	EncodingFile         = utils.EncodingFile
+)
+
+var _ pflag.Value = (*EncodedBytesValue)(nil)
+
+// EncodedBytesValue is a pflag.Value implementation for bytes fields, native or the Value of a
+// google.protobuf.BytesValue, whose arguments are decoded with a BytesEncoding.
+type EncodedBytesValue struct {
+	value    *[]byte
+	encoding BytesEncoding
+	// path is the file last read with EncodingFile.
+	path string
+}
+
+// String encodes the value with the encoding. EncodingFile values print the path of the file
+// they were read from.
+func (b *EncodedBytesValue) String() string {
+	if b == nil || b.value == nil {
+		return ""
+	}
+	if b.encoding == EncodingFile && b.path != "" {
+		return b.path
+	}
+	return utils.EncodeBytes(*b.value, b.encoding)
+}
+
+func (b *EncodedBytesValue) Set(val string) error {
+	bin, err := utils.DecodeBytes(val, b.encoding)
+	if err != nil {
+		return err
+	}
+	*b.value = bin
+	if b.encoding == EncodingFile {
+		b.path = val
+	}
+	return nil
+}
+
+func (b *EncodedBytesValue) Type() string {
+	return bytesEncodingType(b.encoding)
+}
+
+// EncodedBytes binds v to a flag value decoding its arguments with encoding.
+// Use &x.Field.Value for google.protobuf.BytesValue fields.
+func EncodedBytes(v *[]byte, encoding BytesEncoding) *EncodedBytesValue {
+	return &EncodedBytesValue{value: v, encoding: encoding}
+}
+
+// bytesEncodingType returns the value type of a bytes flag shown in help output.
+func bytesEncodingType(e BytesEncoding) string {
+	switch e {
+	case EncodingHex:
+		return "bytesHex"
+	case EncodingBase64URL:
+		return "bytesBase64URL"
+	case EncodingBase64Raw:
+		return "bytesBase64Raw"
+	case EncodingBase64RawURL:
+		return "bytesBase64RawURL"
+	case EncodingUTF8:
+		return "string"
+	case EncodingFile:
+		return "file"
+	}
+	return "bytesBase64"
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kunstack/protoc-gen-flags/utils"
+)
+
+var _ pflag.Value = (*EncodedBytesSliceValue[[]byte])(nil)
+
+// EncodedBytesSliceValue is a pflag.Value implementation for repeated bytes fields, native or of
+// google.protobuf.BytesValue, whose items are decoded with a BytesEncoding.
+type EncodedBytesSliceValue[T []byte | *wrapperspb.BytesValue] struct {
+	value    *[]T
+	encoding BytesEncoding
+	changed  bool
+	// paths maps the contents read with EncodingFile to the path they were read from.
+	paths map[string]string
+	sliceOptions
+}
+
+// Set assigns val as a single value of this flag, replacing the default value on the first call
+// and appending afterwards. A Splitter configured with WithSplitter may break val into several values.
+func (s *EncodedBytesSliceValue[T]) Set(val string) error {
+	ss, err := s.split(val, SplitNone)
+	if err != nil {
+		return err
+	}
+	out, err := s.decode(ss)
+	if err != nil {
+		return err
+	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, encodedBytesKey[T], encodedBytesLess[T])
+	s.changed = true
+	return nil
+}
+
+func (s *EncodedBytesSliceValue[T]) Append(val string) error {
+	out, err := s.decode([]string{val})
+	if err != nil {
+		return err
+	}
+	*s.value = append(*s.value, out...)
+	return nil
+}
+
+func (s *EncodedBytesSliceValue[T]) Replace(val []string) error {
+	out, err := s.decode(val)
+	if err != nil {
+		return err
+	}
+	*s.value = out
+	return nil
+}
+
+func (s *EncodedBytesSliceValue[T]) GetSlice() []string {
+	out := make([]string, len(*s.value))
+	for i, d := range *s.value {
+		out[i] = s.encode(d)
+	}
+	return out
+}
+
+// Type returns a string that uniquely represents this flag's type.
+func (s *EncodedBytesSliceValue[T]) Type() string {
+	return bytesEncodingType(s.encoding) + "Slice"
+}
+
+// String encodes the items with the encoding. EncodingFile items print the path of the file
+// they were read from.
+func (s *EncodedBytesSliceValue[T]) String() string {
+	out, _ := utils.WriteAsCSV(s.GetSlice())
+	return "[" + out + "]"
+}
+
+func (s *EncodedBytesSliceValue[T]) decode(items []string) ([]T, error) {
+	out := make([]T, len(items))
+	for i, item := range items {
+		bin, err := utils.DecodeBytes(item, s.encoding)
+		if err != nil {
+			return nil, err
+		}
+		if s.encoding == EncodingFile {
+			if s.paths == nil {
+				s.paths = make(map[string]string)
+			}
+			s.paths[string(bin)] = item
+		}
+		out[i] = bytesElem[T](bin)
+	}
+	return out, nil
+}
+
+func (s *EncodedBytesSliceValue[T]) encode(v T) string {
+	b := bytesOf(v)
+	if path, ok := s.paths[string(b)]; ok {
+		return path
+	}
+	return utils.EncodeBytes(b, s.encoding)
+}
+
+// EncodedBytesSlice binds v to a repeated flag value decoding its items with encoding.
+func EncodedBytesSlice[T []byte | *wrapperspb.BytesValue](v *[]T, encoding BytesEncoding, opts ...SliceOption) *EncodedBytesSliceValue[T] {
+	return &EncodedBytesSliceValue[T]{value: v, encoding: encoding, sliceOptions: newSliceOptions(opts)}
+}
+
+func bytesElem[T []byte | *wrapperspb.BytesValue](b []byte) T {
+	var v T
+	switch p := any(&v).(type) {
+	case *[]byte:
+		*p = b
+	case **wrapperspb.BytesValue:
+		*p = wrapperspb.Bytes(b)
+	}
+	return v
+}
+
+func bytesOf[T []byte | *wrapperspb.BytesValue](v T) []byte {
+	switch v := any(v).(type) {
+	case []byte:
+		return v
+	case *wrapperspb.BytesValue:
+		return v.GetValue()
+	}
+	return nil
+}
+
+func encodedBytesKey[T []byte | *wrapperspb.BytesValue](v T) string {
+	return bytesKey(bytesOf(v))
+}
+
+func encodedBytesLess[T []byte | *wrapperspb.BytesValue](a, b T) bool {
+	return bytesLess(bytesOf(a), bytesOf(b))
+}
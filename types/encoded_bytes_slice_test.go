@@ -0,0 +1,86 @@
+package types
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestEncodedBytesSliceValue_Set(t *testing.T) {
+	current := [][]byte{[]byte("default")}
+	v := EncodedBytesSlice(&current, EncodingBase64RawURL)
+	for _, arg := range []string{"-_8", "aGk"} {
+		if err := v.Set(arg); err != nil {
+			t.Fatalf("EncodedBytesSliceValue.Set(%q) error = %v", arg, err)
+		}
+	}
+	want := [][]byte{{0xfb, 0xff}, []byte("hi")}
+	if !reflect.DeepEqual(current, want) {
+		t.Errorf("EncodedBytesSliceValue.Set() = %q, want %q", current, want)
+	}
+	if got := v.String(); got != "[-_8,aGk]" {
+		t.Errorf("EncodedBytesSliceValue.String() = %q, want %q", got, "[-_8,aGk]")
+	}
+	if got := v.Type(); got != "bytesBase64RawURLSlice" {
+		t.Errorf("EncodedBytesSliceValue.Type() = %q, want %q", got, "bytesBase64RawURLSlice")
+	}
+	if err := v.Set("a+b"); err == nil {
+		t.Error("EncodedBytesSliceValue.Set() expected error for std alphabet")
+	}
+}
+
+func TestEncodedBytesSliceValue_Wrapper(t *testing.T) {
+	var current []*wrapperspb.BytesValue
+	v := EncodedBytesSlice(&current, EncodingUTF8, WithSplitter(SplitCSV), WithUnique())
+	if err := v.Set("a,b,a"); err != nil {
+		t.Fatalf("EncodedBytesSliceValue.Set() error = %v", err)
+	}
+	if len(current) != 2 || string(current[0].GetValue()) != "a" || string(current[1].GetValue()) != "b" {
+		t.Errorf("EncodedBytesSliceValue.Set() = %v, want [a b]", current)
+	}
+	if err := v.Append("c"); err != nil {
+		t.Fatalf("EncodedBytesSliceValue.Append() error = %v", err)
+	}
+	if got := v.GetSlice(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("EncodedBytesSliceValue.GetSlice() = %v", got)
+	}
+	if err := v.Replace([]string{"x"}); err != nil {
+		t.Fatalf("EncodedBytesSliceValue.Replace() error = %v", err)
+	}
+	if len(current) != 1 || string(current[0].GetValue()) != "x" {
+		t.Errorf("EncodedBytesSliceValue.Replace() = %v, want [x]", current)
+	}
+}
+
+func TestEncodedBytesSliceValue_File(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for _, name := range []string{"a.pem", "b.pem"} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(name+"\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+
+	var current [][]byte
+	v := EncodedBytesSlice(&current, EncodingFile)
+	for _, path := range paths {
+		if err := v.Set(path); err != nil {
+			t.Fatalf("EncodedBytesSliceValue.Set(%q) error = %v", path, err)
+		}
+	}
+	want := [][]byte{[]byte("a.pem\n"), []byte("b.pem\n")}
+	if !reflect.DeepEqual(current, want) {
+		t.Errorf("EncodedBytesSliceValue.Set() = %q, want %q", current, want)
+	}
+	if got := v.GetSlice(); !reflect.DeepEqual(got, paths) {
+		t.Errorf("EncodedBytesSliceValue.GetSlice() = %v, want %v", got, paths)
+	}
+	if err := v.Set(filepath.Join(dir, "missing")); err == nil {
+		t.Error("EncodedBytesSliceValue.Set() expected error for missing file")
+	}
+}
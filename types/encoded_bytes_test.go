@@ -0,0 +1,82 @@
+package types
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEncodedBytesValue_Set(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "key.bin")
+	if err := os.WriteFile(path, []byte{0, 1, 2, '\n'}, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		encoding BytesEncoding
+		input    string
+		want     string
+		wantStr  string
+		wantErr  bool
+	}{
+		{name: "base64 url", encoding: EncodingBase64URL, input: "-_8=", want: "\xfb\xff", wantStr: "-_8="},
+		{name: "base64 url rejects std alphabet", encoding: EncodingBase64URL, input: "+/8=", wantErr: true},
+		{name: "raw base64", encoding: EncodingBase64Raw, input: "+/8", want: "\xfb\xff", wantStr: "+/8"},
+		{name: "raw base64 rejects padding", encoding: EncodingBase64Raw, input: "+/8=", wantErr: true},
+		{name: "raw url base64", encoding: EncodingBase64RawURL, input: "eyJhbGciOiJIUzI1NiJ9", want: `{"alg":"HS256"}`, wantStr: "eyJhbGciOiJIUzI1NiJ9"},
+		{name: "utf8", encoding: EncodingUTF8, input: " héllo ", want: " héllo ", wantStr: " héllo "},
+		{name: "utf8 rejects invalid text", encoding: EncodingUTF8, input: "\xff", wantErr: true},
+		{name: "file keeps raw contents", encoding: EncodingFile, input: path, want: "\x00\x01\x02\n", wantStr: path},
+		{name: "missing file", encoding: EncodingFile, input: filepath.Join(dir, "missing"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b []byte
+			v := EncodedBytes(&b, tt.encoding)
+			err := v.Set(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EncodedBytesValue.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if string(b) != tt.want {
+				t.Errorf("EncodedBytesValue.Set() = %q, want %q", b, tt.want)
+			}
+			if got := v.String(); got != tt.wantStr {
+				t.Errorf("EncodedBytesValue.String() = %q, want %q", got, tt.wantStr)
+			}
+		})
+	}
+}
+
+func TestEncodedBytesValue_Type(t *testing.T) {
+	tests := []struct {
+		encoding BytesEncoding
+		want     string
+	}{
+		{EncodingBase64URL, "bytesBase64URL"},
+		{EncodingBase64Raw, "bytesBase64Raw"},
+		{EncodingBase64RawURL, "bytesBase64RawURL"},
+		{EncodingUTF8, "string"},
+		{EncodingFile, "file"},
+	}
+	for _, tt := range tests {
+		if got := EncodedBytes(new([]byte), tt.encoding).Type(); got != tt.want {
+			t.Errorf("EncodedBytesValue.Type() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestEncodedBytesValue_StringDefault(t *testing.T) {
+	b := []byte("hi")
+	if got := EncodedBytes(&b, EncodingFile).String(); got != "aGk=" {
+		t.Errorf("EncodedBytesValue.String() = %q, want %q", got, "aGk=")
+	}
+	if got := (*EncodedBytesValue)(nil).String(); got != "" {
+		t.Errorf("EncodedBytesValue.String() = %q, want empty", got)
+	}
+}
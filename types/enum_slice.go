@@ -18,6 +18,7 @@ type EnumSliceValue struct {
 	allowedTypes []string // List of valid enum value names
 	typ          protoreflect.EnumType
 	descriptors  protoreflect.EnumValueDescriptors // Enum value descriptors for validation
+	sliceOptions
 }
 
 func (s *EnumSliceValue) parseEnum(val string) (protoreflect.Enum, error) {
@@ -33,32 +34,44 @@ func (s *EnumSliceValue) parseEnum(val string) (protoreflect.Enum, error) {
 	return nil, fmt.Errorf("invalid enum value:%s, allowed values are: %s", val, strings.Join(s.allowedTypes, ","))
 }
 
+// A Splitter configured with WithSplitter may break an argument into several values.
 func (s *EnumSliceValue) Set(val string) error {
-	// Get the slice we're working with
-	slice := s.value.Elem()
-	val = strings.TrimSpace(val)
-
-	if !s.changed {
-		newSlice := reflect.MakeSlice(slice.Type(), 0, 1)
-		slice.Set(newSlice)
-		// Try to find the enum by name
-		newEnum, err := s.parseEnum(val)
-		if err != nil {
-			return err
-		}
-		slice.Set(reflect.Append(slice, reflect.ValueOf(newEnum)))
-		s.changed = true
-	} else {
+	ss, err := s.split(val, SplitNone)
+	if err != nil {
+		return err
+	}
+	out := make([]protoreflect.Enum, len(ss))
+	for i, d := range ss {
 		// Try to find the enum by name
-		newEnum, err := s.parseEnum(val)
+		out[i], err = s.parseEnum(d)
 		if err != nil {
 			return err
 		}
-		slice.Set(reflect.Append(slice, reflect.ValueOf(newEnum)))
 	}
+	// Get the slice we're working with
+	slice := s.value.Elem()
+	current := make([]protoreflect.Enum, slice.Len())
+	for i := range current {
+		current[i] = slice.Index(i).Interface().(protoreflect.Enum)
+	}
+	merged := mergeSlice(&s.sliceOptions, !s.changed, current, out, s.enumKey, enumLess)
+	newSlice := reflect.MakeSlice(slice.Type(), len(merged), len(merged))
+	for i, e := range merged {
+		newSlice.Index(i).Set(reflect.ValueOf(e))
+	}
+	slice.Set(newSlice)
+	s.changed = true
 	return nil
 }
 
+func (s *EnumSliceValue) enumKey(e protoreflect.Enum) string {
+	return strconv.Itoa(int(e.Number()))
+}
+
+func enumLess(a, b protoreflect.Enum) bool {
+	return a.Number() < b.Number()
+}
+
 func (s *EnumSliceValue) Append(val string) error {
 	// Get the slice we're working with
 	slice := s.value.Elem()
@@ -133,7 +146,7 @@ func (s *EnumSliceValue) String() string {
 	return "[" + strings.Join(enumStrSlice, ",") + "]"
 }
 
-func EnumSlice(arrPtr any) *EnumSliceValue {
+func EnumSlice(arrPtr any, opts ...SliceOption) *EnumSliceValue {
 	if arrPtr == nil {
 		panic("nil array pointer")
 	}
@@ -176,5 +189,6 @@ func EnumSlice(arrPtr any) *EnumSliceValue {
 		typ:          enumVal.Type(),
 		descriptors:  values,
 		allowedTypes: allowed,
+		sliceOptions: newSliceOptions(opts),
 	}
 }
@@ -273,3 +273,17 @@ func BenchmarkEnumSliceValue_GetSlice(b *testing.B) {
 		_ = es.GetSlice()
 	}
 }
+
+// Test merge, unique and sorted options
+func TestEnumSliceValue_Merge(t *testing.T) {
+	enumSlice := []testtypes.TestEnum1{testtypes.TestEnum1_TEST_ENUM_VALUE2}
+	es := types.EnumSlice(&enumSlice, types.WithMerge(types.MergeAppend), types.WithUnique(), types.WithSorted())
+
+	assert.NoError(t, es.Set("TEST_ENUM_VALUE2"))
+	assert.NoError(t, es.Set("1"))
+	assert.Equal(t, []string{"TEST_ENUM_VALUE1", "TEST_ENUM_VALUE2"}, es.GetSlice())
+
+	// An empty argument clears the list
+	assert.NoError(t, es.Set(""))
+	assert.Empty(t, enumSlice)
+}
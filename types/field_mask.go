@@ -0,0 +1,118 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/kunstack/protoc-gen-flags/utils"
+)
+
+var _ pflag.Value = (*FieldMaskValue)(nil)
+
+// FieldMaskValue is a pflag.Value implementation for google.protobuf.FieldMask fields.
+// It takes comma-separated paths, checked against the target message, and accumulates them
+// over repeated flags, replacing the default value on the first call.
+type FieldMaskValue struct {
+	value   *fieldmaskpb.FieldMask
+	target  protoreflect.MessageDescriptor
+	changed bool
+}
+
+// String returns the canonical paths: proto field names, sorted, without duplicates and without
+// the paths covered by a parent path.
+func (f *FieldMaskValue) String() string {
+	if f == nil || f.value == nil {
+		return ""
+	}
+	canonical := proto.Clone(f.value).(*fieldmaskpb.FieldMask)
+	canonical.Normalize()
+	return strings.Join(canonical.GetPaths(), ",")
+}
+
+// Set adds the comma-separated paths in val. An empty argument clears the mask.
+func (f *FieldMaskValue) Set(val string) error {
+	if strings.TrimSpace(val) == "" {
+		f.value.Paths = []string{}
+		f.changed = true
+		return nil
+	}
+	paths, err := f.resolve(strings.Split(val, ","))
+	if err != nil {
+		return err
+	}
+	if !f.changed {
+		f.value.Paths = nil
+	}
+	f.value.Paths = appendPaths(f.value.Paths, paths...)
+	f.changed = true
+	return nil
+}
+
+func (f *FieldMaskValue) Append(val string) error {
+	paths, err := f.resolve([]string{val})
+	if err != nil {
+		return err
+	}
+	f.value.Paths = appendPaths(f.value.Paths, paths...)
+	return nil
+}
+
+func (f *FieldMaskValue) Replace(val []string) error {
+	paths, err := f.resolve(val)
+	if err != nil {
+		return err
+	}
+	f.value.Paths = appendPaths(nil, paths...)
+	return nil
+}
+
+func (f *FieldMaskValue) GetSlice() []string {
+	return append([]string(nil), f.value.GetPaths()...)
+}
+
+func (f *FieldMaskValue) Type() string {
+	return "paths"
+}
+
+// resolve checks the paths against the target message, skipping empty paths.
+func (f *FieldMaskValue) resolve(paths []string) ([]string, error) {
+	out := make([]string, 0, len(paths))
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		path, err := utils.ResolveFieldPath(f.target, path)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, path)
+	}
+	return out, nil
+}
+
+// FieldMask binds v to a flag value taking the paths of a field mask of the message target.
+func FieldMask(v *fieldmaskpb.FieldMask, target protoreflect.MessageDescriptor) *FieldMaskValue {
+	return &FieldMaskValue{value: v, target: target}
+}
+
+// appendPaths appends the paths missing from dst.
+func appendPaths(dst []string, paths ...string) []string {
+	for _, path := range paths {
+		found := false
+		for _, p := range dst {
+			if p == path {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dst = append(dst, path)
+		}
+	}
+	return dst
+}
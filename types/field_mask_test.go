@@ -0,0 +1,99 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// The tests use google.protobuf.Api as target message.
+var apiDescriptor = (*apipb.Api)(nil).ProtoReflect().Descriptor()
+
+func TestFieldMaskValue_Set(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantPaths []string
+		wantStr   string
+		wantErr   bool
+	}{
+		{
+			name:      "comma-separated paths",
+			args:      []string{"name,version"},
+			wantPaths: []string{"name", "version"},
+			wantStr:   "name,version",
+		},
+		{
+			name:      "repeated flags accumulate",
+			args:      []string{"version", "name", "version"},
+			wantPaths: []string{"version", "name"},
+			wantStr:   "name,version",
+		},
+		{
+			name:      "nested and json names",
+			args:      []string{"sourceContext.fileName, name"},
+			wantPaths: []string{"source_context.file_name", "name"},
+			wantStr:   "name,source_context.file_name",
+		},
+		{
+			name:      "parent paths cover children in String",
+			args:      []string{"source_context.file_name,source_context"},
+			wantPaths: []string{"source_context.file_name", "source_context"},
+			wantStr:   "source_context",
+		},
+		{
+			name:      "empty argument clears",
+			args:      []string{"name", ""},
+			wantPaths: []string{},
+			wantStr:   "",
+		},
+		{name: "unknown field", args: []string{"nmae"}, wantErr: true},
+		{name: "unknown nested field", args: []string{"source_context.path"}, wantErr: true},
+		{name: "path through repeated field", args: []string{"methods.name"}, wantErr: true},
+		{name: "path through scalar field", args: []string{"name.x"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mask := &fieldmaskpb.FieldMask{Paths: []string{"mixins"}}
+			v := FieldMask(mask, apiDescriptor)
+			var err error
+			for _, arg := range tt.args {
+				if err = v.Set(arg); err != nil {
+					break
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FieldMaskValue.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(mask.GetPaths(), tt.wantPaths) {
+				t.Errorf("FieldMaskValue.Set() paths = %q, want %q", mask.GetPaths(), tt.wantPaths)
+			}
+			if got := v.String(); got != tt.wantStr {
+				t.Errorf("FieldMaskValue.String() = %q, want %q", got, tt.wantStr)
+			}
+		})
+	}
+}
+
+func TestFieldMaskValue_Slice(t *testing.T) {
+	mask := &fieldmaskpb.FieldMask{}
+	v := FieldMask(mask, apiDescriptor)
+	if err := v.Replace([]string{"name", "version"}); err != nil {
+		t.Fatalf("FieldMaskValue.Replace() error = %v", err)
+	}
+	if err := v.Append("syntax"); err != nil {
+		t.Fatalf("FieldMaskValue.Append() error = %v", err)
+	}
+	if got := v.GetSlice(); !reflect.DeepEqual(got, []string{"name", "version", "syntax"}) {
+		t.Errorf("FieldMaskValue.GetSlice() = %q", got)
+	}
+	if err := v.Append("bogus"); err == nil {
+		t.Error("FieldMaskValue.Append() expected error for an unknown field")
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/kunstack/protoc-gen-flags/utils"
+)
+
+var _ pflag.Value = (*FileRefValue)(nil)
+
+// FileRefValue wraps a pflag.Value so that an argument "@path" is read from the file at path and
+// "-" from standard input, with a trailing newline trimmed, see utils.ReadFileRef. The contents
+// are passed to the wrapped value, so bytes values decode them with their encoding.
+type FileRefValue struct {
+	pflag.Value
+}
+
+func (f *FileRefValue) Set(val string) error {
+	content, _, err := utils.ReadFileRef(val)
+	if err != nil {
+		return err
+	}
+	return f.Value.Set(content)
+}
+
+// FileRef wraps v to accept file references.
+func FileRef(v pflag.Value) *FileRefValue {
+	return &FileRefValue{Value: v}
+}
+
+// AllowFileRef wraps the value of the flag registered in fs under name with FileRef.
+// It is used for flags bound with the native pflag functions, whose values are not exported.
+func AllowFileRef(fs *pflag.FlagSet, name string) {
+	if f := fs.Lookup(name); f != nil {
+		f.Value = FileRef(f.Value)
+	}
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func writeTemp(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ref")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func withStdin(t *testing.T, content string) {
+	t.Helper()
+	f, err := os.Open(writeTemp(t, content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin := os.Stdin
+	os.Stdin = f
+	t.Cleanup(func() {
+		os.Stdin = stdin
+		_ = f.Close()
+	})
+}
+
+func TestFileRef_String(t *testing.T) {
+	secret := writeTemp(t, "s3cret\n")
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "literal", input: "plain", expected: "plain"},
+		{name: "file", input: "@" + secret, expected: "s3cret"},
+		{name: "escaped", input: "@@handle", expected: "@handle"},
+		{name: "missing file", input: "@" + secret + ".missing", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			var v string
+			fs.StringVar(&v, "password", "", "")
+			AllowFileRef(fs, "password")
+			err := fs.Set("password", tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && v != tt.expected {
+				t.Errorf("Set(%q) = %q, want %q", tt.input, v, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFileRef_Stdin(t *testing.T) {
+	withStdin(t, "from-stdin\r\n")
+	var v string
+	if err := FileRef(CheckedString(&v, "")).Set("-"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != "from-stdin" {
+		t.Errorf("Set(-) = %q, want %q", v, "from-stdin")
+	}
+}
+
+func TestFileRef_Bytes(t *testing.T) {
+	var hex []byte
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.BytesHexVar(&hex, "key", nil, "")
+	AllowFileRef(fs, "key")
+	if err := fs.Set("key", "@"+writeTemp(t, "cafe\n")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(hex, []byte{0xca, 0xfe}) {
+		t.Errorf("hex = %x", hex)
+	}
+
+	cert := &wrapperspb.BytesValue{}
+	if err := FileRef(Bytes(cert)).Set("@" + writeTemp(t, "aGVsbG8=\n")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(cert.Value) != "hello" {
+		t.Errorf("cert = %q", cert.Value)
+	}
+}
+
+func TestWithFileRef(t *testing.T) {
+	var tokens []string
+	sv := NativeStringSlice(&tokens, WithFileRef(), WithMerge(MergeAppend))
+	if err := sv.Set("first"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := sv.Set("@" + writeTemp(t, "a,b\n\nc\r\n")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(tokens, []string{"first", "a,b", "c"}) {
+		t.Errorf("tokens = %q", tokens)
+	}
+
+	var salts [][]byte
+	withStdin(t, "aGVsbG8=\nd29ybGQ=\n")
+	if err := BytesSlice(&salts, WithFileRef()).Set("-"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(salts, [][]byte{[]byte("hello"), []byte("world")}) {
+		t.Errorf("salts = %q", salts)
+	}
+}
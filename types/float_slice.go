@@ -15,15 +15,19 @@ var _ pflag.Value = (*FloatSliceValue)(nil)
 type FloatSliceValue struct {
 	value   *[]*wrapperspb.FloatValue
 	changed bool
+	sliceOptions
 }
 
 // Set converts, and assigns, the comma-separated float argument string representation as the []*wrapperspb.FloatValue value of this flag.
 // If Set is called on a flag that already has a []*wrapperspb.FloatValue assigned, the newly converted values will be appended.
+// A Splitter configured with WithSplitter replaces the comma separation.
 func (s *FloatSliceValue) Set(val string) error {
-	ss := strings.Split(val, ",")
+	ss, err := s.split(val, splitComma)
+	if err != nil {
+		return err
+	}
 	out := make([]*wrapperspb.FloatValue, len(ss))
 	for i, d := range ss {
-		var err error
 		var temp32 float64
 		temp32, err = strconv.ParseFloat(strings.TrimSpace(d), 32)
 		if err != nil {
@@ -31,11 +35,7 @@ func (s *FloatSliceValue) Set(val string) error {
 		}
 		out[i] = wrapperspb.Float(float32(temp32))
 	}
-	if !s.changed {
-		*s.value = out
-	} else {
-		*s.value = append(*s.value, out...)
-	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, wrapperKey[float32, *wrapperspb.FloatValue], wrapperLess[float32, *wrapperspb.FloatValue])
 	s.changed = true
 	return nil
 }
@@ -56,6 +56,6 @@ func (s *FloatSliceValue) String() string {
 	return "[" + out + "]"
 }
 
-func FloatSlice(v *[]*wrapperspb.FloatValue) *FloatSliceValue {
-	return &FloatSliceValue{value: v}
+func FloatSlice(v *[]*wrapperspb.FloatValue, opts ...SliceOption) *FloatSliceValue {
+	return &FloatSliceValue{value: v, sliceOptions: newSliceOptions(opts)}
 }
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/kunstack/protoc-gen-flags/utils"
+)
+
+var _ pflag.Value = (*GoogleTypeValue)(nil)
+
+// googleTypeNames maps the google.type messages to the type names shown in help output.
+var googleTypeNames = map[protoreflect.FullName]string{
+	utils.DateName:      "date",
+	utils.TimeOfDayName: "time",
+	utils.MoneyName:     "money",
+	utils.LatLngName:    "latlng",
+	utils.DecimalName:   "decimal",
+}
+
+// GoogleTypeValue is a pflag.Value implementation for the google.type common messages: Date,
+// TimeOfDay, Money, LatLng and Decimal. The message is accessed through protoreflect, so the
+// genproto packages are only needed by the code defining the fields. See utils.ParseGoogleType
+// for the syntaxes.
+type GoogleTypeValue struct {
+	value protoreflect.Message
+}
+
+func (g *GoogleTypeValue) String() string {
+	if g == nil || g.value == nil {
+		return ""
+	}
+	return utils.FormatGoogleType(g.value)
+}
+
+func (g *GoogleTypeValue) Set(s string) error {
+	return utils.SetGoogleType(g.value, s)
+}
+
+func (g *GoogleTypeValue) Type() string {
+	return googleTypeNames[g.value.Descriptor().FullName()]
+}
+
+// newGoogleType binds v, which must be the google.type message name, to a flag value.
+func newGoogleType(v proto.Message, name protoreflect.FullName) *GoogleTypeValue {
+	m := v.ProtoReflect()
+	if got := m.Descriptor().FullName(); got != name {
+		panic(fmt.Sprintf("types: %s given for a %s flag", got, name))
+	}
+	return &GoogleTypeValue{value: m}
+}
+
+// Date binds a *date.Date (google.type.Date) to a flag value taking e.g. 2026-10-16.
+func Date(v proto.Message) *GoogleTypeValue {
+	return newGoogleType(v, utils.DateName)
+}
+
+// TimeOfDay binds a *timeofday.TimeOfDay (google.type.TimeOfDay) to a flag value taking e.g. 13:45.
+func TimeOfDay(v proto.Message) *GoogleTypeValue {
+	return newGoogleType(v, utils.TimeOfDayName)
+}
+
+// Money binds a *money.Money (google.type.Money) to a flag value taking e.g. "12.50 USD".
+func Money(v proto.Message) *GoogleTypeValue {
+	return newGoogleType(v, utils.MoneyName)
+}
+
+// LatLng binds a *latlng.LatLng (google.type.LatLng) to a flag value taking e.g. 52.52,13.40.
+func LatLng(v proto.Message) *GoogleTypeValue {
+	return newGoogleType(v, utils.LatLngName)
+}
+
+// Decimal binds a *decimal.Decimal (google.type.Decimal) to a flag value taking e.g. 3.14159.
+func Decimal(v proto.Message) *GoogleTypeValue {
+	return newGoogleType(v, utils.DecimalName)
+}
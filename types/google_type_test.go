@@ -0,0 +1,157 @@
+package types
+
+import (
+	"testing"
+
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGoogleTypeValue_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   func() *GoogleTypeValue
+		arg     string
+		want    proto.Message
+		wantStr string
+		wantErr bool
+	}{
+		{
+			name:    "date",
+			value:   func() *GoogleTypeValue { return Date(&date.Date{}) },
+			arg:     "2026-10-16",
+			want:    &date.Date{Year: 2026, Month: 10, Day: 16},
+			wantStr: "2026-10-16",
+		},
+		{
+			name:    "date without day",
+			value:   func() *GoogleTypeValue { return Date(&date.Date{Day: 3}) },
+			arg:     "2026-10",
+			want:    &date.Date{Year: 2026, Month: 10},
+			wantStr: "2026-10",
+		},
+		{
+			name:    "date without year accepts february 29",
+			value:   func() *GoogleTypeValue { return Date(&date.Date{}) },
+			arg:     "--02-29",
+			want:    &date.Date{Month: 2, Day: 29},
+			wantStr: "--02-29",
+		},
+		{
+			name:    "invalid day",
+			value:   func() *GoogleTypeValue { return Date(&date.Date{}) },
+			arg:     "2025-02-29",
+			wantErr: true,
+		},
+		{
+			name:    "time of day",
+			value:   func() *GoogleTypeValue { return TimeOfDay(&timeofday.TimeOfDay{}) },
+			arg:     "13:45",
+			want:    &timeofday.TimeOfDay{Hours: 13, Minutes: 45},
+			wantStr: "13:45",
+		},
+		{
+			name:    "time of day with fraction",
+			value:   func() *GoogleTypeValue { return TimeOfDay(&timeofday.TimeOfDay{}) },
+			arg:     "08:05:30.25",
+			want:    &timeofday.TimeOfDay{Hours: 8, Minutes: 5, Seconds: 30, Nanos: 250000000},
+			wantStr: "08:05:30.25",
+		},
+		{
+			name:    "invalid time of day",
+			value:   func() *GoogleTypeValue { return TimeOfDay(&timeofday.TimeOfDay{}) },
+			arg:     "24:30",
+			wantErr: true,
+		},
+		{
+			name:    "money",
+			value:   func() *GoogleTypeValue { return Money(&money.Money{}) },
+			arg:     "12.50 usd",
+			want:    &money.Money{CurrencyCode: "USD", Units: 12, Nanos: 500000000},
+			wantStr: "12.5 USD",
+		},
+		{
+			name:    "negative money",
+			value:   func() *GoogleTypeValue { return Money(&money.Money{}) },
+			arg:     "-0.75 EUR",
+			want:    &money.Money{CurrencyCode: "EUR", Nanos: -750000000},
+			wantStr: "-0.75 EUR",
+		},
+		{
+			name:    "money without currency",
+			value:   func() *GoogleTypeValue { return Money(&money.Money{}) },
+			arg:     "12.50",
+			wantErr: true,
+		},
+		{
+			name:    "lat lng",
+			value:   func() *GoogleTypeValue { return LatLng(&latlng.LatLng{}) },
+			arg:     "52.52, 13.40",
+			want:    &latlng.LatLng{Latitude: 52.52, Longitude: 13.4},
+			wantStr: "52.52,13.4",
+		},
+		{
+			name:    "latitude out of range",
+			value:   func() *GoogleTypeValue { return LatLng(&latlng.LatLng{}) },
+			arg:     "91,0",
+			wantErr: true,
+		},
+		{
+			name:    "decimal",
+			value:   func() *GoogleTypeValue { return Decimal(&decimal.Decimal{}) },
+			arg:     "3.14159",
+			want:    &decimal.Decimal{Value: "3.14159"},
+			wantStr: "3.14159",
+		},
+		{
+			name:    "invalid decimal",
+			value:   func() *GoogleTypeValue { return Decimal(&decimal.Decimal{}) },
+			arg:     "NaN",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := tt.value()
+			before := proto.Clone(v.value.Interface())
+			err := v.Set(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !proto.Equal(v.value.Interface(), before) {
+					t.Errorf("Set() changed the value on error: %v", v.value.Interface())
+				}
+				return
+			}
+			if !proto.Equal(v.value.Interface(), tt.want) {
+				t.Errorf("Set() value = %v, want %v", v.value.Interface(), tt.want)
+			}
+			if got := v.String(); got != tt.wantStr {
+				t.Errorf("String() = %q, want %q", got, tt.wantStr)
+			}
+		})
+	}
+}
+
+func TestGoogleTypeValue_Type(t *testing.T) {
+	if got := Money(&money.Money{}).Type(); got != "money" {
+		t.Errorf("Type() = %q, want money", got)
+	}
+	if got := Date(&date.Date{}).String(); got != "" {
+		t.Errorf("String() of an empty date = %q, want empty", got)
+	}
+}
+
+func TestGoogleType_WrongMessage(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Date() with a google.type.Money did not panic")
+		}
+	}()
+	Date(&money.Money{})
+}
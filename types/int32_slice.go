@@ -6,8 +6,6 @@ import (
 
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/types/known/wrapperspb"
-
-	"github.com/kunstack/protoc-gen-flags/utils"
 )
 
 var _ pflag.Value = (*Int32SliceValue)(nil)
@@ -15,15 +13,19 @@ var _ pflag.Value = (*Int32SliceValue)(nil)
 type Int32SliceValue struct {
 	value   *[]*wrapperspb.Int32Value
 	changed bool
+	sliceOptions
 }
 
 // Set converts, and assigns, the comma-separated int32 argument string representation as the []*wrapperspb.Int32Value value of this flag.
 // If Set is called on a flag that already has a []*wrapperspb.Int32Value assigned, the newly converted values will be appended.
+// A Splitter configured with WithSplitter replaces the comma separation.
 func (s *Int32SliceValue) Set(val string) error {
-	ss := strings.Split(val, ",")
+	ss, err := s.split(val, splitComma)
+	if err != nil {
+		return err
+	}
 	out := make([]*wrapperspb.Int32Value, len(ss))
 	for i, d := range ss {
-		var err error
 		var temp32 int64
 		temp32, err = strconv.ParseInt(strings.TrimSpace(d), 10, 32)
 		if err != nil {
@@ -31,11 +33,7 @@ func (s *Int32SliceValue) Set(val string) error {
 		}
 		out[i] = wrapperspb.Int32(int32(temp32))
 	}
-	if !s.changed {
-		*s.value = out
-	} else {
-		*s.value = append(*s.value, out...)
-	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, wrapperKey[int32, *wrapperspb.Int32Value], wrapperLess[int32, *wrapperspb.Int32Value])
 	s.changed = true
 	return nil
 }
@@ -51,11 +49,11 @@ func (s *Int32SliceValue) String() string {
 	for i, v := range *s.value {
 		int32StrSlice[i] = strconv.FormatInt(int64(v.Value), 10)
 	}
-	out, _ := utils.WriteAsCSV(int32StrSlice)
+	out := s.join(int32StrSlice)
 
 	return "[" + out + "]"
 }
 
-func Int32Slice(v *[]*wrapperspb.Int32Value) *Int32SliceValue {
-	return &Int32SliceValue{value: v}
+func Int32Slice(v *[]*wrapperspb.Int32Value, opts ...SliceOption) *Int32SliceValue {
+	return &Int32SliceValue{value: v, sliceOptions: newSliceOptions(opts)}
 }
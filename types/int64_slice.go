@@ -6,8 +6,6 @@ import (
 
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/types/known/wrapperspb"
-
-	"github.com/kunstack/protoc-gen-flags/utils"
 )
 
 var _ pflag.Value = (*Int64SliceValue)(nil)
@@ -15,13 +13,16 @@ var _ pflag.Value = (*Int64SliceValue)(nil)
 type Int64SliceValue struct {
 	value   *[]*wrapperspb.Int64Value
 	changed bool
+	sliceOptions
 }
 
 func (s *Int64SliceValue) Set(val string) error {
-	ss := strings.Split(val, ",")
+	ss, err := s.split(val, splitComma)
+	if err != nil {
+		return err
+	}
 	out := make([]*wrapperspb.Int64Value, len(ss))
 	for i, d := range ss {
-		var err error
 		var temp int64
 		temp, err = strconv.ParseInt(strings.TrimSpace(d), 10, 64)
 		if err != nil {
@@ -29,11 +30,7 @@ func (s *Int64SliceValue) Set(val string) error {
 		}
 		out[i] = wrapperspb.Int64(temp)
 	}
-	if !s.changed {
-		*s.value = out
-	} else {
-		*s.value = append(*s.value, out...)
-	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, wrapperKey[int64, *wrapperspb.Int64Value], wrapperLess[int64, *wrapperspb.Int64Value])
 	s.changed = true
 	return nil
 }
@@ -49,11 +46,11 @@ func (s *Int64SliceValue) String() string {
 	for i, v := range *s.value {
 		int64StrSlice[i] = strconv.FormatInt(v.Value, 10)
 	}
-	out, _ := utils.WriteAsCSV(int64StrSlice)
+	out := s.join(int64StrSlice)
 
 	return "[" + out + "]"
 }
 
-func Int64Slice(v *[]*wrapperspb.Int64Value) *Int64SliceValue {
-	return &Int64SliceValue{value: v}
+func Int64Slice(v *[]*wrapperspb.Int64Value, opts ...SliceOption) *Int64SliceValue {
+	return &Int64SliceValue{value: v, sliceOptions: newSliceOptions(opts)}
 }
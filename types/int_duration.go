@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kunstack/protoc-gen-flags/utils"
+	"github.com/spf13/pflag"
+)
+
+// The storage units of the integer duration values.
+const (
+	Nanoseconds  = time.Nanosecond
+	Microseconds = time.Microsecond
+	Milliseconds = time.Millisecond
+	Seconds      = time.Second
+	Minutes      = time.Minute
+	Hours        = time.Hour
+)
+
+var _ pflag.Value = (*IntDurationValue[int64])(nil)
+
+// IntDurationValue is a pflag.Value implementation for integer fields holding a duration
+// as a number of unit, such as timeout_ms. It accepts durations like "1m30s" and fails
+// when the value is not a whole number of unit or does not fit the field.
+type IntDurationValue[T int32 | int64 | uint32 | uint64] struct {
+	value *T
+	unit  time.Duration
+}
+
+func (d *IntDurationValue[T]) String() string {
+	if d == nil || d.value == nil {
+		return "0s"
+	}
+	if v, ok := any(*d.value).(uint64); !ok || v <= math.MaxInt64 {
+		if dur, ok := utils.IntToDuration(int64(*d.value), d.unit); ok {
+			return utils.FormatDuration(dur)
+		}
+	}
+	// The value is beyond the range of time.Duration, print it in its storage unit
+	return fmt.Sprint(*d.value, unitSuffixes[d.unit])
+}
+
+var unitSuffixes = map[time.Duration]string{
+	time.Nanosecond:  "ns",
+	time.Microsecond: "us",
+	time.Millisecond: "ms",
+	time.Second:      "s",
+	time.Minute:      "m",
+	time.Hour:        "h",
+}
+
+func (d *IntDurationValue[T]) Set(s string) error {
+	dur, err := utils.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	bitSize, unsigned := 64, false
+	switch any(d.value).(type) {
+	case *int32:
+		bitSize = 32
+	case *uint32:
+		bitSize, unsigned = 32, true
+	case *uint64:
+		unsigned = true
+	}
+	n, err := utils.DurationToInt(dur, d.unit, bitSize, unsigned)
+	if err != nil {
+		return err
+	}
+	*d.value = T(n)
+	return nil
+}
+
+func (d *IntDurationValue[T]) Type() string {
+	return "duration"
+}
+
+// DurationAs binds the integer v, holding a number of unit, to a duration flag value.
+func DurationAs[T int32 | int64 | uint32 | uint64](v *T, unit time.Duration) *IntDurationValue[T] {
+	return &IntDurationValue[T]{value: v, unit: unit}
+}
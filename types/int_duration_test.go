@@ -0,0 +1,83 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestIntDurationValue_Set(t *testing.T) {
+	t.Run("milliseconds int64", func(t *testing.T) {
+		var v int64
+		d := DurationAs(&v, Milliseconds)
+		if err := d.Set("1m30s"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v != 90000 {
+			t.Errorf("got %d, want 90000", v)
+		}
+		if got := d.String(); got != "90s" {
+			t.Errorf("String() = %q, want %q", got, "90s")
+		}
+	})
+
+	t.Run("truncation", func(t *testing.T) {
+		var v int64
+		if err := DurationAs(&v, Seconds).Set("1500ms"); err == nil {
+			t.Error("expected error for a duration that is not a whole number of seconds")
+		}
+	})
+
+	t.Run("uint32 seconds", func(t *testing.T) {
+		var v uint32
+		d := DurationAs(&v, Seconds)
+		if err := d.Set("2h"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v != 7200 {
+			t.Errorf("got %d, want 7200", v)
+		}
+		if err := d.Set("-1s"); err == nil {
+			t.Error("expected error for a negative duration on an unsigned field")
+		}
+	})
+
+	t.Run("int32 overflow", func(t *testing.T) {
+		var v int32
+		d := DurationAs(&v, Nanoseconds)
+		if err := d.Set("3s"); err == nil {
+			t.Error("expected overflow error for 3s in int32 nanoseconds")
+		}
+		if err := d.Set("2s"); err != nil || v != 2e9 {
+			t.Errorf("Set(2s) = %d, %v", v, err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var v int64
+		if err := DurationAs(&v, Seconds).Set("abc"); err == nil {
+			t.Error("expected error for invalid duration")
+		}
+	})
+}
+
+func TestIntDurationValue_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    uint64
+		unit     time.Duration
+		expected string
+	}{
+		{name: "zero", value: 0, unit: Seconds, expected: "0s"},
+		{name: "minutes", value: 90, unit: Minutes, expected: "90m"},
+		{name: "overflow", value: math.MaxUint64, unit: Hours, expected: "18446744073709551615h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := tt.value
+			if got := DurationAs(&v, tt.unit).String(); got != tt.expected {
+				t.Errorf("String() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
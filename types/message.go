@@ -0,0 +1,226 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var _ pflag.Value = (*MessageValue)(nil)
+
+// MessageValue is a pflag.Value implementation taking a whole message as protojson, e.g.
+// {"certFile": "a"}, or prototext, optionally enclosed in braces, e.g. {cert_file: "a"}.
+//
+// The fields given replace the current values in place, the other fields are kept, so the flags
+// bound to the fields of the message stay valid. The fields set by the per-field flags tracked
+// with TrackFlags are not overwritten: these flags take precedence over the literal whatever the
+// order of the arguments.
+type MessageValue struct {
+	value protoreflect.Message
+	sets  []trackedSet
+}
+
+// trackedSet is a call to Set of a per-field flag of the message.
+type trackedSet struct {
+	value pflag.Value
+	arg   string
+}
+
+// trackedValue wraps the value of a per-field flag of the message to record the calls to Set.
+type trackedValue struct {
+	pflag.Value
+	message *MessageValue
+}
+
+func (t *trackedValue) Set(s string) error {
+	if err := t.Value.Set(s); err != nil {
+		return err
+	}
+	t.message.sets = append(t.message.sets, trackedSet{value: t.Value, arg: s})
+	return nil
+}
+
+// Unwrap returns the wrapped value.
+func (t *trackedValue) Unwrap() pflag.Value {
+	return t.Value
+}
+
+// String returns the message as compact protojson, or an empty string when no field is set.
+func (m *MessageValue) String() string {
+	if m == nil || m.value == nil || isEmptyMessage(m.value) {
+		return ""
+	}
+	out, err := protojson.Marshal(m.value.Interface())
+	if err != nil {
+		return prototext.MarshalOptions{}.Format(m.value.Interface())
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, out); err != nil {
+		return string(out)
+	}
+	return compact.String()
+}
+
+func (m *MessageValue) Set(val string) error {
+	literal := m.value.New()
+	if err := protojson.Unmarshal([]byte(val), literal.Interface()); err != nil {
+		text := strings.TrimSpace(val)
+		if strings.HasPrefix(text, "{") && strings.HasSuffix(text, "}") {
+			text = text[1 : len(text)-1]
+		}
+		literal = m.value.New()
+		if textErr := prototext.Unmarshal([]byte(text), literal.Interface()); textErr != nil {
+			return fmt.Errorf("invalid %s, expected protojson or prototext: %v", m.value.Descriptor().FullName(), textErr)
+		}
+	}
+
+	before := proto.Clone(m.value.Interface()).ProtoReflect()
+	mergeFields(m.value, literal)
+	// Setting the per-field flags again reveals the fields they are bound to, even when set to the
+	// value the field already held, which are restored to their values before the literal.
+	for _, set := range m.sets {
+		merged := proto.Clone(m.value.Interface()).ProtoReflect()
+		if err := set.value.Set(set.arg); err != nil {
+			return err
+		}
+		changed := make(map[string]struct{})
+		diffFields(m.value, merged, "", changed)
+		for path := range changed {
+			copyField(m.value, before, path)
+		}
+	}
+	return nil
+}
+
+func (m *MessageValue) Type() string {
+	return "message"
+}
+
+// Message binds v to a flag value taking the whole message.
+func Message(v proto.Message) *MessageValue {
+	return &MessageValue{value: v.ProtoReflect()}
+}
+
+// TrackFlags calls register, which registers the per-field flags of the message in fs, and wraps
+// the values of the flags it adds so that the fields they set are kept by Set.
+func (m *MessageValue) TrackFlags(fs *pflag.FlagSet, register func()) {
+	existing := make(map[string]struct{})
+	fs.VisitAll(func(f *pflag.Flag) {
+		existing[f.Name] = struct{}{}
+	})
+	register()
+	fs.VisitAll(func(f *pflag.Flag) {
+		if _, ok := existing[f.Name]; ok {
+			return
+		}
+		f.Value = &trackedValue{Value: f.Value, message: m}
+	})
+}
+
+// isEmptyMessage reports whether no field of m is set.
+func isEmptyMessage(m protoreflect.Message) bool {
+	empty := true
+	m.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		empty = false
+		return false
+	})
+	return empty
+}
+
+// isSingularMessage reports whether fd is a message field that is neither a list nor a map.
+func isSingularMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Message() != nil && !fd.IsList() && !fd.IsMap()
+}
+
+// diffFields adds to out the paths of the fields of a that differ from b. Singular message
+// fields are compared field by field, an unset message being equal to an empty one.
+func diffFields(a, b protoreflect.Message, prefix string, out map[string]struct{}) {
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		if !a.Has(fd) && !b.Has(fd) {
+			continue
+		}
+		if isSingularMessage(fd) {
+			diffFields(a.Get(fd).Message(), b.Get(fd).Message(), path+".", out)
+			continue
+		}
+		if a.Has(fd) != b.Has(fd) || !equalFieldValue(fd, a.Get(fd), b.Get(fd)) {
+			out[path] = struct{}{}
+		}
+	}
+}
+
+// equalFieldValue compares the values x and y of the list, map or scalar field fd.
+func equalFieldValue(fd protoreflect.FieldDescriptor, x, y protoreflect.Value) bool {
+	switch {
+	case fd.IsList():
+		lx, ly := x.List(), y.List()
+		if lx.Len() != ly.Len() {
+			return false
+		}
+		for i := 0; i < lx.Len(); i++ {
+			if !equalScalar(fd, lx.Get(i), ly.Get(i)) {
+				return false
+			}
+		}
+		return true
+	case fd.IsMap():
+		mx, my := x.Map(), y.Map()
+		if mx.Len() != my.Len() {
+			return false
+		}
+		equal := true
+		mx.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			equal = my.Has(k) && equalScalar(fd.MapValue(), v, my.Get(k))
+			return equal
+		})
+		return equal
+	}
+	return equalScalar(fd, x, y)
+}
+
+// equalScalar compares the values x and y of a single element of fd.
+func equalScalar(fd protoreflect.FieldDescriptor, x, y protoreflect.Value) bool {
+	switch {
+	case fd.Message() != nil:
+		return proto.Equal(x.Message().Interface(), y.Message().Interface())
+	case fd.Kind() == protoreflect.BytesKind:
+		return bytes.Equal(x.Bytes(), y.Bytes())
+	}
+	return x.Interface() == y.Interface()
+}
+
+// mergeFields sets the fields set in src on dst in place, recursing into singular message fields.
+func mergeFields(dst, src protoreflect.Message) {
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if isSingularMessage(fd) {
+			mergeFields(dst.Mutable(fd).Message(), v.Message())
+			return true
+		}
+		dst.Set(fd, v)
+		return true
+	})
+}
+
+// copyField sets the field at path, as reported by diffFields, of dst to its value in src.
+func copyField(dst, src protoreflect.Message, path string) {
+	name, rest, nested := strings.Cut(path, ".")
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(name))
+	switch {
+	case nested:
+		copyField(dst.Mutable(fd).Message(), src.Get(fd).Message(), rest)
+	case src.Has(fd):
+		dst.Set(fd, src.Get(fd))
+	default:
+		dst.Clear(fd)
+	}
+}
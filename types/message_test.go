@@ -0,0 +1,152 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+	"google.golang.org/protobuf/types/known/typepb"
+)
+
+func TestMessageValue_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial *apipb.Api
+		args    []string
+		want    *apipb.Api
+		wantErr bool
+	}{
+		{
+			name:    "protojson",
+			initial: &apipb.Api{Name: "default", Version: "v1"},
+			args:    []string{`{"name": "billing", "sourceContext": {"fileName": "a.proto"}}`},
+			want:    &apipb.Api{Name: "billing", Version: "v1", SourceContext: &sourcecontextpb.SourceContext{FileName: "a.proto"}},
+		},
+		{
+			name:    "prototext with braces",
+			initial: &apipb.Api{},
+			args:    []string{`{name: "billing" syntax: SYNTAX_PROTO3}`},
+			want:    &apipb.Api{Name: "billing", Syntax: typepb.Syntax_SYNTAX_PROTO3},
+		},
+		{
+			name:    "lists are replaced",
+			initial: &apipb.Api{Methods: []*apipb.Method{{Name: "Get"}}},
+			args:    []string{`methods: [{name: "List"}]`},
+			want:    &apipb.Api{Methods: []*apipb.Method{{Name: "List"}}},
+		},
+		{
+			name:    "invalid",
+			initial: &apipb.Api{Name: "default"},
+			args:    []string{`{"unknown": 1}`},
+			want:    &apipb.Api{Name: "default"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Message(tt.initial)
+			for _, arg := range tt.args {
+				if err := v.Set(arg); (err != nil) != tt.wantErr {
+					t.Fatalf("Set(%q) error = %v, wantErr %v", arg, err, tt.wantErr)
+				}
+			}
+			if !proto.Equal(tt.initial, tt.want) {
+				t.Errorf("value = %v, want %v", tt.initial, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessageValue_TrackFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want *typepb.Type
+	}{
+		{
+			name: "literal only",
+			args: []string{`--type={name: "lit" oneofs: ["x"] source_context {file_name: "lit.proto"}}`},
+			want: &typepb.Type{Name: "lit", Oneofs: []string{"x"}, SourceContext: &sourcecontextpb.SourceContext{FileName: "lit.proto"}},
+		},
+		{
+			name: "per-field flag before",
+			args: []string{"--name=a", `--type={name: "lit" oneofs: ["x"]}`},
+			want: &typepb.Type{Name: "a", Oneofs: []string{"x"}, SourceContext: &sourcecontextpb.SourceContext{}},
+		},
+		{
+			name: "per-field flag after",
+			args: []string{`--type={name: "lit" oneofs: ["x"]}`, "--name=a"},
+			want: &typepb.Type{Name: "a", Oneofs: []string{"x"}, SourceContext: &sourcecontextpb.SourceContext{}},
+		},
+		{
+			name: "per-field flag set to the current value before",
+			args: []string{"--name=default", `--type={name: "lit"}`},
+			want: &typepb.Type{Name: "default", SourceContext: &sourcecontextpb.SourceContext{}},
+		},
+		{
+			name: "per-field flag set to the current value after",
+			args: []string{`--type={name: "lit"}`, "--name=default"},
+			want: &typepb.Type{Name: "default", SourceContext: &sourcecontextpb.SourceContext{}},
+		},
+		{
+			name: "list flag before",
+			args: []string{"--oneofs=a", `--type={oneofs: ["x", "y"]}`},
+			want: &typepb.Type{Name: "default", Oneofs: []string{"a"}, SourceContext: &sourcecontextpb.SourceContext{}},
+		},
+		{
+			name: "list flag after",
+			args: []string{`--type={oneofs: ["x", "y"]}`, "--oneofs=a"},
+			want: &typepb.Type{Name: "default", Oneofs: []string{"a"}, SourceContext: &sourcecontextpb.SourceContext{}},
+		},
+		{
+			name: "nested flag before",
+			args: []string{"--file-name=b.proto", `--type={source_context {file_name: "lit.proto"}}`, `--type={name: "lit"}`},
+			want: &typepb.Type{Name: "lit", SourceContext: &sourcecontextpb.SourceContext{FileName: "b.proto"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ := &typepb.Type{Name: "default", SourceContext: &sourcecontextpb.SourceContext{}}
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			literal := Message(typ)
+			literal.TrackFlags(fs, func() {
+				fs.StringVar(&typ.Name, "name", typ.Name, "")
+				fs.StringSliceVar(&typ.Oneofs, "oneofs", typ.Oneofs, "")
+				fs.StringVar(&typ.SourceContext.FileName, "file-name", typ.SourceContext.FileName, "")
+			})
+			fs.Var(literal, "type", "")
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(typ, tt.want) {
+				t.Errorf("value = %v, want %v", typ, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessageValue_KeepsNestedPointers(t *testing.T) {
+	api := &apipb.Api{SourceContext: &sourcecontextpb.SourceContext{}}
+	bound := &api.SourceContext.FileName
+	v := Message(api)
+	if err := v.Set(`{"sourceContext": {"fileName": "a.proto"}}`); err != nil {
+		t.Fatal(err)
+	}
+	if *bound != "a.proto" {
+		t.Errorf("bound field = %q, want a.proto", *bound)
+	}
+}
+
+func TestMessageValue_String(t *testing.T) {
+	if got := Message(&apipb.Api{}).String(); got != "" {
+		t.Errorf("String() = %q, want empty", got)
+	}
+	if got := Message(&apipb.Api{Name: "billing"}).String(); got != `{"name":"billing"}` {
+		t.Errorf("String() = %q", got)
+	}
+	if got := Message(&apipb.Api{}).Type(); got != "message" {
+		t.Errorf("Type() = %q, want message", got)
+	}
+}
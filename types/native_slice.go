@@ -0,0 +1,187 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+var (
+	_ pflag.Value      = (*NativeSliceValue[string])(nil)
+	_ pflag.SliceValue = (*NativeSliceValue[string])(nil)
+)
+
+// NativeSliceValue is a pflag.Value implementation for handling native repeated scalar fields
+// such as []string or []uint32. It behaves like the pflag slice values of the same type, except that
+// an empty argument clears the slice, and additionally honors the SliceOption settings.
+type NativeSliceValue[T any] struct {
+	value   *[]T
+	parse   func(string) (T, error)
+	format  func(T) string
+	less    func(a, b T) bool
+	typ     string
+	def     Splitter
+	changed bool
+	sliceOptions
+}
+
+// Set converts, and assigns, the separated argument string representation as the slice value of this flag.
+// If Set is called on a flag that already has a value assigned, the newly converted values will be appended.
+func (s *NativeSliceValue[T]) Set(val string) error {
+	ss, err := s.split(val, s.def)
+	if err != nil {
+		return err
+	}
+	out := make([]T, len(ss))
+	for i, d := range ss {
+		out[i], err = s.parse(d)
+		if err != nil {
+			return err
+		}
+	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, s.format, s.less)
+	s.changed = true
+	return nil
+}
+
+func (s *NativeSliceValue[T]) Append(val string) error {
+	val, err := applyChecks(s.checks, val)
+	if err != nil {
+		return err
+	}
+	v, err := s.parse(val)
+	if err != nil {
+		return err
+	}
+	*s.value = append(*s.value, v)
+	return nil
+}
+
+func (s *NativeSliceValue[T]) Replace(val []string) error {
+	out := make([]T, len(val))
+	for i, d := range val {
+		d, err := applyChecks(s.checks, d)
+		if err != nil {
+			return err
+		}
+		out[i], err = s.parse(d)
+		if err != nil {
+			return err
+		}
+	}
+	*s.value = out
+	return nil
+}
+
+func (s *NativeSliceValue[T]) GetSlice() []string {
+	out := make([]string, len(*s.value))
+	for i, d := range *s.value {
+		out[i] = s.format(d)
+	}
+	return out
+}
+
+// Type returns a string that uniquely represents this flag's type.
+func (s *NativeSliceValue[T]) Type() string {
+	return s.typ
+}
+
+// String defines a "native" format for this slice flag value.
+func (s *NativeSliceValue[T]) String() string {
+	out := s.join(s.GetSlice())
+	return "[" + out + "]"
+}
+
+func newNativeSlice[T any](v *[]T, typ string, def Splitter, parse func(string) (T, error), format func(T) string, less func(a, b T) bool, opts []SliceOption) *NativeSliceValue[T] {
+	return &NativeSliceValue[T]{
+		value:        v,
+		parse:        parse,
+		format:       format,
+		less:         less,
+		typ:          typ,
+		def:          def,
+		sliceOptions: newSliceOptions(opts),
+	}
+}
+
+func NativeStringSlice(v *[]string, opts ...SliceOption) *NativeSliceValue[string] {
+	return newNativeSlice(v, "stringSlice", SplitCSV,
+		func(s string) (string, error) { return s, nil },
+		func(s string) string { return s },
+		orderedLess[string],
+		opts,
+	)
+}
+
+func NativeBoolSlice(v *[]bool, opts ...SliceOption) *NativeSliceValue[bool] {
+	return newNativeSlice(v, "boolSlice", splitQuotedCSV,
+		func(s string) (bool, error) { return strconv.ParseBool(strings.TrimSpace(s)) },
+		strconv.FormatBool,
+		boolLess,
+		opts,
+	)
+}
+
+func NativeInt32Slice(v *[]int32, opts ...SliceOption) *NativeSliceValue[int32] {
+	return newNativeSlice(v, "int32Slice", splitComma,
+		func(s string) (int32, error) {
+			i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+			return int32(i), err
+		},
+		func(i int32) string { return strconv.FormatInt(int64(i), 10) },
+		orderedLess[int32],
+		opts,
+	)
+}
+
+func NativeInt64Slice(v *[]int64, opts ...SliceOption) *NativeSliceValue[int64] {
+	return newNativeSlice(v, "int64Slice", splitComma,
+		func(s string) (int64, error) { return strconv.ParseInt(strings.TrimSpace(s), 10, 64) },
+		func(i int64) string { return strconv.FormatInt(i, 10) },
+		orderedLess[int64],
+		opts,
+	)
+}
+
+func NativeUInt32Slice(v *[]uint32, opts ...SliceOption) *NativeSliceValue[uint32] {
+	return newNativeSlice(v, "uint32Slice", splitComma,
+		func(s string) (uint32, error) {
+			i, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+			return uint32(i), err
+		},
+		func(i uint32) string { return strconv.FormatUint(uint64(i), 10) },
+		orderedLess[uint32],
+		opts,
+	)
+}
+
+func NativeUInt64Slice(v *[]uint64, opts ...SliceOption) *NativeSliceValue[uint64] {
+	return newNativeSlice(v, "uint64Slice", splitComma,
+		func(s string) (uint64, error) { return strconv.ParseUint(strings.TrimSpace(s), 10, 64) },
+		func(i uint64) string { return strconv.FormatUint(i, 10) },
+		orderedLess[uint64],
+		opts,
+	)
+}
+
+func NativeFloatSlice(v *[]float32, opts ...SliceOption) *NativeSliceValue[float32] {
+	return newNativeSlice(v, "float32Slice", splitComma,
+		func(s string) (float32, error) {
+			f, err := strconv.ParseFloat(strings.TrimSpace(s), 32)
+			return float32(f), err
+		},
+		func(f float32) string { return strconv.FormatFloat(float64(f), 'g', -1, 32) },
+		orderedLess[float32],
+		opts,
+	)
+}
+
+func NativeDoubleSlice(v *[]float64, opts ...SliceOption) *NativeSliceValue[float64] {
+	return newNativeSlice(v, "float64Slice", splitComma,
+		func(s string) (float64, error) { return strconv.ParseFloat(strings.TrimSpace(s), 64) },
+		func(f float64) string { return strconv.FormatFloat(f, 'g', -1, 64) },
+		orderedLess[float64],
+		opts,
+	)
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNativeStringSlice(t *testing.T) {
+	var slice []string
+	v := NativeStringSlice(&slice)
+	if err := v.Set(`a,"b,c"`); err != nil {
+		t.Fatalf("NativeStringSlice.Set() error = %v", err)
+	}
+	if err := v.Set("d"); err != nil {
+		t.Fatalf("NativeStringSlice.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(slice, []string{"a", "b,c", "d"}) {
+		t.Errorf("NativeStringSlice.Set() = %v, want [a b,c d]", slice)
+	}
+	if v.Type() != "stringSlice" {
+		t.Errorf("NativeStringSlice.Type() = %v, want stringSlice", v.Type())
+	}
+	if v.String() != `[a,"b,c",d]` {
+		t.Errorf("NativeStringSlice.String() = %v, want %v", v.String(), `[a,"b,c",d]`)
+	}
+}
+
+func TestNativeStringSlice_WithSplitter(t *testing.T) {
+	slice := []string{"default"}
+	v := NativeStringSlice(&slice, WithSplitter(SplitNone))
+	if err := v.Set("a,b"); err != nil {
+		t.Fatalf("NativeStringSlice.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(slice, []string{"a,b"}) {
+		t.Errorf("NativeStringSlice.Set() = %v, want [a,b]", slice)
+	}
+}
+
+func TestNativeUInt32Slice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []uint32
+		wantErr  bool
+	}{
+		{
+			name:     "multiple values",
+			input:    "1, 2,3",
+			expected: []uint32{1, 2, 3},
+		},
+		{
+			name:     "max uint32",
+			input:    "4294967295",
+			expected: []uint32{4294967295},
+		},
+		{
+			name:    "overflow",
+			input:   "4294967296",
+			wantErr: true,
+		},
+		{
+			name:    "negative",
+			input:   "-1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var slice []uint32
+			err := NativeUInt32Slice(&slice).Set(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NativeUInt32Slice.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(slice, tt.expected) {
+				t.Errorf("NativeUInt32Slice.Set() = %v, want %v", slice, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNativeUInt64Slice(t *testing.T) {
+	var slice []uint64
+	v := NativeUInt64Slice(&slice)
+	if err := v.Set("18446744073709551615,0"); err != nil {
+		t.Fatalf("NativeUInt64Slice.Set() error = %v", err)
+	}
+	if v.String() != "[18446744073709551615,0]" {
+		t.Errorf("NativeUInt64Slice.String() = %v", v.String())
+	}
+	if err := v.Replace([]string{"7"}); err != nil {
+		t.Fatalf("NativeUInt64Slice.Replace() error = %v", err)
+	}
+	if err := v.Append("8"); err != nil {
+		t.Fatalf("NativeUInt64Slice.Append() error = %v", err)
+	}
+	if !reflect.DeepEqual(v.GetSlice(), []string{"7", "8"}) {
+		t.Errorf("NativeUInt64Slice.GetSlice() = %v, want [7 8]", v.GetSlice())
+	}
+}
+
+func TestNativeNumericSlices(t *testing.T) {
+	var bools []bool
+	if err := NativeBoolSlice(&bools).Set(`"true",false`); err != nil {
+		t.Fatalf("NativeBoolSlice.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(bools, []bool{true, false}) {
+		t.Errorf("NativeBoolSlice.Set() = %v", bools)
+	}
+
+	var ints []int32
+	if err := NativeInt32Slice(&ints, WithSplitter(SplitJSON)).Set("[1,-2]"); err != nil {
+		t.Fatalf("NativeInt32Slice.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(ints, []int32{1, -2}) {
+		t.Errorf("NativeInt32Slice.Set() = %v", ints)
+	}
+
+	var longs []int64
+	if err := NativeInt64Slice(&longs, WithSplitter(SplitSeparator(" "))).Set("1 2"); err != nil {
+		t.Fatalf("NativeInt64Slice.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(longs, []int64{1, 2}) {
+		t.Errorf("NativeInt64Slice.Set() = %v", longs)
+	}
+
+	var floats []float32
+	fv := NativeFloatSlice(&floats)
+	if err := fv.Set("1.5,2"); err != nil {
+		t.Fatalf("NativeFloatSlice.Set() error = %v", err)
+	}
+	if fv.String() != "[1.5,2]" {
+		t.Errorf("NativeFloatSlice.String() = %v", fv.String())
+	}
+
+	var doubles []float64
+	if err := NativeDoubleSlice(&doubles).Set("abc"); err == nil {
+		t.Errorf("NativeDoubleSlice.Set() expected error")
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/spf13/pflag"
+)
+
+var _ pflag.Value = (*PresenceValue[struct{}])(nil)
+
+// PresenceValue is a bool pflag.Value controlling whether an optional message field is set:
+// true sets the field to the message given to Presence, false sets it to nil.
+type PresenceValue[T any] struct {
+	field **T
+	msg   *T
+}
+
+// String returns whether the field is set.
+func (p *PresenceValue[T]) String() string {
+	if p == nil || p.field == nil {
+		return "false"
+	}
+	return strconv.FormatBool(*p.field != nil)
+}
+
+func (p *PresenceValue[T]) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	if v {
+		*p.field = p.msg
+	} else {
+		*p.field = nil
+	}
+	return nil
+}
+
+func (p *PresenceValue[T]) Type() string {
+	return "bool"
+}
+
+// IsBoolFlag allows the flag to be given without a value, see flags.AddPresenceFlags.
+func (p *PresenceValue[T]) IsBoolFlag() bool {
+	return true
+}
+
+// Presence binds the presence of the message field *field to a flag value. msg is the message
+// the field is set to when the flag is true, the flags of its fields should be bound to it.
+func Presence[T any](field **T, msg *T) *PresenceValue[T] {
+	return &PresenceValue[T]{field: field, msg: msg}
+}
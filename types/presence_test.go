@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestPresenceValue_Set(t *testing.T) {
+	var field *durationpb.Duration
+	msg := &durationpb.Duration{Seconds: 5}
+	v := Presence(&field, msg)
+
+	if got := v.String(); got != "false" {
+		t.Errorf("String() = %q, want false", got)
+	}
+	if err := v.Set("true"); err != nil {
+		t.Fatal(err)
+	}
+	if field != msg {
+		t.Errorf("field = %v, want the bound message", field)
+	}
+	if got := v.String(); got != "true" {
+		t.Errorf("String() = %q, want true", got)
+	}
+	if err := v.Set("false"); err != nil {
+		t.Fatal(err)
+	}
+	if field != nil {
+		t.Errorf("field = %v, want nil", field)
+	}
+	if err := v.Set("maybe"); err == nil {
+		t.Error("Set(maybe) did not fail")
+	}
+	if v.Type() != "bool" || !v.IsBoolFlag() {
+		t.Errorf("Type() = %q, IsBoolFlag() = %v", v.Type(), v.IsBoolFlag())
+	}
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxRangeSize is the number of items a single argument may expand to
+// when range expansion is enabled without an explicit cap.
+const DefaultMaxRangeSize = 10000
+
+// expandRanges expands the range items of an integer slice argument. An item is either
+// a single integer, a range "lo-hi" or a stepped range "lo-hi:step", bounds inclusive.
+// At most max items are produced.
+func expandRanges(items []string, max int) ([]string, error) {
+	if max <= 0 {
+		max = DefaultMaxRangeSize
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		expanded, err := expandRange(item, max-len(out))
+		if err == errRangeLimit {
+			return nil, fmt.Errorf("range %q expands beyond the limit of %d items", item, max)
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, expanded...)
+	}
+	return out, nil
+}
+
+var errRangeLimit = errors.New("range limit exceeded")
+
+// expandRange expands a single item into at most max items, or fails with errRangeLimit.
+func expandRange(item string, max int) ([]string, error) {
+	bounds, step := item, "1"
+	if i := strings.IndexByte(item, ':'); i >= 0 {
+		bounds, step = item[:i], item[i+1:]
+	}
+	// Skip a leading sign so that negative bounds such as "-5--1" are split correctly
+	i := -1
+	if len(bounds) > 1 {
+		if i = strings.IndexByte(bounds[1:], '-'); i >= 0 {
+			i++
+		}
+	}
+	if i < 0 {
+		if bounds != item {
+			return nil, fmt.Errorf("invalid range %q: a step requires a range", item)
+		}
+		if max < 1 {
+			return nil, errRangeLimit
+		}
+		return []string{item}, nil
+	}
+
+	lo, hi := strings.TrimSpace(bounds[:i]), strings.TrimSpace(bounds[i+1:])
+	n, err := strconv.ParseUint(step, 10, 64)
+	if err != nil || n == 0 {
+		return nil, fmt.Errorf("invalid range %q: step must be a positive integer", item)
+	}
+
+	if l, err := strconv.ParseInt(lo, 10, 64); err == nil {
+		if h, err := strconv.ParseInt(hi, 10, 64); err == nil {
+			if l > h {
+				return nil, fmt.Errorf("invalid range %q: start is greater than end", item)
+			}
+			if uint64(h-l)/n >= uint64(max) {
+				return nil, errRangeLimit
+			}
+			var out []string
+			for v := l; ; v += int64(n) {
+				out = append(out, strconv.FormatInt(v, 10))
+				if uint64(h-v) < n {
+					return out, nil
+				}
+			}
+		}
+	}
+
+	l, err := strconv.ParseUint(lo, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range %q: %w", item, err)
+	}
+	h, err := strconv.ParseUint(hi, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid range %q: %w", item, err)
+	}
+	if l > h {
+		return nil, fmt.Errorf("invalid range %q: start is greater than end", item)
+	}
+	if (h-l)/n >= uint64(max) {
+		return nil, errRangeLimit
+	}
+	var out []string
+	for v := l; ; v += n {
+		out = append(out, strconv.FormatUint(v, 10))
+		if h-v < n {
+			return out, nil
+		}
+	}
+}
+
+// compressRanges joins the decimal integer items with commas, compressing runs of
+// three or more consecutive values into "lo-hi".
+func compressRanges(items []string) string {
+	var (
+		b     strings.Builder
+		start = 0
+	)
+	for i := 1; i <= len(items); i++ {
+		if i < len(items) && isSuccessor(items[i-1], items[i]) {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		switch i - start {
+		case 1:
+			b.WriteString(items[start])
+		case 2:
+			b.WriteString(items[start] + "," + items[i-1])
+		default:
+			b.WriteString(items[start] + "-" + items[i-1])
+		}
+		start = i
+	}
+	return b.String()
+}
+
+// isSuccessor reports whether the integer b is a + 1.
+func isSuccessor(a, b string) bool {
+	if x, err := strconv.ParseInt(a, 10, 64); err == nil {
+		y, err := strconv.ParseInt(b, 10, 64)
+		return err == nil && x != math.MaxInt64 && y == x+1
+	}
+	x, err := strconv.ParseUint(a, 10, 64)
+	if err != nil {
+		return false
+	}
+	y, err := strconv.ParseUint(b, 10, 64)
+	return err == nil && x != math.MaxUint64 && y == x+1
+}
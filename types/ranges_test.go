@@ -0,0 +1,145 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestExpandRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []string
+		max      int
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "single values",
+			items:    []string{"1", " 8 "},
+			expected: []string{"1", "8"},
+		},
+		{
+			name:     "ranges",
+			items:    []string{"1-5", "8", "10-12"},
+			expected: []string{"1", "2", "3", "4", "5", "8", "10", "11", "12"},
+		},
+		{
+			name:     "stepped range",
+			items:    []string{"0-100:25"},
+			expected: []string{"0", "25", "50", "75", "100"},
+		},
+		{
+			name:     "step not reaching the end",
+			items:    []string{"1-10:4"},
+			expected: []string{"1", "5", "9"},
+		},
+		{
+			name:     "negative bounds",
+			items:    []string{"-3--1", "-1-1"},
+			expected: []string{"-3", "-2", "-1", "-1", "0", "1"},
+		},
+		{
+			name:     "unsigned bounds beyond int64",
+			items:    []string{"18446744073709551614-18446744073709551615"},
+			expected: []string{"18446744073709551614", "18446744073709551615"},
+		},
+		{
+			name:    "start greater than end",
+			items:   []string{"5-1"},
+			wantErr: true,
+		},
+		{
+			name:    "zero step",
+			items:   []string{"1-5:0"},
+			wantErr: true,
+		},
+		{
+			name:    "step without range",
+			items:   []string{"5:2"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid bound",
+			items:   []string{"a-5"},
+			wantErr: true,
+		},
+		{
+			name:    "exceeds limit",
+			items:   []string{"1-3", "4-6"},
+			max:     5,
+			wantErr: true,
+		},
+		{
+			name:    "exceeds default limit",
+			items:   []string{"-9223372036854775808-9223372036854775807"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := expandRanges(tt.items, tt.max)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expandRanges() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expandRanges() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompressRanges(t *testing.T) {
+	tests := []struct {
+		items    []string
+		expected string
+	}{
+		{items: []string{}, expected: ""},
+		{items: []string{"1"}, expected: "1"},
+		{items: []string{"1", "2"}, expected: "1,2"},
+		{items: []string{"1", "2", "3", "4", "5", "8", "10", "11", "12"}, expected: "1-5,8,10-12"},
+		{items: []string{"5", "4", "3"}, expected: "5,4,3"},
+		{items: []string{"-2", "-1", "0"}, expected: "-2-0"},
+		{items: []string{"18446744073709551613", "18446744073709551614", "18446744073709551615"}, expected: "18446744073709551613-18446744073709551615"},
+	}
+
+	for _, tt := range tests {
+		if result := compressRanges(tt.items); result != tt.expected {
+			t.Errorf("compressRanges(%v) = %v, want %v", tt.items, result, tt.expected)
+		}
+	}
+}
+
+func TestWithRanges(t *testing.T) {
+	var shards []*wrapperspb.Int32Value
+	isv := Int32Slice(&shards, WithRanges(0))
+	if err := isv.Set("1-5,8,10-12"); err != nil {
+		t.Fatalf("Int32SliceValue.Set() error = %v", err)
+	}
+	if len(shards) != 9 {
+		t.Errorf("Int32SliceValue.Set() length = %v, want 9", len(shards))
+	}
+	if isv.String() != "[1-5,8,10-12]" {
+		t.Errorf("Int32SliceValue.String() = %v, want [1-5,8,10-12]", isv.String())
+	}
+	if err := isv.Set("2147483647-2147483648"); err == nil {
+		t.Errorf("Int32SliceValue.Set() expected overflow error")
+	}
+
+	var ports []uint32
+	usv := NativeUInt32Slice(&ports, WithRanges(100))
+	if err := usv.Set("8000-8002,9000"); err != nil {
+		t.Fatalf("NativeUInt32Slice.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(ports, []uint32{8000, 8001, 8002, 9000}) {
+		t.Errorf("NativeUInt32Slice.Set() = %v", ports)
+	}
+	if usv.String() != "[8000-8002,9000]" {
+		t.Errorf("NativeUInt32Slice.String() = %v, want [8000-8002,9000]", usv.String())
+	}
+	if err := usv.Set("0-1000"); err == nil {
+		t.Errorf("NativeUInt32Slice.Set() expected range limit error")
+	}
+}
@@ -0,0 +1,227 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kunstack/protoc-gen-flags/utils"
+)
+
+// MergeMode controls how the items given on the command line are combined with the
+// value a repeated flag held before its first Set, usually the defaults filled by SetDefaults.
+type MergeMode int
+
+const (
+	// MergeReplace discards the previous value on the first Set, later calls append.
+	MergeReplace MergeMode = iota
+	// MergeAppend keeps the previous value and appends the command-line items after it.
+	MergeAppend
+	// MergePrepend keeps the previous value and inserts the command-line items before it.
+	MergePrepend
+)
+
+// SliceOption customizes how the repeated flag values in this package handle their arguments.
+type SliceOption func(*sliceOptions)
+
+type sliceOptions struct {
+	splitter Splitter
+	merge    MergeMode
+	unique   bool
+	sorted   bool
+	ranges   bool
+	maxRange int
+	checks   []StringCheck
+	fileRef  bool
+
+	// prepended is the number of command-line items inserted before the previous value in MergePrepend mode.
+	prepended int
+}
+
+// WithSplitter sets the Splitter used to break each argument into items,
+// overriding the historical behavior of the value type.
+func WithSplitter(splitter Splitter) SliceOption {
+	return func(o *sliceOptions) {
+		o.splitter = splitter
+	}
+}
+
+// WithMerge sets how the command-line items are combined with the previous value.
+func WithMerge(mode MergeMode) SliceOption {
+	return func(o *sliceOptions) {
+		o.merge = mode
+	}
+}
+
+// WithUnique drops repeated items after every Set, keeping the first occurrence.
+func WithUnique() SliceOption {
+	return func(o *sliceOptions) {
+		o.unique = true
+	}
+}
+
+// WithSorted sorts the items in ascending order after every Set.
+func WithSorted() SliceOption {
+	return func(o *sliceOptions) {
+		o.sorted = true
+	}
+}
+
+// WithRanges enables range expansion for integer slices: an item "1-5" expands to 1,2,3,4,5 and
+// "0-100:10" steps by 10. A single argument expands to at most max items, DefaultMaxRangeSize
+// when max is zero. The flag value is printed with runs of consecutive values compressed.
+func WithRanges(max int) SliceOption {
+	return func(o *sliceOptions) {
+		o.ranges = true
+		o.maxRange = max
+	}
+}
+
+// WithCheck validates and canonicalizes every item with check before it is parsed.
+// Several checks are applied in the order they are given.
+func WithCheck(check StringCheck) SliceOption {
+	return func(o *sliceOptions) {
+		o.checks = append(o.checks, check)
+	}
+}
+
+// WithFileRef reads an argument "@path" from the file at path and "-" from standard input, one item
+// per line, see utils.ReadFileRef. Empty lines are skipped.
+func WithFileRef() SliceOption {
+	return func(o *sliceOptions) {
+		o.fileRef = true
+	}
+}
+
+func newSliceOptions(opts []SliceOption) sliceOptions {
+	var o sliceOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// split breaks val into items with the configured Splitter, or with def when none was configured,
+// then expands ranges when enabled and applies the checks to every item. An empty argument always
+// yields no items, which clears the list. File references are split into lines instead.
+func (o *sliceOptions) split(val string, def Splitter) ([]string, error) {
+	if o.splitter != nil {
+		def = o.splitter
+	}
+	if o.fileRef {
+		content, ok, err := utils.ReadFileRef(val)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			def = splitLines
+		}
+		val = content
+	}
+	if val == "" {
+		return []string{}, nil
+	}
+	items, err := def(val)
+	if err == nil && o.ranges {
+		items, err = expandRanges(items, o.maxRange)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range items {
+		if items[i], err = applyChecks(o.checks, item); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// join joins the items of an integer slice for String, compressing consecutive runs when
+// range expansion is enabled.
+func (o *sliceOptions) join(items []string) string {
+	if o.ranges {
+		return compressRanges(items)
+	}
+	out, _ := utils.WriteAsCSV(items)
+	return out
+}
+
+// mergeSlice combines the parsed items of a Set call with the current value according to the
+// configured MergeMode, then applies the unique and sorted post-processing.
+// first reports whether this is the first Set call of the flag. Setting no items clears the list.
+// key identifies equal items and less orders them.
+func mergeSlice[T any](o *sliceOptions, first bool, current, items []T, key func(T) string, less func(a, b T) bool) []T {
+	if len(items) == 0 {
+		o.prepended = 0
+		return []T{}
+	}
+
+	if o.prepended > len(current) {
+		o.prepended = len(current)
+	}
+
+	var out []T
+	switch {
+	case first && o.merge == MergeReplace:
+		out = items
+	case o.merge == MergePrepend:
+		out = make([]T, 0, len(current)+len(items))
+		out = append(out, current[:o.prepended]...)
+		out = append(out, items...)
+		out = append(out, current[o.prepended:]...)
+		o.prepended += len(items)
+	default:
+		out = append(append(make([]T, 0, len(current)+len(items)), current...), items...)
+	}
+
+	if o.unique {
+		seen := make(map[string]struct{}, len(out))
+		deduped := out[:0]
+		prepended := 0
+		for i, item := range out {
+			k := key(item)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if i < o.prepended {
+				prepended++
+			}
+			deduped = append(deduped, item)
+		}
+		out = deduped
+		o.prepended = prepended
+	}
+
+	if o.sorted {
+		sort.SliceStable(out, func(i, j int) bool {
+			return less(out[i], out[j])
+		})
+	}
+	return out
+}
+
+// ordered is the set of element types that support the < operator.
+type ordered interface {
+	~int32 | ~int64 | ~uint32 | ~uint64 | ~float32 | ~float64 | ~string
+}
+
+// wrapper is implemented by the wrapperspb message types.
+type wrapper[V any] interface {
+	GetValue() V
+}
+
+func orderedLess[T ordered](a, b T) bool {
+	return a < b
+}
+
+func boolLess(a, b bool) bool {
+	return !a && b
+}
+
+func wrapperKey[V any, T wrapper[V]](v T) string {
+	return fmt.Sprint(v.GetValue())
+}
+
+func wrapperLess[V ordered, T wrapper[V]](a, b T) bool {
+	return a.GetValue() < b.GetValue()
+}
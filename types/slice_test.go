@@ -0,0 +1,136 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSliceMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []SliceOption
+		defaults []string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "replace discards defaults",
+			defaults: []string{"a", "b"},
+			args:     []string{"c", "d"},
+			expected: []string{"c", "d"},
+		},
+		{
+			name:     "append keeps defaults",
+			opts:     []SliceOption{WithMerge(MergeAppend)},
+			defaults: []string{"a", "b"},
+			args:     []string{"c", "d"},
+			expected: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:     "prepend keeps command-line order",
+			opts:     []SliceOption{WithMerge(MergePrepend)},
+			defaults: []string{"a", "b"},
+			args:     []string{"c", "d"},
+			expected: []string{"c", "d", "a", "b"},
+		},
+		{
+			name:     "empty argument clears the list",
+			opts:     []SliceOption{WithMerge(MergeAppend)},
+			defaults: []string{"a", "b"},
+			args:     []string{"", "c"},
+			expected: []string{"c"},
+		},
+		{
+			name:     "prepend after clearing",
+			opts:     []SliceOption{WithMerge(MergePrepend)},
+			defaults: []string{"a"},
+			args:     []string{"b", "", "c"},
+			expected: []string{"c"},
+		},
+		{
+			name:     "unique keeps first occurrence",
+			opts:     []SliceOption{WithMerge(MergePrepend), WithUnique()},
+			defaults: []string{"a", "b"},
+			args:     []string{"b", "c", "b", "d"},
+			expected: []string{"b", "c", "d", "a"},
+		},
+		{
+			name:     "sorted",
+			opts:     []SliceOption{WithMerge(MergeAppend), WithSorted()},
+			defaults: []string{"b"},
+			args:     []string{"c", "a"},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "unique and sorted",
+			opts:     []SliceOption{WithUnique(), WithSorted()},
+			args:     []string{"b", "a", "b"},
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slice := make([]*wrapperspb.StringValue, len(tt.defaults))
+			for i, d := range tt.defaults {
+				slice[i] = wrapperspb.String(d)
+			}
+			ssv := StringSlice(&slice, tt.opts...)
+			for _, arg := range tt.args {
+				if err := ssv.Set(arg); err != nil {
+					t.Fatalf("StringSliceValue.Set(%q) error = %v", arg, err)
+				}
+			}
+			if got := ssv.GetSlice(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("StringSliceValue.GetSlice() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSliceMerge_DefaultsNotModified(t *testing.T) {
+	defaults := []int32{3, 1}
+	slice := defaults
+	v := NativeInt32Slice(&slice, WithMerge(MergeAppend), WithSorted())
+	if err := v.Set("2"); err != nil {
+		t.Fatalf("NativeInt32Slice.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(slice, []int32{1, 2, 3}) {
+		t.Errorf("NativeInt32Slice.Set() = %v, want [1 2 3]", slice)
+	}
+	if !reflect.DeepEqual(defaults, []int32{3, 1}) {
+		t.Errorf("defaults were modified: %v", defaults)
+	}
+}
+
+func TestSliceMerge_Sorted(t *testing.T) {
+	durations := []*durationpb.Duration{durationpb.New(60e9)}
+	dsv := DurationSlice(&durations, WithMerge(MergeAppend), WithSorted(), WithUnique())
+	if err := dsv.Set("1s,1m,500ms"); err != nil {
+		t.Fatalf("DurationSliceValue.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(dsv.GetSlice(), []string{"500ms", "1s", "1m"}) {
+		t.Errorf("DurationSliceValue.GetSlice() = %v", dsv.GetSlice())
+	}
+
+	var uints []*wrapperspb.UInt64Value
+	usv := UInt64Slice(&uints, WithSorted())
+	if err := usv.Set("10,9,100"); err != nil {
+		t.Fatalf("UInt64SliceValue.Set() error = %v", err)
+	}
+	if usv.String() != "[9,10,100]" {
+		t.Errorf("UInt64SliceValue.String() = %v, want [9,10,100]", usv.String())
+	}
+
+	bools := []bool{true}
+	bsv := NativeBoolSlice(&bools, WithMerge(MergePrepend), WithSorted())
+	if err := bsv.Set("false"); err != nil {
+		t.Fatalf("NativeBoolSlice.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(bools, []bool{false, true}) {
+		t.Errorf("NativeBoolSlice.Set() = %v, want [false true]", bools)
+	}
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kunstack/protoc-gen-flags/utils"
+)
+
+// Splitter breaks a single command-line argument into the items of a repeated flag.
+type Splitter func(val string) ([]string, error)
+
+// SplitCSV parses the argument as a single CSV record, the same way pflag's
+// StringSlice does, so items may be quoted to contain commas.
+// An empty argument yields no items.
+func SplitCSV(val string) ([]string, error) {
+	items, err := utils.ReadAsCSV(val)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return items, nil
+}
+
+// SplitNone never splits: the argument is exactly one item, the same way
+// pflag's StringArray behaves.
+func SplitNone(val string) ([]string, error) {
+	return []string{val}, nil
+}
+
+// SplitSeparator returns a Splitter breaking the argument on sep, e.g. ";" or ":"
+// for PATH-like values. An empty argument yields no items.
+func SplitSeparator(sep string) Splitter {
+	return func(val string) ([]string, error) {
+		if val == "" {
+			return []string{}, nil
+		}
+		return strings.Split(val, sep), nil
+	}
+}
+
+// SplitJSON parses the argument as a JSON array. String elements are used as-is,
+// any other element (numbers, booleans) is used as its JSON text.
+func SplitJSON(val string) ([]string, error) {
+	var raw []json.RawMessage
+	decoder := json.NewDecoder(strings.NewReader(val))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid JSON array %q: %w", val, err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("invalid JSON array %q: unexpected trailing data", val)
+	}
+	items := make([]string, len(raw))
+	for i, item := range raw {
+		if bytes.HasPrefix(item, []byte(`"`)) {
+			if err := json.Unmarshal(item, &items[i]); err != nil {
+				return nil, fmt.Errorf("invalid JSON array %q: %w", val, err)
+			}
+			continue
+		}
+		if bytes.Equal(item, []byte("null")) || bytes.HasPrefix(item, []byte("{")) || bytes.HasPrefix(item, []byte("[")) {
+			return nil, fmt.Errorf("invalid JSON array %q: element %d must be a string, number or boolean", val, i)
+		}
+		items[i] = string(item)
+	}
+	return items, nil
+}
+
+// splitComma is the historical splitter of the numeric and duration slices:
+// a plain split on commas without any quoting support.
+func splitComma(val string) ([]string, error) {
+	return strings.Split(val, ","), nil
+}
+
+// splitLines splits the contents of a file reference into lines, trimming carriage returns
+// and skipping empty lines.
+func splitLines(val string) ([]string, error) {
+	var items []string
+	for _, line := range strings.Split(val, "\n") {
+		if line = strings.TrimSuffix(line, "\r"); line != "" {
+			items = append(items, line)
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSplitters(t *testing.T) {
+	tests := []struct {
+		name     string
+		splitter Splitter
+		input    string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "csv",
+			splitter: SplitCSV,
+			input:    `a,"b,c",d`,
+			expected: []string{"a", "b,c", "d"},
+		},
+		{
+			name:     "csv empty",
+			splitter: SplitCSV,
+			input:    "",
+			expected: []string{},
+		},
+		{
+			name:     "none",
+			splitter: SplitNone,
+			input:    "a,b",
+			expected: []string{"a,b"},
+		},
+		{
+			name:     "separator",
+			splitter: SplitSeparator(":"),
+			input:    "/usr/bin:/bin",
+			expected: []string{"/usr/bin", "/bin"},
+		},
+		{
+			name:     "separator empty",
+			splitter: SplitSeparator(":"),
+			input:    "",
+			expected: []string{},
+		},
+		{
+			name:     "json",
+			splitter: SplitJSON,
+			input:    `["a,b", 1, true, "c"]`,
+			expected: []string{"a,b", "1", "true", "c"},
+		},
+		{
+			name:     "json invalid",
+			splitter: SplitJSON,
+			input:    `["a"`,
+			wantErr:  true,
+		},
+		{
+			name:     "json not an array",
+			splitter: SplitJSON,
+			input:    `"a"`,
+			wantErr:  true,
+		},
+		{
+			name:     "json nested",
+			splitter: SplitJSON,
+			input:    `[["a"]]`,
+			wantErr:  true,
+		},
+		{
+			name:     "json null",
+			splitter: SplitJSON,
+			input:    `[null]`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.splitter(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Splitter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Splitter() = %#v, want %#v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWithSplitter(t *testing.T) {
+	var ints []*wrapperspb.Int32Value
+	if err := Int32Slice(&ints, WithSplitter(SplitJSON)).Set("[1, 2, 3]"); err != nil {
+		t.Fatalf("Int32SliceValue.Set() error = %v", err)
+	}
+	if len(ints) != 3 || ints[2].GetValue() != 3 {
+		t.Errorf("Int32SliceValue.Set() = %v, want [1 2 3]", ints)
+	}
+
+	var strs []*wrapperspb.StringValue
+	ssv := StringSlice(&strs, WithSplitter(SplitSeparator(";")))
+	if err := ssv.Set("a;b"); err != nil {
+		t.Fatalf("StringSliceValue.Set() error = %v", err)
+	}
+	if err := ssv.Set("c"); err != nil {
+		t.Fatalf("StringSliceValue.Set() error = %v", err)
+	}
+	if got := ssv.GetSlice(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("StringSliceValue.GetSlice() = %v, want [a b c]", got)
+	}
+
+	var bs [][]byte
+	if err := BytesHexSlice(&bs, WithSplitter(SplitCSV)).Set("0a,ff"); err != nil {
+		t.Fatalf("BytesHexSlice.Set() error = %v", err)
+	}
+	if !reflect.DeepEqual(bs, [][]byte{{0x0a}, {0xff}}) {
+		t.Errorf("BytesHexSlice.Set() = %v, want [[10] [255]]", bs)
+	}
+}
@@ -12,19 +12,30 @@ var _ pflag.Value = (*StringSliceValue)(nil)
 type StringSliceValue struct {
 	value   *[]*wrapperspb.StringValue
 	changed bool
+	sliceOptions
 }
 
+// Set assigns val as a single value of this flag, replacing the default value on the first call
+// and appending afterwards. A Splitter configured with WithSplitter may break val into several values.
 func (s *StringSliceValue) Set(val string) error {
-	if !s.changed {
-		*s.value = []*wrapperspb.StringValue{{Value: val}}
-		s.changed = true
-	} else {
-		*s.value = append(*s.value, wrapperspb.String(val))
+	ss, err := s.split(val, SplitNone)
+	if err != nil {
+		return err
 	}
+	out := make([]*wrapperspb.StringValue, len(ss))
+	for i, d := range ss {
+		out[i] = wrapperspb.String(d)
+	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, wrapperKey[string, *wrapperspb.StringValue], wrapperLess[string, *wrapperspb.StringValue])
+	s.changed = true
 	return nil
 }
 
 func (s *StringSliceValue) Append(val string) error {
+	val, err := applyChecks(s.checks, val)
+	if err != nil {
+		return err
+	}
 	*s.value = append(*s.value, wrapperspb.String(val))
 	return nil
 }
@@ -32,6 +43,10 @@ func (s *StringSliceValue) Append(val string) error {
 func (s *StringSliceValue) Replace(val []string) error {
 	out := make([]*wrapperspb.StringValue, len(val))
 	for i, d := range val {
+		d, err := applyChecks(s.checks, d)
+		if err != nil {
+			return err
+		}
 		out[i] = wrapperspb.String(d)
 	}
 	*s.value = out
@@ -61,6 +76,6 @@ func (s *StringSliceValue) String() string {
 	return "[" + out + "]"
 }
 
-func StringSlice(v *[]*wrapperspb.StringValue) *StringSliceValue {
-	return &StringSliceValue{value: v}
+func StringSlice(v *[]*wrapperspb.StringValue, opts ...SliceOption) *StringSliceValue {
+	return &StringSliceValue{value: v, sliceOptions: newSliceOptions(opts)}
 }
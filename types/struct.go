@@ -0,0 +1,175 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	_ pflag.Value = (*StructValue)(nil)
+	_ pflag.Value = (*ValueValue)(nil)
+	_ pflag.Value = (*ListValue)(nil)
+)
+
+// overridePattern matches the key of a "key=value" override, a dotted path of object keys.
+var overridePattern = regexp.MustCompile(`^[^\s=.{}\[\]"]+(\.[^\s=.{}\[\]"]+)*=`)
+
+// StructValue is a pflag.Value implementation for google.protobuf.Struct fields.
+// It accepts a JSON object, replacing the value, or a "key.path=value" override.
+type StructValue struct {
+	value *structpb.Struct
+}
+
+// String returns the value as compact JSON with sorted keys.
+func (s *StructValue) String() string {
+	if s == nil || s.value == nil || len(s.value.GetFields()) == 0 {
+		return ""
+	}
+	return marshalJSON(s.value.AsMap())
+}
+
+func (s *StructValue) Set(val string) error {
+	if path, v, ok := parseOverride(val); ok {
+		setPath(s.value, path, v)
+		return nil
+	}
+	parsed := &structpb.Struct{}
+	if err := protojson.Unmarshal([]byte(val), parsed); err != nil {
+		return fmt.Errorf("expected a JSON object or key=value: %w", err)
+	}
+	s.value.Fields = parsed.Fields
+	return nil
+}
+
+func (s *StructValue) Type() string {
+	return "json"
+}
+
+// Struct binds v to a flag value taking JSON objects and overrides.
+func Struct(v *structpb.Struct) *StructValue {
+	return &StructValue{value: v}
+}
+
+// ValueValue is a pflag.Value implementation for google.protobuf.Value fields.
+// It accepts any JSON value, replacing the value, or a "key.path=value" override, which
+// turns the value into an object when it is not one.
+type ValueValue struct {
+	value *structpb.Value
+}
+
+// String returns the value as compact JSON with sorted keys.
+func (s *ValueValue) String() string {
+	if s == nil || s.value == nil || s.value.GetKind() == nil {
+		return ""
+	}
+	return marshalJSON(s.value.AsInterface())
+}
+
+func (s *ValueValue) Set(val string) error {
+	parsed := &structpb.Value{}
+	err := protojson.Unmarshal([]byte(val), parsed)
+	if err == nil {
+		s.value.Kind = parsed.Kind
+		return nil
+	}
+	if path, v, ok := parseOverride(val); ok {
+		st := s.value.GetStructValue()
+		if st == nil {
+			st = &structpb.Struct{}
+			s.value.Kind = &structpb.Value_StructValue{StructValue: st}
+		}
+		setPath(st, path, v)
+		return nil
+	}
+	return fmt.Errorf("expected a JSON value or key=value: %w", err)
+}
+
+func (s *ValueValue) Type() string {
+	return "json"
+}
+
+// Value binds v to a flag value taking JSON values and overrides.
+func Value(v *structpb.Value) *ValueValue {
+	return &ValueValue{value: v}
+}
+
+// ListValue is a pflag.Value implementation for google.protobuf.ListValue fields.
+// It accepts a JSON array, replacing the value.
+type ListValue struct {
+	value *structpb.ListValue
+}
+
+// String returns the value as compact JSON with sorted keys.
+func (s *ListValue) String() string {
+	if s == nil || s.value == nil || len(s.value.GetValues()) == 0 {
+		return ""
+	}
+	return marshalJSON(s.value.AsSlice())
+}
+
+func (s *ListValue) Set(val string) error {
+	parsed := &structpb.ListValue{}
+	if err := protojson.Unmarshal([]byte(val), parsed); err != nil {
+		return fmt.Errorf("expected a JSON array: %w", err)
+	}
+	s.value.Values = parsed.Values
+	return nil
+}
+
+func (s *ListValue) Type() string {
+	return "json"
+}
+
+// List binds v to a flag value taking JSON arrays.
+func List(v *structpb.ListValue) *ListValue {
+	return &ListValue{value: v}
+}
+
+// parseOverride splits a "key.path=value" override. ok is false when val is not an override.
+// The value is parsed as JSON, and taken as a string when it is not valid JSON.
+func parseOverride(val string) (path []string, v *structpb.Value, ok bool) {
+	if !overridePattern.MatchString(val) {
+		return nil, nil, false
+	}
+	key, raw, _ := strings.Cut(val, "=")
+	v = &structpb.Value{}
+	if err := protojson.Unmarshal([]byte(raw), v); err != nil {
+		v = structpb.NewStringValue(raw)
+	}
+	return strings.Split(key, "."), v, true
+}
+
+// setPath sets the value at path in s, replacing intermediate values that are not objects.
+func setPath(s *structpb.Struct, path []string, v *structpb.Value) {
+	for _, key := range path[:len(path)-1] {
+		if s.Fields == nil {
+			s.Fields = make(map[string]*structpb.Value)
+		}
+		next := s.Fields[key].GetStructValue()
+		if next == nil {
+			next = &structpb.Struct{}
+			s.Fields[key] = structpb.NewStructValue(next)
+		}
+		s = next
+	}
+	if s.Fields == nil {
+		s.Fields = make(map[string]*structpb.Value)
+	}
+	s.Fields[path[len(path)-1]] = v
+}
+
+// marshalJSON encodes v with encoding/json, which sorts object keys, unlike protojson whose
+// output is deliberately unstable.
+func marshalJSON(v interface{}) string {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
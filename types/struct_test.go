@@ -0,0 +1,114 @@
+package types
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestStructValue_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{name: "json", args: []string{`{"mode": "fast", "timeout": 5}`}, want: `{"mode":"fast","timeout":5}`},
+		{name: "json replaces the value", args: []string{`{"a": 1}`, `{"b": 2}`}, want: `{"b":2}`},
+		{name: "overrides build the value", args: []string{"timeout=5", "mode=fast", "debug=true"}, want: `{"debug":true,"mode":"fast","timeout":5}`},
+		{name: "overrides apply after json", args: []string{`{"a": 1, "b": 1}`, "b=2"}, want: `{"a":1,"b":2}`},
+		{name: "nested override", args: []string{"db.port=5432", "db.host=localhost"}, want: `{"db":{"host":"localhost","port":5432}}`},
+		{name: "nested override replaces scalars", args: []string{"db=1", "db.port=1"}, want: `{"db":{"port":1}}`},
+		{name: "override with json value", args: []string{`tags=["a","b"]`}, want: `{"tags":["a","b"]}`},
+		{name: "quoted string value", args: []string{`n="5"`}, want: `{"n":"5"}`},
+		{name: "empty value", args: []string{"n="}, want: `{"n":""}`},
+		{name: "array is rejected", args: []string{`[1]`}, wantErr: true},
+		{name: "invalid json", args: []string{`{"a":`}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Struct(&structpb.Struct{})
+			var err error
+			for _, arg := range tt.args {
+				if err = v.Set(arg); err != nil {
+					break
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StructValue.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && v.String() != tt.want {
+				t.Errorf("StructValue.String() = %s, want %s", v.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestValueValue_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{name: "number", args: []string{"5"}, want: "5"},
+		{name: "string", args: []string{`"fast"`}, want: `"fast"`},
+		{name: "null", args: []string{"null"}, want: "null"},
+		{name: "array", args: []string{`[1, "a"]`}, want: `[1,"a"]`},
+		{name: "override turns the value into an object", args: []string{"5", "a.b=1"}, want: `{"a":{"b":1}}`},
+		{name: "override keeps objects", args: []string{`{"a": 1}`, "b=2"}, want: `{"a":1,"b":2}`},
+		{name: "bare word", args: []string{"fast"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Value(&structpb.Value{})
+			var err error
+			for _, arg := range tt.args {
+				if err = v.Set(arg); err != nil {
+					break
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValueValue.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && v.String() != tt.want {
+				t.Errorf("ValueValue.String() = %s, want %s", v.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestListValue_Set(t *testing.T) {
+	l := &structpb.ListValue{}
+	v := List(l)
+	if got := v.String(); got != "" {
+		t.Errorf("ListValue.String() = %q, want empty", got)
+	}
+	if err := v.Set(`[1, {"a": true}]`); err != nil {
+		t.Fatalf("ListValue.Set() error = %v", err)
+	}
+	if got := v.String(); got != `[1,{"a":true}]` {
+		t.Errorf("ListValue.String() = %s", got)
+	}
+	if err := v.Set("a=1"); err == nil {
+		t.Error("ListValue.Set() expected error for an override")
+	}
+	if got := v.Type(); got != "json" {
+		t.Errorf("ListValue.Type() = %q, want json", got)
+	}
+}
+
+func TestStructValue_SetKeepsValueOnError(t *testing.T) {
+	v := Struct(&structpb.Struct{})
+	if err := v.Set(`{"a": 1}`); err != nil {
+		t.Fatalf("StructValue.Set() error = %v", err)
+	}
+	if err := v.Set(`{"a":`); err == nil {
+		t.Fatal("StructValue.Set() expected error for invalid JSON")
+	}
+	if got := v.String(); got != `{"a":1}` {
+		t.Errorf("StructValue.String() = %s, want {\"a\":1}", got)
+	}
+}
@@ -1,8 +1,6 @@
 package types
 
 import (
-	"fmt"
-	"strings"
 	"time"
 
 	"github.com/kunstack/protoc-gen-flags/utils"
@@ -12,32 +10,38 @@ import (
 
 var _ pflag.Value = (*TimestampValue)(nil)
 
+// TimestampValue is a pflag.Value implementation for google.protobuf.Timestamp fields.
+// Besides the configured layouts it accepts relative expressions such as "now-15m" or
+// "yesterday+6h" and Unix epoch seconds or milliseconds, see utils.ParseTime.
 type TimestampValue struct {
 	layouts []string
+	loc     *time.Location
+	err     error
 	wrap    *timestamppb.Timestamp
 }
 
+// String formats the timestamp with the first layout.
 func (t *TimestampValue) String() string {
 	if t.wrap != nil {
 		w := t.wrap.AsTime()
 		if !w.IsZero() {
-			return w.Format(time.RFC3339Nano)
+			return utils.FormatTime(w, t.layouts, t.loc)
 		}
 	}
 	return ""
 }
 
 func (t *TimestampValue) Set(s string) error {
-	for _, format := range t.layouts {
-		actualFormat := utils.ParseTimeFormat(format)
-		value, err := time.Parse(actualFormat, s)
-		if err == nil {
-			t.wrap.Seconds = value.Unix()
-			t.wrap.Nanos = int32(value.Nanosecond())
-			return nil
-		}
+	if t.err != nil {
+		return t.err
+	}
+	value, err := utils.ParseTime(s, t.layouts, t.loc)
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("invalid time format `%s` must be one of: %s", s, strings.Join(t.layouts, ","))
+	t.wrap.Seconds = value.Unix()
+	t.wrap.Nanos = int32(value.Nanosecond())
+	return nil
 }
 
 func (t *TimestampValue) Type() string {
@@ -47,3 +51,10 @@ func (t *TimestampValue) Type() string {
 func Timestamp(wrap *timestamppb.Timestamp, formats []string) *TimestampValue {
 	return &TimestampValue{wrap: wrap, layouts: formats}
 }
+
+// TimestampIn is like Timestamp, but interprets the values without an offset and formats the
+// value in the IANA time zone with the given name.
+func TimestampIn(wrap *timestamppb.Timestamp, formats []string, timezone string) *TimestampValue {
+	loc, err := utils.LoadLocation(timezone)
+	return &TimestampValue{wrap: wrap, layouts: formats, loc: loc, err: err}
+}
@@ -1,8 +1,6 @@
 package types
 
 import (
-	"fmt"
-	"strings"
 	"time"
 
 	"github.com/kunstack/protoc-gen-flags/utils"
@@ -18,67 +16,73 @@ var (
 type TimestampSliceValue struct {
 	value   *[]*timestamppb.Timestamp
 	layouts []string
+	loc     *time.Location
+	err     error
 	changed bool
+	sliceOptions
 }
 
+// Set parses val with the configured layouts, replacing the default value on the first call
+// and appending afterwards. A Splitter configured with WithSplitter may break val into several values.
 func (t *TimestampSliceValue) Set(val string) error {
-	for _, format := range t.layouts {
-		actualFormat := utils.ParseTimeFormat(format)
-		parsedTime, err := time.Parse(actualFormat, val)
-		if err == nil {
-			ts := timestamppb.New(parsedTime)
-			if !t.changed {
-				*t.value = []*timestamppb.Timestamp{ts}
-				t.changed = true
-			} else {
-				*t.value = append(*t.value, ts)
-			}
-			return nil
+	ss, err := t.split(val, SplitNone)
+	if err != nil {
+		return err
+	}
+	out := make([]*timestamppb.Timestamp, len(ss))
+	for i, d := range ss {
+		ts, err := t.parse(d)
+		if err != nil {
+			return err
 		}
+		out[i] = ts
 	}
-	return fmt.Errorf("invalid time format `%s` must be one of: %s", val, strings.Join(t.layouts, ","))
+	*t.value = mergeSlice(&t.sliceOptions, !t.changed, *t.value, out, timestampKey, timestampLess)
+	t.changed = true
+	return nil
+}
+
+func (t *TimestampSliceValue) parse(val string) (*timestamppb.Timestamp, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	parsedTime, err := utils.ParseTime(val, t.layouts, t.loc)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(parsedTime), nil
 }
 
 func (t *TimestampSliceValue) Append(val string) error {
-	for _, format := range t.layouts {
-		actualFormat := utils.ParseTimeFormat(format)
-		parsedTime, err := time.Parse(actualFormat, val)
-		if err == nil {
-			*t.value = append(*t.value, timestamppb.New(parsedTime))
-			return nil
-		}
+	ts, err := t.parse(val)
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("invalid time format `%s` must be one of: %s", val, strings.Join(t.layouts, ","))
+	*t.value = append(*t.value, ts)
+	return nil
 }
 
 func (t *TimestampSliceValue) Replace(val []string) error {
 	out := make([]*timestamppb.Timestamp, 0, len(val))
 	for _, timestampStr := range val {
-		var parsed bool
-		for _, format := range t.layouts {
-			actualFormat := utils.ParseTimeFormat(format)
-			parsedTime, err := time.Parse(actualFormat, timestampStr)
-			if err == nil {
-				out = append(out, timestamppb.New(parsedTime))
-				parsed = true
-				break
-			}
-		}
-		if !parsed {
-			return fmt.Errorf("invalid time format `%s` must be one of: %s", timestampStr, strings.Join(t.layouts, ","))
+		ts, err := t.parse(timestampStr)
+		if err != nil {
+			return err
 		}
+		out = append(out, ts)
 	}
 	*t.value = out
 	return nil
 }
 
+// GetSlice formats the timestamps with the first layout.
 func (t *TimestampSliceValue) GetSlice() []string {
 	out := make([]string, len(*t.value))
 	for i, ts := range *t.value {
 		if ts != nil {
-			t := ts.AsTime()
-			if !t.IsZero() {
-				out[i] = ts.AsTime().Format(time.RFC3339Nano)
+			tm := ts.AsTime()
+			if !tm.IsZero() {
+				out[i] = utils.FormatTime(tm, t.layouts, t.loc)
 			}
 		}
 	}
@@ -92,19 +96,25 @@ func (t *TimestampSliceValue) Type() string {
 
 // String defines a "native" format for this timestamp slice flag value.
 func (t *TimestampSliceValue) String() string {
-	timestampStrSlice := make([]string, len(*t.value))
-	for i, ts := range *t.value {
-		if ts != nil {
-			t := ts.AsTime()
-			if !t.IsZero() {
-				timestampStrSlice[i] = ts.AsTime().Format(time.RFC3339Nano)
-			}
-		}
-	}
-	out, _ := utils.WriteAsCSV(timestampStrSlice)
+	out, _ := utils.WriteAsCSV(t.GetSlice())
 	return "[" + out + "]"
 }
 
-func TimestampSlice(v *[]*timestamppb.Timestamp, formats []string) *TimestampSliceValue {
-	return &TimestampSliceValue{value: v, layouts: formats}
+func TimestampSlice(v *[]*timestamppb.Timestamp, formats []string, opts ...SliceOption) *TimestampSliceValue {
+	return &TimestampSliceValue{value: v, layouts: formats, sliceOptions: newSliceOptions(opts)}
+}
+
+// TimestampSliceIn is like TimestampSlice, but interprets the values without an offset and
+// formats the values in the IANA time zone with the given name.
+func TimestampSliceIn(v *[]*timestamppb.Timestamp, formats []string, timezone string, opts ...SliceOption) *TimestampSliceValue {
+	loc, err := utils.LoadLocation(timezone)
+	return &TimestampSliceValue{value: v, layouts: formats, loc: loc, err: err, sliceOptions: newSliceOptions(opts)}
+}
+
+func timestampKey(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().Format(time.RFC3339Nano)
+}
+
+func timestampLess(a, b *timestamppb.Timestamp) bool {
+	return a.AsTime().Before(b.AsTime())
 }
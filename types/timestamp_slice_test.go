@@ -34,12 +34,12 @@ func TestTimestampSliceValue_String(t *testing.T) {
 			expected: "[2023-12-25T10:30:00Z,2023-12-26T15:45:30Z]",
 		},
 		{
-			name: "timestamps with nanoseconds",
+			name: "timestamps with nanoseconds truncated by the first format",
 			input: []*timestamppb.Timestamp{
 				timestamppb.New(time.Date(2023, 12, 25, 10, 30, 0, 123456789, time.UTC)),
 				timestamppb.New(time.Date(2023, 12, 26, 15, 45, 30, 987654321, time.UTC)),
 			},
-			expected: "[2023-12-25T10:30:00.123456789Z,2023-12-26T15:45:30.987654321Z]",
+			expected: "[2023-12-25T10:30:00Z,2023-12-26T15:45:30Z]",
 		},
 		{
 			name: "nil timestamp in slice",
@@ -103,11 +103,10 @@ func TestTimestampSliceValue_Set(t *testing.T) {
 			errorMessage: "invalid time format `invalid-timestamp` must be one of: 2006-01-02T15:04:05Z07:00",
 		},
 		{
-			name:         "empty string",
-			formats:      []string{time.RFC3339},
-			input:        "",
-			wantErr:      true,
-			errorMessage: "invalid time format `` must be one of: 2006-01-02T15:04:05Z07:00",
+			name:      "empty string clears the list",
+			formats:   []string{time.RFC3339},
+			input:     "",
+			wantTimes: []time.Time{},
 		},
 	}
 
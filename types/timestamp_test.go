@@ -16,7 +16,7 @@ func TestTimestampValue_String(t *testing.T) {
 		expected string
 	}{
 		{
-			name:     "RFC3339Nano format (default)",
+			name:     "RFC3339 format",
 			format:   time.RFC3339,
 			warpTime: time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC),
 			expected: "2023-12-25T10:30:00Z",
@@ -25,13 +25,13 @@ func TestTimestampValue_String(t *testing.T) {
 			name:     "RFC1123 format",
 			format:   time.RFC1123,
 			warpTime: time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC),
-			expected: "2023-12-25T10:30:00Z",
+			expected: "Mon, 25 Dec 2023 10:30:00 UTC",
 		},
 		{
 			name:     "RFC822 format",
 			format:   time.RFC822,
 			warpTime: time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC),
-			expected: "2023-12-25T10:30:00Z",
+			expected: "25 Dec 23 10:30 UTC",
 		},
 	}
 
@@ -253,3 +253,84 @@ func TestTimestamp_ConstructorNil(t *testing.T) {
 		t.Errorf("Timestamp(nil).layouts = %v, want %v", ts.layouts, time.RFC3339)
 	}
 }
+
+func TestTimestampValue_SetRelative(t *testing.T) {
+	var wrap timestamppb.Timestamp
+	ts := Timestamp(&wrap, []string{"RFC3339"})
+
+	before := time.Now()
+	if err := ts.Set("now-15m"); err != nil {
+		t.Fatalf("Set(now-15m) unexpected error: %v", err)
+	}
+	if got := wrap.AsTime(); got.Before(before.Add(-15*time.Minute-time.Second)) || got.After(time.Now().Add(-15*time.Minute)) {
+		t.Errorf("Set(now-15m) = %v, want about %v", got, before.Add(-15*time.Minute))
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"today":         today,
+		"yesterday+6h":  today.AddDate(0, 0, -1).Add(6 * time.Hour),
+		"tomorrow":      today.AddDate(0, 0, 1),
+		"Today - 1d12h": today.Add(-36 * time.Hour),
+	}
+	for input, expected := range tests {
+		if err := ts.Set(input); err != nil {
+			t.Fatalf("Set(%q) unexpected error: %v", input, err)
+		}
+		// Tolerate crossing midnight between computing the expectation and Set
+		if got := wrap.AsTime(); !got.Equal(expected) && !got.Equal(expected.AddDate(0, 0, 1)) {
+			t.Errorf("Set(%q) = %v, want %v", input, got, expected)
+		}
+	}
+
+	if err := ts.Set("now+abc"); err == nil {
+		t.Error("Set(now+abc) expected error")
+	}
+}
+
+func TestTimestampValue_SetEpoch(t *testing.T) {
+	var wrap timestamppb.Timestamp
+	ts := Timestamp(&wrap, []string{"RFC3339"})
+
+	if err := ts.Set("1700000000"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wrap.Seconds != 1700000000 || wrap.Nanos != 0 {
+		t.Errorf("Set(epoch seconds) = %v", wrap.AsTime())
+	}
+
+	if err := ts.Set("1700000000123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wrap.Seconds != 1700000000 || wrap.Nanos != 123000000 {
+		t.Errorf("Set(epoch milliseconds) = %v", wrap.AsTime())
+	}
+}
+
+func TestTimestampIn(t *testing.T) {
+	var wrap timestamppb.Timestamp
+	ts := TimestampIn(&wrap, []string{"DateTime", "RFC3339"}, "Asia/Shanghai")
+
+	if err := ts.Set("2023-12-25 08:00:00"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := wrap.AsTime(), time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Set() = %v, want %v", got, want)
+	}
+	if got := ts.String(); got != "2023-12-25 08:00:00" {
+		t.Errorf("String() = %q, want %q", got, "2023-12-25 08:00:00")
+	}
+
+	// Values with an explicit offset keep it
+	if err := ts.Set("2023-12-25T08:00:00Z"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := ts.String(); got != "2023-12-25 16:00:00" {
+		t.Errorf("String() = %q, want %q", got, "2023-12-25 16:00:00")
+	}
+
+	if err := TimestampIn(&wrap, []string{"RFC3339"}, "Invalid/Zone").Set("now"); err == nil {
+		t.Error("expected error for an unknown time zone")
+	}
+}
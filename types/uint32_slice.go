@@ -0,0 +1,59 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ pflag.Value = (*UInt32SliceValue)(nil)
+
+type UInt32SliceValue struct {
+	value   *[]*wrapperspb.UInt32Value
+	changed bool
+	sliceOptions
+}
+
+// Set converts, and assigns, the comma-separated uint32 argument string representation as the []*wrapperspb.UInt32Value value of this flag.
+// If Set is called on a flag that already has a []*wrapperspb.UInt32Value assigned, the newly converted values will be appended.
+// A Splitter configured with WithSplitter replaces the comma separation.
+func (s *UInt32SliceValue) Set(val string) error {
+	ss, err := s.split(val, splitComma)
+	if err != nil {
+		return err
+	}
+	out := make([]*wrapperspb.UInt32Value, len(ss))
+	for i, d := range ss {
+		var temp32 uint64
+		temp32, err = strconv.ParseUint(strings.TrimSpace(d), 10, 32)
+		if err != nil {
+			return err
+		}
+		out[i] = wrapperspb.UInt32(uint32(temp32))
+	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, wrapperKey[uint32, *wrapperspb.UInt32Value], wrapperLess[uint32, *wrapperspb.UInt32Value])
+	s.changed = true
+	return nil
+}
+
+// Type returns a string that uniquely represents this flag's type.
+func (s *UInt32SliceValue) Type() string {
+	return "uint32SliceValue"
+}
+
+// String defines a "native" format for this uint32 slice flag value.
+func (s *UInt32SliceValue) String() string {
+	uint32StrSlice := make([]string, len(*s.value))
+	for i, v := range *s.value {
+		uint32StrSlice[i] = strconv.FormatUint(uint64(v.Value), 10)
+	}
+	out := s.join(uint32StrSlice)
+
+	return "[" + out + "]"
+}
+
+func UInt32Slice(v *[]*wrapperspb.UInt32Value, opts ...SliceOption) *UInt32SliceValue {
+	return &UInt32SliceValue{value: v, sliceOptions: newSliceOptions(opts)}
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUInt32SliceValue_Set(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []uint32
+		wantErr  bool
+	}{
+		{
+			name:     "single value",
+			input:    "42",
+			expected: []uint32{42},
+		},
+		{
+			name:     "values with spaces",
+			input:    " 1 , 2 , 3 ",
+			expected: []uint32{1, 2, 3},
+		},
+		{
+			name:     "max uint32 value",
+			input:    "4294967295",
+			expected: []uint32{4294967295},
+		},
+		{
+			name:    "overflows uint32",
+			input:   "4294967296",
+			wantErr: true,
+		},
+		{
+			name:    "negative value",
+			input:   "-1",
+			wantErr: true,
+		},
+		{
+			name:    "invalid integer",
+			input:   "1,abc",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var slice []*wrapperspb.UInt32Value
+			err := UInt32Slice(&slice).Set(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UInt32SliceValue.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(slice) != len(tt.expected) {
+				t.Fatalf("UInt32SliceValue.Set() length = %v, want %v", len(slice), len(tt.expected))
+			}
+			for i, expected := range tt.expected {
+				if slice[i].Value != expected {
+					t.Errorf("UInt32SliceValue.Set()[%d] = %v, want %v", i, slice[i].Value, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestUInt32SliceValue_Append(t *testing.T) {
+	slice := []*wrapperspb.UInt32Value{wrapperspb.UInt32(9)}
+	isv := UInt32Slice(&slice)
+
+	// The first Set replaces the default value, later calls append.
+	if err := isv.Set("1,2"); err != nil {
+		t.Fatalf("UInt32SliceValue.Set() error = %v", err)
+	}
+	if err := isv.Set("3"); err != nil {
+		t.Fatalf("UInt32SliceValue.Set() error = %v", err)
+	}
+	if isv.String() != "[1,2,3]" {
+		t.Errorf("UInt32SliceValue.String() = %v, want %v", isv.String(), "[1,2,3]")
+	}
+	if isv.Type() != "uint32SliceValue" {
+		t.Errorf("UInt32SliceValue.Type() = %v, want %v", isv.Type(), "uint32SliceValue")
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ pflag.Value = (*UInt64SliceValue)(nil)
+
+type UInt64SliceValue struct {
+	value   *[]*wrapperspb.UInt64Value
+	changed bool
+	sliceOptions
+}
+
+func (s *UInt64SliceValue) Set(val string) error {
+	ss, err := s.split(val, splitComma)
+	if err != nil {
+		return err
+	}
+	out := make([]*wrapperspb.UInt64Value, len(ss))
+	for i, d := range ss {
+		var temp uint64
+		temp, err = strconv.ParseUint(strings.TrimSpace(d), 10, 64)
+		if err != nil {
+			return err
+		}
+		out[i] = wrapperspb.UInt64(temp)
+	}
+	*s.value = mergeSlice(&s.sliceOptions, !s.changed, *s.value, out, wrapperKey[uint64, *wrapperspb.UInt64Value], wrapperLess[uint64, *wrapperspb.UInt64Value])
+	s.changed = true
+	return nil
+}
+
+// Type returns a string that uniquely represents this flag's type.
+func (s *UInt64SliceValue) Type() string {
+	return "uint64SliceValue"
+}
+
+// String defines a "native" format for this uint64 slice flag value.
+func (s *UInt64SliceValue) String() string {
+	uint64StrSlice := make([]string, len(*s.value))
+	for i, v := range *s.value {
+		uint64StrSlice[i] = strconv.FormatUint(v.Value, 10)
+	}
+	out := s.join(uint64StrSlice)
+
+	return "[" + out + "]"
+}
+
+func UInt64Slice(v *[]*wrapperspb.UInt64Value, opts ...SliceOption) *UInt64SliceValue {
+	return &UInt64SliceValue{value: v, sliceOptions: newSliceOptions(opts)}
+}
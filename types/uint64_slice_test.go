@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUInt64SliceValue_Set(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []uint64
+		wantErr  bool
+	}{
+		{
+			name:     "single value",
+			input:    "42",
+			expected: []uint64{42},
+		},
+		{
+			name:     "values with spaces",
+			input:    " 1 , 2 , 3 ",
+			expected: []uint64{1, 2, 3},
+		},
+		{
+			name:     "max uint64 value",
+			input:    "18446744073709551615",
+			expected: []uint64{18446744073709551615},
+		},
+		{
+			name:    "overflows uint64",
+			input:   "18446744073709551616",
+			wantErr: true,
+		},
+		{
+			name:    "negative value",
+			input:   "-1",
+			wantErr: true,
+		},
+		{
+			name:    "invalid integer",
+			input:   "1,abc",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var slice []*wrapperspb.UInt64Value
+			err := UInt64Slice(&slice).Set(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UInt64SliceValue.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(slice) != len(tt.expected) {
+				t.Fatalf("UInt64SliceValue.Set() length = %v, want %v", len(slice), len(tt.expected))
+			}
+			for i, expected := range tt.expected {
+				if slice[i].Value != expected {
+					t.Errorf("UInt64SliceValue.Set()[%d] = %v, want %v", i, slice[i].Value, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestUInt64SliceValue_Append(t *testing.T) {
+	slice := []*wrapperspb.UInt64Value{wrapperspb.UInt64(9)}
+	isv := UInt64Slice(&slice)
+
+	// The first Set replaces the default value, later calls append.
+	if err := isv.Set("1,2"); err != nil {
+		t.Fatalf("UInt64SliceValue.Set() error = %v", err)
+	}
+	if err := isv.Set("3"); err != nil {
+		t.Fatalf("UInt64SliceValue.Set() error = %v", err)
+	}
+	if isv.String() != "[1,2,3]" {
+		t.Errorf("UInt64SliceValue.String() = %v, want %v", isv.String(), "[1,2,3]")
+	}
+	if isv.Type() != "uint64SliceValue" {
+		t.Errorf("UInt64SliceValue.Type() = %v, want %v", isv.Type(), "uint64SliceValue")
+	}
+}
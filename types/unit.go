@@ -0,0 +1,118 @@
+package types
+
+import (
+	"github.com/kunstack/protoc-gen-flags/utils"
+	"github.com/spf13/pflag"
+)
+
+// Unit selects the suffixes accepted by a UnitValue.
+type Unit = utils.Unit
+
+const (
+	UnitNone    = utils.UnitNone
+	UnitBytes   = utils.UnitBytes
+	UnitSI      = utils.UnitSI
+	UnitPercent = utils.UnitPercent
+)
+
+var _ pflag.Value = (*UnitValue[int64])(nil)
+
+// UnitValue is a pflag.Value implementation for numeric fields accepting unit suffixes,
+// such as "512MiB" for byte sizes, "10k" for SI quantities or "80%" for percentages.
+// String prints the value with the largest unit that represents it exactly.
+type UnitValue[T int32 | int64 | uint32 | uint64 | float32 | float64] struct {
+	value *T
+	unit  Unit
+}
+
+func (u *UnitValue[T]) String() string {
+	if u == nil || u.value == nil {
+		return ""
+	}
+	switch v := any(u.value).(type) {
+	case *int32:
+		return utils.FormatUnitInt(int64(*v), u.unit)
+	case *int64:
+		return utils.FormatUnitInt(*v, u.unit)
+	case *uint32:
+		return utils.FormatUnitUint(uint64(*v), u.unit)
+	case *uint64:
+		return utils.FormatUnitUint(*v, u.unit)
+	case *float32:
+		return utils.FormatUnitFloat(float64(*v), u.unit, 32)
+	case *float64:
+		return utils.FormatUnitFloat(*v, u.unit, 64)
+	}
+	return ""
+}
+
+func (u *UnitValue[T]) Set(s string) error {
+	switch v := any(u.value).(type) {
+	case *int32:
+		n, err := utils.ParseUnitInt(s, u.unit, 32)
+		if err != nil {
+			return err
+		}
+		*v = int32(n)
+	case *int64:
+		n, err := utils.ParseUnitInt(s, u.unit, 64)
+		if err != nil {
+			return err
+		}
+		*v = n
+	case *uint32:
+		n, err := utils.ParseUnitUint(s, u.unit, 32)
+		if err != nil {
+			return err
+		}
+		*v = uint32(n)
+	case *uint64:
+		n, err := utils.ParseUnitUint(s, u.unit, 64)
+		if err != nil {
+			return err
+		}
+		*v = n
+	case *float32:
+		f, err := utils.ParseUnitFloat(s, u.unit, 32)
+		if err != nil {
+			return err
+		}
+		*v = float32(f)
+	case *float64:
+		f, err := utils.ParseUnitFloat(s, u.unit, 64)
+		if err != nil {
+			return err
+		}
+		*v = f
+	}
+	return nil
+}
+
+func (u *UnitValue[T]) Type() string {
+	switch u.unit {
+	case UnitBytes:
+		return "size"
+	case UnitSI:
+		return "quantity"
+	case UnitPercent:
+		return "percent"
+	}
+	switch any(u.value).(type) {
+	case *int32:
+		return "int32"
+	case *int64:
+		return "int64"
+	case *uint32:
+		return "uint32"
+	case *uint64:
+		return "uint64"
+	case *float32:
+		return "float32"
+	}
+	return "float64"
+}
+
+// NumberWithUnit binds v to a flag value accepting the suffixes of unit.
+func NumberWithUnit[T int32 | int64 | uint32 | uint64 | float32 | float64](v *T, unit Unit) *UnitValue[T] {
+	return &UnitValue[T]{value: v, unit: unit}
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestUnitValue_Int64Bytes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		str      string
+		wantErr  bool
+	}{
+		{input: "0", expected: 0, str: "0"},
+		{input: "512", expected: 512, str: "512B"},
+		{input: "1KiB", expected: 1024, str: "1KiB"},
+		{input: "1Ki", expected: 1024, str: "1KiB"},
+		{input: "512mib", expected: 512 << 20, str: "512MiB"},
+		{input: "1.5GiB", expected: 3 << 29, str: "1536MiB"},
+		{input: "2GB", expected: 2000000000, str: "2GB"},
+		{input: "1 kb", expected: 1000, str: "1KB"},
+		{input: "2EB", expected: 2e18, str: "2EB"},
+		{input: "8EiB", wantErr: true},
+		{input: "-1KiB", wantErr: true},
+		{input: "0.5B", wantErr: true},
+		{input: "10XB", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var v int64
+			u := NumberWithUnit(&v, UnitBytes)
+			err := u.Set(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if v != tt.expected {
+				t.Errorf("Set(%q) = %d, want %d", tt.input, v, tt.expected)
+			}
+			if got := u.String(); got != tt.str {
+				t.Errorf("String() = %q, want %q", got, tt.str)
+			}
+		})
+	}
+}
+
+func TestUnitValue_SI(t *testing.T) {
+	var n uint32
+	u := NumberWithUnit(&n, UnitSI)
+	for input, expected := range map[string]uint32{"10k": 10000, "10K": 10000, "3M": 3000000, "1.5k": 1500, "42": 42} {
+		if err := u.Set(input); err != nil {
+			t.Fatalf("Set(%q) unexpected error: %v", input, err)
+		}
+		if n != expected {
+			t.Errorf("Set(%q) = %d, want %d", input, n, expected)
+		}
+	}
+	if err := u.Set("5G"); err == nil {
+		t.Error("Set(\"5G\") expected out of range error for uint32")
+	}
+	if err := u.Set("1.5"); err == nil {
+		t.Error("Set(\"1.5\") expected error for a fractional integer")
+	}
+
+	n = 2000000
+	if got := u.String(); got != "2M" {
+		t.Errorf("String() = %q, want %q", got, "2M")
+	}
+
+	var f float64
+	fu := NumberWithUnit(&f, UnitSI)
+	if err := fu.Set("250m"); err != nil || f != 0.25 {
+		t.Errorf("Set(\"250m\") = %v, %v, want 0.25", f, err)
+	}
+}
+
+func TestUnitValue_Percent(t *testing.T) {
+	var f float32
+	u := NumberWithUnit(&f, UnitPercent)
+	if err := u.Set("80%"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f != 0.8 {
+		t.Errorf("Set(\"80%%\") = %v, want 0.8", f)
+	}
+	if got := u.String(); got != "80%" {
+		t.Errorf("String() = %q, want %q", got, "80%")
+	}
+	if err := u.Set("0.25"); err != nil || f != 0.25 {
+		t.Errorf("Set(\"0.25\") = %v, %v, want 0.25", f, err)
+	}
+	if err := u.Set("abc%"); err == nil {
+		t.Error("expected error for invalid percentage")
+	}
+}
+
+func TestUnitValue_Type(t *testing.T) {
+	var i int32
+	var f float64
+	tests := []struct {
+		value    pflag.Value
+		expected string
+	}{
+		{NumberWithUnit(&i, UnitBytes), "size"},
+		{NumberWithUnit(&i, UnitSI), "quantity"},
+		{NumberWithUnit(&f, UnitPercent), "percent"},
+		{NumberWithUnit(&i, UnitNone), "int32"},
+	}
+	for _, tt := range tests {
+		if got := tt.value.Type(); got != tt.expected {
+			t.Errorf("Type() = %q, want %q", got, tt.expected)
+		}
+	}
+}
+
+func TestUnitValue_FlagSet(t *testing.T) {
+	var size int64 = 1 << 30
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(NumberWithUnit(&size, UnitBytes), "max-memory", "memory limit")
+
+	if got := fs.Lookup("max-memory").DefValue; got != "1GiB" {
+		t.Errorf("DefValue = %q, want %q", got, "1GiB")
+	}
+	if err := fs.Parse([]string{"--max-memory=256MiB"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if size != 256<<20 {
+		t.Errorf("size = %d, want %d", size, 256<<20)
+	}
+}
+
+func TestUnitValue_ZeroDefault(t *testing.T) {
+	var (
+		size    int64
+		ratio   float32
+		quantum uint32
+	)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(NumberWithUnit(&size, UnitBytes), "max-memory", "memory limit")
+	fs.Var(NumberWithUnit(&ratio, UnitPercent), "ratio", "sampling ratio")
+	fs.Var(NumberWithUnit(&quantum, UnitSI), "quantum", "work quantum")
+
+	if usages := fs.FlagUsages(); strings.Contains(usages, "default") {
+		t.Errorf("FlagUsages() prints zero defaults:\n%s", usages)
+	}
+}
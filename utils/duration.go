@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationUnits = map[string]int64{
+	"ns": int64(time.Nanosecond),
+	"us": int64(time.Microsecond),
+	"µs": int64(time.Microsecond), // U+00B5 micro sign
+	"μs": int64(time.Microsecond), // U+03BC Greek letter mu
+	"ms": int64(time.Millisecond),
+	"s":  int64(time.Second),
+	"m":  int64(time.Minute),
+	"h":  int64(time.Hour),
+	"d":  int64(24 * time.Hour),
+	"w":  int64(7 * 24 * time.Hour),
+}
+
+// formatUnits are the units used by FormatDuration, from the largest.
+var formatUnits = []struct {
+	suffix string
+	unit   time.Duration
+}{
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+	{"ms", time.Millisecond},
+	{"us", time.Microsecond},
+	{"ns", time.Nanosecond},
+}
+
+// isoDateUnits and isoTimeUnits are the designators of the ISO-8601 duration form, in order.
+var (
+	isoDateUnits = []string{"W", "D"}
+	isoTimeUnits = []string{"H", "M", "S"}
+	isoUnits     = map[string]int64{
+		"W": int64(7 * 24 * time.Hour),
+		"D": int64(24 * time.Hour),
+		"H": int64(time.Hour),
+		"M": int64(time.Minute),
+		"S": int64(time.Second),
+	}
+)
+
+var errDurationRange = errors.New("out of range")
+
+// ParseDuration parses a duration string. In addition to the time.ParseDuration syntax such as
+// "1h30m" or "500ms", it accepts the units d (24h) and w (7d), e.g. "30d" or "1w2d12h", and the
+// ISO-8601 form, e.g. "P1DT2H", "PT0.5S" or "P2W". Years and months are rejected as their length
+// varies, and so are durations beyond the range of time.Duration (about 292 years).
+func ParseDuration(s string) (time.Duration, error) {
+	orig := s
+	s = strings.TrimSpace(s)
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	var (
+		total *big.Rat
+		err   error
+	)
+	switch {
+	case s == "0":
+		return 0, nil
+	case s == "":
+		err = errors.New("empty duration")
+	case s[0] == 'P' || s[0] == 'p':
+		total, err = parseISODuration(strings.ToUpper(s[1:]))
+	default:
+		total, err = parseUnitDuration(s)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", orig, err)
+	}
+
+	if neg {
+		total.Neg(total)
+	}
+	// Fractions of a nanosecond are truncated like time.ParseDuration does
+	n := new(big.Int).Quo(total.Num(), total.Denom())
+	if !n.IsInt64() {
+		return 0, fmt.Errorf("invalid duration %q: %w", orig, errDurationRange)
+	}
+	return time.Duration(n.Int64()), nil
+}
+
+// parseUnitDuration parses a sequence of decimal numbers with unit suffixes, e.g. "1d12h".
+func parseUnitDuration(s string) (*big.Rat, error) {
+	total := new(big.Rat)
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if i == 0 {
+			return nil, fmt.Errorf("expected a number at %q", s)
+		}
+		if i < 0 {
+			return nil, fmt.Errorf("missing unit after %q", s)
+		}
+		num := s[:i]
+		s = s[i:]
+		j := strings.IndexFunc(s, func(r rune) bool { return (r >= '0' && r <= '9') || r == '.' })
+		if j < 0 {
+			j = len(s)
+		}
+		unit, ok := durationUnits[s[:j]]
+		if !ok {
+			return nil, fmt.Errorf("unknown unit %q", s[:j])
+		}
+		s = s[j:]
+		if err := addDuration(total, num, unit); err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
+// parseISODuration parses the part of an ISO-8601 duration following the leading P.
+func parseISODuration(s string) (*big.Rat, error) {
+	date, clock := s, ""
+	if i := strings.IndexByte(s, 'T'); i >= 0 {
+		date, clock = s[:i], s[i+1:]
+		if clock == "" {
+			return nil, errors.New("missing time components after T")
+		}
+	}
+	if date == "" && clock == "" {
+		return nil, errors.New("missing components after P")
+	}
+	total := new(big.Rat)
+	for _, part := range []struct {
+		s     string
+		units []string
+	}{{date, isoDateUnits}, {clock, isoTimeUnits}} {
+		next := 0
+		s := part.s
+		for s != "" {
+			i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+			if i <= 0 {
+				return nil, fmt.Errorf("expected a number at %q", s)
+			}
+			designator := s[i : i+1]
+			if (designator == "Y" || designator == "M") && part.s == date {
+				return nil, errors.New("years and months are not supported")
+			}
+			k := next
+			for k < len(part.units) && part.units[k] != designator {
+				k++
+			}
+			if k == len(part.units) {
+				return nil, fmt.Errorf("unexpected designator %q", designator)
+			}
+			next = k + 1
+			if err := addDuration(total, strings.ReplaceAll(s[:i], ",", "."), isoUnits[designator]); err != nil {
+				return nil, err
+			}
+			s = s[i+1:]
+		}
+	}
+	return total, nil
+}
+
+// addDuration adds the decimal num of unit nanoseconds to total.
+func addDuration(total *big.Rat, num string, unit int64) error {
+	v, ok := new(big.Rat).SetString(num)
+	if !ok || strings.Count(num, ".") > 1 || num == "." {
+		return fmt.Errorf("invalid number %q", num)
+	}
+	total.Add(total, v.Mul(v, new(big.Rat).SetInt64(unit)))
+	if total.Cmp(new(big.Rat).SetUint64(math.MaxInt64+1)) > 0 {
+		return errDurationRange
+	}
+	return nil
+}
+
+// FormatDuration formats d as a whole number of the largest unit that represents it exactly,
+// e.g. "2w", "30d", "90m" or "1500ms". The result is accepted by ParseDuration.
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+	for _, u := range formatUnits {
+		if d%u.unit == 0 {
+			return strconv.FormatInt(int64(d/u.unit), 10) + u.suffix
+		}
+	}
+	return d.String()
+}
@@ -5,6 +5,10 @@ package utils
 import (
 	"encoding/base64"
 	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
 )
 
 // MustDecodeBase64 decodes a base64-encoded string and returns the resulting bytes.
@@ -48,3 +52,71 @@ func MustDecodeHex(d string) []byte {
 	}
 	return val
 }
+
+// BytesEncoding is the text encoding of the command-line values of a bytes flag.
+type BytesEncoding int
+
+const (
+	EncodingBase64       BytesEncoding = iota // EncodingBase64 is standard, padded base64.
+	EncodingHex                               // EncodingHex is hexadecimal, case-insensitive.
+	EncodingBase64URL                         // EncodingBase64URL is padded base64 with the URL alphabet.
+	EncodingBase64Raw                         // EncodingBase64Raw is standard base64 without padding.
+	EncodingBase64RawURL                      // EncodingBase64RawURL is base64 with the URL alphabet and no padding, as used by JWTs.
+	EncodingUTF8                              // EncodingUTF8 takes the UTF-8 text as is.
+	EncodingFile                              // EncodingFile reads the raw contents of the file at the given path.
+)
+
+func (e BytesEncoding) base64() *base64.Encoding {
+	switch e {
+	case EncodingBase64URL:
+		return base64.URLEncoding
+	case EncodingBase64Raw:
+		return base64.RawStdEncoding
+	case EncodingBase64RawURL:
+		return base64.RawURLEncoding
+	}
+	return base64.StdEncoding
+}
+
+// DecodeBytes decodes s with the encoding e. Surrounding whitespace is ignored except for
+// EncodingUTF8, which requires valid UTF-8. For EncodingFile, s is a path expanded like ExpandPath.
+func DecodeBytes(s string, e BytesEncoding) ([]byte, error) {
+	switch e {
+	case EncodingHex:
+		return hex.DecodeString(strings.TrimSpace(s))
+	case EncodingUTF8:
+		if !utf8.ValidString(s) {
+			return nil, fmt.Errorf("invalid UTF-8 text %q", s)
+		}
+		return []byte(s), nil
+	case EncodingFile:
+		path, err := ExpandPath(s)
+		if err != nil {
+			return nil, err
+		}
+		return os.ReadFile(path)
+	}
+	return e.base64().DecodeString(strings.TrimSpace(s))
+}
+
+// EncodeBytes encodes b with the encoding e. EncodingFile values have no text form and are
+// encoded as standard base64.
+func EncodeBytes(b []byte, e BytesEncoding) string {
+	switch e {
+	case EncodingHex:
+		return strings.ToUpper(hex.EncodeToString(b))
+	case EncodingUTF8:
+		return string(b)
+	}
+	return e.base64().EncodeToString(b)
+}
+
+// MustDecodeBytes is like DecodeBytes but panics on error.
+// It decodes the bytes defaults in the generated code.
+func MustDecodeBytes(s string, e BytesEncoding) []byte {
+	val, err := DecodeBytes(s, e)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// FindMessage returns the descriptor of the message with the full name name, e.g. "pkg.Config",
+// from the global registry of linked protobuf types.
+func FindMessage(name string) (protoreflect.MessageDescriptor, error) {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(name, ".")))
+	if err != nil {
+		return nil, fmt.Errorf("cannot find message %q: %w", name, err)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a message", name)
+	}
+	return md, nil
+}
+
+// ResolveFieldPath checks the field mask path against md and returns it with the proto names of
+// the fields. Path segments may use the proto or the JSON name of the fields; all but the last
+// must be singular message fields.
+func ResolveFieldPath(md protoreflect.MessageDescriptor, path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("empty field mask path")
+	}
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		if md == nil {
+			return "", fmt.Errorf("invalid field mask path %q: %s is not a message field", path, strings.Join(segments[:i], "."))
+		}
+		fd := md.Fields().ByName(protoreflect.Name(segment))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(segment)
+		}
+		if fd == nil {
+			return "", fmt.Errorf("invalid field mask path %q: %s has no field %q", path, md.FullName(), segment)
+		}
+		segments[i] = string(fd.Name())
+		md = nil
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			md = fd.Message()
+		}
+	}
+	return strings.Join(segments, "."), nil
+}
@@ -0,0 +1,291 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Full names of the google.type messages supported by ParseGoogleType.
+const (
+	DateName      protoreflect.FullName = "google.type.Date"
+	TimeOfDayName protoreflect.FullName = "google.type.TimeOfDay"
+	MoneyName     protoreflect.FullName = "google.type.Money"
+	LatLngName    protoreflect.FullName = "google.type.LatLng"
+	DecimalName   protoreflect.FullName = "google.type.Decimal"
+)
+
+var (
+	datePattern      = regexp.MustCompile(`^(\d{4})(?:-(\d{2})(?:-(\d{2}))?)?$`)
+	monthDayPattern  = regexp.MustCompile(`^--(\d{2})-(\d{2})$`)
+	timeOfDayPattern = regexp.MustCompile(`^(\d{1,2}):(\d{2})(?::(\d{2})(?:\.(\d{1,9}))?)?$`)
+	amountPattern    = regexp.MustCompile(`^([+-]?)(\d+)(?:\.(\d{1,9}))?$`)
+	currencyPattern  = regexp.MustCompile(`^[A-Z]{3}$`)
+	decimalPattern   = regexp.MustCompile(`^[+-]?(?:\d+(?:\.\d*)?|\.\d+)(?:[eE][+-]?\d+)?$`)
+)
+
+// GoogleTypeFields holds the field values of a google.type message by field name.
+type GoogleTypeFields map[protoreflect.Name]protoreflect.Value
+
+// ParseGoogleType parses s in the syntax of the google.type message name:
+//
+//   - Date: "2026-10-16", "2026-10" or "2026" for a partial date and "--10-16" without a year
+//   - TimeOfDay: "13:45", "13:45:30" or "13:45:30.5", "24:00" is the end of the day
+//   - Money: "12.50 USD", an amount with up to 9 decimals and an ISO 4217 currency code
+//   - LatLng: "52.52,13.40", latitude then longitude in degrees
+//   - Decimal: "3.14159" or "1.5e-3"
+func ParseGoogleType(name protoreflect.FullName, s string) (GoogleTypeFields, error) {
+	s = strings.TrimSpace(s)
+	switch name {
+	case DateName:
+		return parseDate(s)
+	case TimeOfDayName:
+		return parseTimeOfDay(s)
+	case MoneyName:
+		return parseMoney(s)
+	case LatLngName:
+		return parseLatLng(s)
+	case DecimalName:
+		if !decimalPattern.MatchString(s) {
+			return nil, fmt.Errorf("invalid decimal %q, expected e.g. 3.14159 or 1.5e-3", s)
+		}
+		return GoogleTypeFields{"value": protoreflect.ValueOfString(s)}, nil
+	}
+	return nil, fmt.Errorf("unsupported message type %s", name)
+}
+
+// SetGoogleType parses s with ParseGoogleType according to the type of m and replaces the fields
+// of m with the result. m is left unchanged on error.
+func SetGoogleType(m protoreflect.Message, s string) error {
+	values, err := ParseGoogleType(m.Descriptor().FullName(), s)
+	if err != nil {
+		return err
+	}
+	fields := m.Descriptor().Fields()
+	for name := range values {
+		if fields.ByName(name) == nil {
+			return fmt.Errorf("%s has no field %q", m.Descriptor().FullName(), name)
+		}
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		m.Clear(fd)
+		return true
+	})
+	for name, value := range values {
+		m.Set(fields.ByName(name), value)
+	}
+	return nil
+}
+
+// FormatGoogleType formats m in the syntax accepted by ParseGoogleType. It returns an empty
+// string when no field of m is set.
+func FormatGoogleType(m protoreflect.Message) string {
+	empty := true
+	m.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		empty = false
+		return false
+	})
+	if empty {
+		return ""
+	}
+
+	fields := m.Descriptor().Fields()
+	get := func(name protoreflect.Name) protoreflect.Value {
+		if fd := fields.ByName(name); fd != nil {
+			return m.Get(fd)
+		}
+		return protoreflect.Value{}
+	}
+	getInt := func(name protoreflect.Name) int64 {
+		if v := get(name); v.IsValid() {
+			return v.Int()
+		}
+		return 0
+	}
+
+	switch m.Descriptor().FullName() {
+	case DateName:
+		year, month, day := getInt("year"), getInt("month"), getInt("day")
+		switch {
+		case year == 0:
+			return fmt.Sprintf("--%02d-%02d", month, day)
+		case month == 0:
+			return fmt.Sprintf("%04d", year)
+		case day == 0:
+			return fmt.Sprintf("%04d-%02d", year, month)
+		}
+		return fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+	case TimeOfDayName:
+		out := fmt.Sprintf("%02d:%02d", getInt("hours"), getInt("minutes"))
+		if seconds, nanos := getInt("seconds"), getInt("nanos"); seconds != 0 || nanos != 0 {
+			out += fmt.Sprintf(":%02d", seconds) + formatNanos(nanos)
+		}
+		return out
+	case MoneyName:
+		units, nanos := getInt("units"), getInt("nanos")
+		sign := ""
+		if units < 0 || nanos < 0 {
+			sign = "-"
+		}
+		amount := strconv.FormatUint(uint64(units), 10)
+		if units < 0 {
+			amount = strconv.FormatUint(uint64(-units), 10)
+		}
+		if nanos < 0 {
+			nanos = -nanos
+		}
+		return sign + amount + formatNanos(nanos) + " " + get("currency_code").String()
+	case LatLngName:
+		return strconv.FormatFloat(get("latitude").Float(), 'f', -1, 64) + "," +
+			strconv.FormatFloat(get("longitude").Float(), 'f', -1, 64)
+	case DecimalName:
+		return get("value").String()
+	}
+	return ""
+}
+
+// MustParseGoogleType parses s into m with SetGoogleType and returns m, panicking on error.
+// It decodes the defaults of the google.type fields in the generated code.
+func MustParseGoogleType[T proto.Message](s string, m T) T {
+	if err := SetGoogleType(m.ProtoReflect(), s); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func parseDate(s string) (GoogleTypeFields, error) {
+	var year, month, day int
+	var hasMonth, hasDay bool
+	if match := datePattern.FindStringSubmatch(s); match != nil {
+		year, _ = strconv.Atoi(match[1])
+		month, _ = strconv.Atoi("0" + match[2])
+		day, _ = strconv.Atoi("0" + match[3])
+		hasMonth, hasDay = match[2] != "", match[3] != ""
+		if year == 0 {
+			return nil, fmt.Errorf("invalid date %q: year must be between 1 and 9999", s)
+		}
+	} else if match := monthDayPattern.FindStringSubmatch(s); match != nil {
+		month, _ = strconv.Atoi(match[1])
+		day, _ = strconv.Atoi(match[2])
+		hasMonth, hasDay = true, true
+	} else {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD, YYYY-MM, YYYY or --MM-DD", s)
+	}
+
+	if hasMonth && (month < 1 || month > 12) {
+		return nil, fmt.Errorf("invalid date %q: month must be between 1 and 12", s)
+	}
+	if hasDay {
+		// Without a year, February 29 is accepted.
+		y := year
+		if y == 0 {
+			y = 2000
+		}
+		if days := time.Date(y, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day(); day < 1 || day > days {
+			return nil, fmt.Errorf("invalid date %q: day must be between 1 and %d", s, days)
+		}
+	}
+	return GoogleTypeFields{
+		"year":  protoreflect.ValueOfInt32(int32(year)),
+		"month": protoreflect.ValueOfInt32(int32(month)),
+		"day":   protoreflect.ValueOfInt32(int32(day)),
+	}, nil
+}
+
+func parseTimeOfDay(s string) (GoogleTypeFields, error) {
+	match := timeOfDayPattern.FindStringSubmatch(s)
+	if match == nil {
+		return nil, fmt.Errorf("invalid time of day %q, expected HH:MM, HH:MM:SS or HH:MM:SS.fraction", s)
+	}
+	hours, _ := strconv.ParseInt(match[1], 10, 32)
+	minutes, _ := strconv.ParseInt(match[2], 10, 32)
+	seconds, _ := strconv.ParseInt("0"+match[3], 10, 32)
+	nanos := parseNanos(match[4])
+	switch {
+	case hours == 24 && (minutes != 0 || seconds != 0 || nanos != 0):
+		return nil, fmt.Errorf("invalid time of day %q: only 24:00 is allowed after 23:59", s)
+	case hours > 24:
+		return nil, fmt.Errorf("invalid time of day %q: hours must be between 0 and 24", s)
+	case minutes > 59:
+		return nil, fmt.Errorf("invalid time of day %q: minutes must be between 0 and 59", s)
+	case seconds > 60:
+		// 60 allows leap seconds.
+		return nil, fmt.Errorf("invalid time of day %q: seconds must be between 0 and 60", s)
+	}
+	return GoogleTypeFields{
+		"hours":   protoreflect.ValueOfInt32(int32(hours)),
+		"minutes": protoreflect.ValueOfInt32(int32(minutes)),
+		"seconds": protoreflect.ValueOfInt32(int32(seconds)),
+		"nanos":   protoreflect.ValueOfInt32(nanos),
+	}, nil
+}
+
+func parseMoney(s string) (GoogleTypeFields, error) {
+	parts := strings.Fields(s)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid money %q, expected an amount and a currency code such as 12.50 USD", s)
+	}
+	match := amountPattern.FindStringSubmatch(parts[0])
+	if match == nil {
+		return nil, fmt.Errorf("invalid money %q: invalid amount %q, expected at most 9 decimals", s, parts[0])
+	}
+	code := strings.ToUpper(parts[1])
+	if !currencyPattern.MatchString(code) {
+		return nil, fmt.Errorf("invalid money %q: invalid currency code %q, expected 3 letters such as USD", s, parts[1])
+	}
+	units, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid money %q: amount out of range", s)
+	}
+	nanos := parseNanos(match[3])
+	if match[1] == "-" {
+		units, nanos = -units, -nanos
+	}
+	return GoogleTypeFields{
+		"currency_code": protoreflect.ValueOfString(code),
+		"units":         protoreflect.ValueOfInt64(units),
+		"nanos":         protoreflect.ValueOfInt32(nanos),
+	}, nil
+}
+
+func parseLatLng(s string) (GoogleTypeFields, error) {
+	lat, lng, ok := strings.Cut(s, ",")
+	if !ok {
+		return nil, fmt.Errorf("invalid lat/lng %q, expected latitude,longitude such as 52.52,13.40", s)
+	}
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	if err != nil || math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return nil, fmt.Errorf("invalid lat/lng %q: latitude must be a number between -90 and 90", s)
+	}
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(lng), 64)
+	if err != nil || math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return nil, fmt.Errorf("invalid lat/lng %q: longitude must be a number between -180 and 180", s)
+	}
+	return GoogleTypeFields{
+		"latitude":  protoreflect.ValueOfFloat64(latitude),
+		"longitude": protoreflect.ValueOfFloat64(longitude),
+	}, nil
+}
+
+// parseNanos converts up to 9 decimals to nanoseconds or nano units.
+func parseNanos(decimals string) int32 {
+	if decimals == "" {
+		return 0
+	}
+	n, _ := strconv.ParseInt((decimals + "000000000")[:9], 10, 32)
+	return int32(n)
+}
+
+// formatNanos formats nanos as decimals with the trailing zeros removed, or "" when 0.
+func formatNanos(nanos int64) string {
+	if nanos == 0 {
+		return ""
+	}
+	return "." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+}
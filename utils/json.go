@@ -0,0 +1,16 @@
+package utils
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// MustUnmarshalJSON parses the JSON s into m with protojson and returns m, panicking on error.
+// It decodes the JSON defaults of google.protobuf.Struct, Value and ListValue fields in the
+// generated code.
+func MustUnmarshalJSON[T proto.Message](s string, m T) T {
+	if err := protojson.Unmarshal([]byte(s), m); err != nil {
+		panic(err)
+	}
+	return m
+}
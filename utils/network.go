@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// NormalizeIP validates an IPv4 or IPv6 address and returns its canonical form,
+// e.g. "2001:db8::1" for "2001:DB8:0:0:0:0:0:1".
+func NormalizeIP(s string) (string, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(s))
+	if err != nil {
+		return "", fmt.Errorf("invalid IP address %q", s)
+	}
+	return addr.String(), nil
+}
+
+// NormalizeIPv4 validates an IPv4 address and returns its canonical form.
+func NormalizeIPv4(s string) (string, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(s))
+	if err != nil || !addr.Is4() {
+		return "", fmt.Errorf("invalid IPv4 address %q", s)
+	}
+	return addr.String(), nil
+}
+
+// NormalizeIPv6 validates an IPv6 address and returns its canonical form.
+func NormalizeIPv6(s string) (string, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(s))
+	if err != nil || !addr.Is6() {
+		return "", fmt.Errorf("invalid IPv6 address %q", s)
+	}
+	return addr.String(), nil
+}
+
+// NormalizeCIDR validates a CIDR prefix and returns it with the host bits cleared,
+// e.g. "10.0.0.0/8" for "10.1.2.3/8".
+func NormalizeCIDR(s string) (string, error) {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(s))
+	if err != nil {
+		return "", fmt.Errorf("invalid CIDR %q", s)
+	}
+	return prefix.Masked().String(), nil
+}
+
+// NormalizeHostPort validates a "host:port" address. The host may be a hostname, an IP address
+// (IPv6 in brackets) or empty, e.g. ":8080"; the port must be numeric.
+func NormalizeHostPort(s string) (string, error) {
+	host, port, err := net.SplitHostPort(strings.TrimSpace(s))
+	if err != nil {
+		var addrErr *net.AddrError
+		if errors.As(err, &addrErr) {
+			return "", fmt.Errorf("invalid host:port %q: %s", s, addrErr.Err)
+		}
+		return "", fmt.Errorf("invalid host:port %q", s)
+	}
+	n, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return "", fmt.Errorf("invalid host:port %q: invalid port %q", s, port)
+	}
+	port = strconv.FormatUint(n, 10)
+	if host != "" {
+		if addr, err := netip.ParseAddr(host); err == nil {
+			host = addr.String()
+		} else if host, err = NormalizeHostname(host); err != nil {
+			return "", fmt.Errorf("invalid host:port %q: %w", s, err)
+		}
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// NormalizeURL validates an absolute URL with a scheme and a host, and returns it with
+// the scheme and host lower-cased.
+func NormalizeURL(s string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", s, errors.Unwrap(err))
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid URL %q: a scheme and a host are required", s)
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	return u.String(), nil
+}
+
+// NormalizeHostname validates an RFC 1123 hostname and returns it lower-cased,
+// without a trailing dot.
+func NormalizeHostname(s string) (string, error) {
+	host := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(s), "."))
+	if host == "" || len(host) > 253 {
+		return "", fmt.Errorf("invalid hostname %q", s)
+	}
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return "", fmt.Errorf("invalid hostname %q", s)
+		}
+		for _, c := range label {
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+				return "", fmt.Errorf("invalid hostname %q", s)
+			}
+		}
+	}
+	return host, nil
+}
+
+// NormalizeEmail validates a bare email address such as "user@example.com" and returns it
+// with the domain lower-cased. Display names are rejected.
+func NormalizeEmail(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Name != "" || addr.Address != s {
+		return "", fmt.Errorf("invalid email address %q", s)
+	}
+	at := strings.LastIndexByte(addr.Address, '@')
+	return addr.Address[:at] + strings.ToLower(addr.Address[at:]), nil
+}
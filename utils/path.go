@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PathCheck is the file system check applied by Path.
+type PathCheck int
+
+const (
+	PathAny          PathCheck = iota // PathAny applies no check.
+	PathMustExist                     // PathMustExist requires the path to exist.
+	PathMustBeFile                    // PathMustBeFile requires the path to exist and not be a directory.
+	PathMustBeDir                     // PathMustBeDir requires the path to be an existing directory.
+	PathMustNotExist                  // PathMustNotExist requires the path not to exist.
+)
+
+// Path resolves and checks the file system paths given to path flags.
+type Path struct {
+	// Absolute makes relative paths absolute against Base, or the working directory when Base is empty.
+	Absolute bool
+	// Base is the directory relative paths are resolved against. It is expanded like the paths
+	// and implies Absolute.
+	Base string
+	// Check is the file system check applied by Normalize.
+	Check PathCheck
+}
+
+// ExpandPath expands a leading "~" to the home directory of the current user and $VAR or ${VAR}
+// references to the values of environment variables. Unset variables expand to "".
+func ExpandPath(s string) (string, error) {
+	if s == "~" || strings.HasPrefix(s, "~/") || strings.HasPrefix(s, "~"+string(os.PathSeparator)) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("cannot expand %q: %w", s, err)
+		}
+		s = home + s[1:]
+	}
+	return os.ExpandEnv(s), nil
+}
+
+// Resolve expands s and makes it absolute as configured. An empty path is returned unchanged.
+func (p Path) Resolve(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	path, err := ExpandPath(s)
+	if err != nil {
+		return "", err
+	}
+	if !p.Absolute && p.Base == "" {
+		return path, nil
+	}
+	if !filepath.IsAbs(path) && p.Base != "" {
+		base, err := ExpandPath(p.Base)
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(base, path)
+	}
+	return filepath.Abs(path)
+}
+
+// Normalize resolves s and applies the configured check. It can be used as a types.StringCheck.
+func (p Path) Normalize(s string) (string, error) {
+	path, err := p.Resolve(s)
+	if err != nil {
+		return "", err
+	}
+	if p.Check == PathAny {
+		return path, nil
+	}
+	info, err := os.Stat(path)
+	switch {
+	case p.Check == PathMustNotExist:
+		if err == nil {
+			return "", fmt.Errorf("path %q already exists", path)
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	case os.IsNotExist(err):
+		return "", fmt.Errorf("path %q does not exist", path)
+	case err != nil:
+		return "", err
+	case p.Check == PathMustBeFile && info.IsDir():
+		return "", fmt.Errorf("path %q is a directory, not a file", path)
+	case p.Check == PathMustBeDir && !info.IsDir():
+		return "", fmt.Errorf("path %q is not a directory", path)
+	}
+	return path, nil
+}
+
+// Default resolves a default value without checking it, returning s unchanged when it cannot be
+// resolved. It evaluates path defaults in the generated code.
+func (p Path) Default(s string) string {
+	path, err := p.Resolve(s)
+	if err != nil {
+		return s
+	}
+	return path
+}
+
+// ReadFileRef resolves a file reference: "@path" is replaced by the contents of the file at path,
+// expanded like ExpandPath, and "-" by the contents of standard input, with a trailing newline
+// trimmed. "@@" escapes a literal leading "@". ok is false when s is not a reference.
+func ReadFileRef(s string) (content string, ok bool, err error) {
+	var data []byte
+	switch {
+	case strings.HasPrefix(s, "@@"):
+		return s[1:], false, nil
+	case s == "-":
+		if data, err = io.ReadAll(os.Stdin); err != nil {
+			return "", true, fmt.Errorf("cannot read standard input: %w", err)
+		}
+	case strings.HasPrefix(s, "@"):
+		var path string
+		if path, err = ExpandPath(s[1:]); err != nil {
+			return "", true, err
+		}
+		if data, err = os.ReadFile(path); err != nil {
+			return "", true, err
+		}
+	default:
+		return s, false, nil
+	}
+	content = strings.TrimSuffix(string(data), "\n")
+	return strings.TrimSuffix(content, "\r"), true, nil
+}
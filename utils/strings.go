@@ -2,6 +2,7 @@ package utils
 
 import (
 	"encoding/csv"
+	"fmt"
 	"strings"
 )
 
@@ -25,3 +26,22 @@ func WriteAsCSV(vals []string) (string, error) {
 	w.Flush()
 	return strings.TrimSuffix(b.String(), "\n"), nil
 }
+
+// Choices restricts string values to a fixed set.
+type Choices struct {
+	// Values are the valid choices.
+	Values []string
+	// CaseInsensitive matches Values ignoring case.
+	CaseInsensitive bool
+}
+
+// Normalize returns the choice matching s as declared in Values, or an error listing the valid
+// choices. It can be used as a types.StringCheck.
+func (c Choices) Normalize(s string) (string, error) {
+	for _, v := range c.Values {
+		if v == s || (c.CaseInsensitive && strings.EqualFold(v, s)) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid choice %q, must be one of: %s", s, strings.Join(c.Values, ", "))
+}
@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -47,3 +51,135 @@ func ParseTimeFormat(format string) string {
 		return format
 	}
 }
+
+// DurationToInt converts d to a whole number of unit that fits an integer of the given bit size.
+// It fails rather than rounding when d is not a multiple of unit.
+func DurationToInt(d, unit time.Duration, bitSize int, unsigned bool) (int64, error) {
+	if d%unit != 0 {
+		return 0, fmt.Errorf("duration %s is not a whole number of %s", d, unit)
+	}
+	n := int64(d / unit)
+	switch {
+	case unsigned && n < 0:
+		return 0, fmt.Errorf("duration %s must not be negative", d)
+	case unsigned && bitSize < 64 && n >= 1<<bitSize,
+		!unsigned && bitSize < 64 && (n >= 1<<(bitSize-1) || n < -1<<(bitSize-1)):
+		return 0, fmt.Errorf("duration %s overflows a %d-bit integer of %s", d, bitSize, unit)
+	}
+	return n, nil
+}
+
+// IntToDuration converts n units to a time.Duration, reporting false when the result overflows.
+func IntToDuration(n int64, unit time.Duration) (time.Duration, bool) {
+	if n > math.MaxInt64/int64(unit) || n < math.MinInt64/int64(unit) {
+		return 0, false
+	}
+	return time.Duration(n) * unit, true
+}
+
+// LoadLocation returns the time zone with the given IANA name, UTC when name is empty.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// relativeBases are the keywords a relative time expression starts with.
+var relativeBases = []string{"now()", "now", "today", "yesterday", "tomorrow"}
+
+// IsRelativeTime reports whether s is a relative time expression such as "now-15m" or "today".
+func IsRelativeTime(s string) bool {
+	_, ok, _ := parseRelativeTime(s, time.UTC, time.Now())
+	return ok
+}
+
+// parseRelativeTime evaluates the relative time expression s at now. ok is false when s is not
+// a relative expression.
+func parseRelativeTime(s string, loc *time.Location, now time.Time) (t time.Time, ok bool, err error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for _, base := range relativeBases {
+		if !strings.HasPrefix(s, base) {
+			continue
+		}
+		offset := strings.TrimSpace(s[len(base):])
+		if offset != "" && offset[0] != '+' && offset[0] != '-' {
+			return time.Time{}, false, nil
+		}
+		now = now.In(loc)
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+		switch base {
+		case "today":
+			t = today
+		case "yesterday":
+			t = today.AddDate(0, 0, -1)
+		case "tomorrow":
+			t = today.AddDate(0, 0, 1)
+		default:
+			t = now
+		}
+		if offset == "" {
+			return t, true, nil
+		}
+		d, err := ParseDuration(strings.ReplaceAll(offset, " ", ""))
+		if err != nil {
+			return time.Time{}, true, err
+		}
+		return t.Add(d), true, nil
+	}
+	return time.Time{}, false, nil
+}
+
+// ParseTime parses s as a point in time, trying in order:
+//   - relative expressions: now, today, yesterday or tomorrow, optionally followed by a signed
+//     duration in the ParseDuration syntax, e.g. "now-15m" or "yesterday+6h";
+//   - the layouts, named (see ParseTimeFormat) or literal; values without an offset are
+//     interpreted in loc, or UTC when loc is nil;
+//   - Unix epoch seconds, or milliseconds for values of 12 digits or more.
+func ParseTime(s string, layouts []string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if t, ok, err := parseRelativeTime(s, loc, time.Now()); ok {
+		return t, err
+	}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(ParseTimeFormat(layout), s, loc); err == nil {
+			return t, nil
+		}
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if n >= 1e11 || n <= -1e11 {
+			return time.UnixMilli(n).In(loc), nil
+		}
+		return time.Unix(n, 0).In(loc), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time format `%s` must be one of: %s", s, strings.Join(layouts, ","))
+}
+
+// MustParseTime is like ParseTime with the time zone given by name, but panics on error.
+// It evaluates relative time defaults in the generated code.
+func MustParseTime(s string, layouts []string, timezone string) time.Time {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		panic(err)
+	}
+	t, err := ParseTime(s, layouts, loc)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// FormatTime formats t with the first layout in loc, or UTC when loc is nil.
+// RFC3339Nano is used when there are no layouts.
+func FormatTime(t time.Time, layouts []string, loc *time.Location) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+	layout := time.RFC3339Nano
+	if len(layouts) > 0 {
+		layout = ParseTimeFormat(layouts[0])
+	}
+	return t.In(loc).Format(layout)
+}
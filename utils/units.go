@@ -0,0 +1,229 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Unit selects the suffixes accepted by the unit-aware numeric flag values.
+type Unit int
+
+const (
+	// UnitNone accepts plain numbers only.
+	UnitNone Unit = iota
+	// UnitBytes accepts byte sizes with binary (KiB, MiB, ... or Ki, Mi, ...) and
+	// decimal (KB, MB, ...) suffixes, case-insensitively, e.g. "512MiB" or "2GB".
+	UnitBytes
+	// UnitSI accepts SI multipliers: k/K, M, G, T, P, E and the fractional m, u, n.
+	UnitSI
+	// UnitPercent accepts percentages, "80%" is 0.8. Plain numbers are taken as ratios.
+	UnitPercent
+)
+
+type unitSuffix struct {
+	suffix string
+	factor *big.Rat
+}
+
+func pow(base, exp int64) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(base), big.NewInt(exp), nil))
+}
+
+var (
+	binaryByteSuffixes = []unitSuffix{
+		{"EiB", pow(1024, 6)}, {"PiB", pow(1024, 5)}, {"TiB", pow(1024, 4)},
+		{"GiB", pow(1024, 3)}, {"MiB", pow(1024, 2)}, {"KiB", pow(1024, 1)},
+	}
+	decimalByteSuffixes = []unitSuffix{
+		{"EB", pow(1000, 6)}, {"PB", pow(1000, 5)}, {"TB", pow(1000, 4)},
+		{"GB", pow(1000, 3)}, {"MB", pow(1000, 2)}, {"KB", pow(1000, 1)},
+	}
+	siSuffixes = []unitSuffix{
+		{"E", pow(1000, 6)}, {"P", pow(1000, 5)}, {"T", pow(1000, 4)},
+		{"G", pow(1000, 3)}, {"M", pow(1000, 2)}, {"k", pow(1000, 1)},
+	}
+	// siParseSuffixes additionally accepts an upper case K and the fractional multipliers.
+	siParseSuffixes = append(siSuffixes[:len(siSuffixes):len(siSuffixes)],
+		unitSuffix{"K", pow(1000, 1)},
+		unitSuffix{"m", new(big.Rat).SetFrac64(1, 1e3)},
+		unitSuffix{"u", new(big.Rat).SetFrac64(1, 1e6)},
+		unitSuffix{"µ", new(big.Rat).SetFrac64(1, 1e6)},
+		unitSuffix{"n", new(big.Rat).SetFrac64(1, 1e9)},
+	)
+)
+
+// parseUnit parses s into an exact rational number according to unit.
+func parseUnit(s string, unit Unit) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	num, factor := s, new(big.Rat).SetInt64(1)
+
+	switch unit {
+	case UnitBytes:
+		i := strings.IndexFunc(s, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+'
+		})
+		if i >= 0 {
+			num = strings.TrimSpace(s[:i])
+			factor = byteFactor(s[i:])
+			if factor == nil {
+				return nil, fmt.Errorf("invalid byte size %q: unknown unit %q", s, s[i:])
+			}
+		}
+	case UnitSI:
+		for _, u := range siParseSuffixes {
+			if strings.HasSuffix(s, u.suffix) {
+				num, factor = strings.TrimSpace(strings.TrimSuffix(s, u.suffix)), u.factor
+				break
+			}
+		}
+	case UnitPercent:
+		if strings.HasSuffix(s, "%") {
+			num, factor = strings.TrimSpace(strings.TrimSuffix(s, "%")), new(big.Rat).SetFrac64(1, 100)
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(num)
+	if !ok || num == "" {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	if unit == UnitBytes && r.Sign() < 0 {
+		return nil, fmt.Errorf("invalid byte size %q: must not be negative", s)
+	}
+	return r.Mul(r, factor), nil
+}
+
+// byteFactor returns the factor of a byte size suffix such as "MiB", "Mi" or "MB", or nil.
+func byteFactor(suffix string) *big.Rat {
+	suffix = strings.ToLower(strings.TrimSpace(suffix))
+	if suffix == "b" {
+		return new(big.Rat).SetInt64(1)
+	}
+	for _, u := range binaryByteSuffixes {
+		if name := strings.ToLower(u.suffix); suffix == name || suffix == strings.TrimSuffix(name, "b") {
+			return u.factor
+		}
+	}
+	for _, u := range decimalByteSuffixes {
+		if suffix == strings.ToLower(u.suffix) {
+			return u.factor
+		}
+	}
+	return nil
+}
+
+// ParseUnitInt parses s as a signed integer of the given bit size, accepting the suffixes of unit.
+func ParseUnitInt(s string, unit Unit, bitSize int) (int64, error) {
+	r, err := parseUnit(s, unit)
+	if err != nil {
+		return 0, err
+	}
+	if !r.IsInt() {
+		return 0, fmt.Errorf("invalid value %q: not a whole number", s)
+	}
+	n := r.Num()
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bitSize-1))
+	if n.Cmp(new(big.Int).Neg(limit)) < 0 || n.Cmp(limit) >= 0 {
+		return 0, fmt.Errorf("invalid value %q: out of range for int%d", s, bitSize)
+	}
+	return n.Int64(), nil
+}
+
+// ParseUnitUint parses s as an unsigned integer of the given bit size, accepting the suffixes of unit.
+func ParseUnitUint(s string, unit Unit, bitSize int) (uint64, error) {
+	r, err := parseUnit(s, unit)
+	if err != nil {
+		return 0, err
+	}
+	if !r.IsInt() {
+		return 0, fmt.Errorf("invalid value %q: not a whole number", s)
+	}
+	n := r.Num()
+	if n.Sign() < 0 || n.BitLen() > bitSize {
+		return 0, fmt.Errorf("invalid value %q: out of range for uint%d", s, bitSize)
+	}
+	return n.Uint64(), nil
+}
+
+// ParseUnitFloat parses s as a floating-point number of the given bit size, accepting the suffixes of unit.
+func ParseUnitFloat(s string, unit Unit, bitSize int) (float64, error) {
+	r, err := parseUnit(s, unit)
+	if err != nil {
+		return 0, err
+	}
+	if bitSize == 32 {
+		f, _ := r.Float32()
+		if math.IsInf(float64(f), 0) {
+			return 0, fmt.Errorf("invalid value %q: out of range for float32", s)
+		}
+		return float64(f), nil
+	}
+	f, _ := r.Float64()
+	if math.IsInf(f, 0) {
+		return 0, fmt.Errorf("invalid value %q: out of range for float64", s)
+	}
+	return f, nil
+}
+
+// FormatUnitInt formats v with the largest unit that represents it exactly.
+func FormatUnitInt(v int64, unit Unit) string {
+	return formatUnit(new(big.Rat).SetInt64(v), unit, func() string { return strconv.FormatInt(v, 10) })
+}
+
+// FormatUnitUint formats v with the largest unit that represents it exactly.
+func FormatUnitUint(v uint64, unit Unit) string {
+	return formatUnit(new(big.Rat).SetInt(new(big.Int).SetUint64(v)), unit, func() string { return strconv.FormatUint(v, 10) })
+}
+
+// FormatUnitFloat formats v with the largest unit that represents it exactly;
+// percentages other than zero are always formatted with a % sign.
+func FormatUnitFloat(v float64, unit Unit, bitSize int) string {
+	plain := func() string { return strconv.FormatFloat(v, 'g', -1, bitSize) }
+	if v == 0 {
+		return "0"
+	}
+	if unit == UnitPercent {
+		if bitSize == 32 {
+			return strconv.FormatFloat(float64(float32(v)*100), 'g', -1, 32) + "%"
+		}
+		return strconv.FormatFloat(v*100, 'g', -1, 64) + "%"
+	}
+	if math.IsInf(v, 0) || math.IsNaN(v) || v != math.Trunc(v) {
+		return plain()
+	}
+	return formatUnit(new(big.Rat).SetFloat64(v), unit, plain)
+}
+
+func formatUnit(r *big.Rat, unit Unit, plain func() string) string {
+	var candidates [][]unitSuffix
+	switch unit {
+	case UnitBytes:
+		candidates = [][]unitSuffix{binaryByteSuffixes, decimalByteSuffixes}
+	case UnitSI:
+		candidates = [][]unitSuffix{siSuffixes}
+	}
+	// Zero is formatted as "0", which pflag recognizes as a zero default and omits from the help.
+	if r.Sign() == 0 {
+		return "0"
+	}
+	// Pick the exact unit giving the smallest number, binary units winning ties.
+	var best *big.Rat
+	var suffix string
+	for _, suffixes := range candidates {
+		for _, u := range suffixes {
+			q := new(big.Rat).Quo(r, u.factor)
+			if q.IsInt() && (best == nil || new(big.Rat).Abs(q).Cmp(new(big.Rat).Abs(best)) < 0) {
+				best, suffix = q, u.suffix
+			}
+		}
+	}
+	if best != nil {
+		return best.Num().String() + suffix
+	}
+	if unit == UnitBytes {
+		return plain() + "B"
+	}
+	return plain()
+}